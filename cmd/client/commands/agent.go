@@ -0,0 +1,131 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/llravell/go-pass/cmd/client/components"
+	"github.com/llravell/go-pass/pkg/keyagent"
+	"github.com/urfave/cli/v3"
+)
+
+const (
+	defaultAgentIdleTimeout     = 15 * time.Minute
+	defaultAgentAbsoluteTimeout = 4 * time.Hour
+)
+
+var ErrAgentAlreadyRunning = errors.New("agent is already running")
+
+type AgentCommands struct {
+	agentClient *keyagent.Client
+	keyProvider *components.EncryptionKeyProvider
+	socketPath  string
+}
+
+func NewAgentCommands(
+	agentClient *keyagent.Client,
+	keyProvider *components.EncryptionKeyProvider,
+	socketPath string,
+) *AgentCommands {
+	return &AgentCommands{
+		agentClient: agentClient,
+		keyProvider: keyProvider,
+		socketPath:  socketPath,
+	}
+}
+
+func (a *AgentCommands) Agent() *cli.Command {
+	return &cli.Command{
+		Name:  "agent",
+		Usage: "run daemon caching the encryption key so master password is not asked on every command",
+		Flags: []cli.Flag{
+			&cli.DurationFlag{
+				Name:  "idle-timeout",
+				Usage: "lock after this period without usage",
+				Value: defaultAgentIdleTimeout,
+			},
+			&cli.DurationFlag{
+				Name:  "timeout",
+				Usage: "lock after this period since unlock regardless of usage",
+				Value: defaultAgentAbsoluteTimeout,
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			listener, err := a.listen(ctx)
+			if err != nil {
+				return err
+			}
+
+			defer os.Remove(a.socketPath)
+
+			fmt.Fprintf(cmd.ErrWriter, "agent listens on %s\n", a.socketPath)
+
+			ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			server := keyagent.NewServer(cmd.Duration("idle-timeout"), cmd.Duration("timeout"))
+
+			return server.Serve(ctx, listener)
+		},
+	}
+}
+
+func (a *AgentCommands) Lock() *cli.Command {
+	return &cli.Command{
+		Name:  "lock",
+		Usage: "drop the encryption key cached by agent",
+		Action: func(ctx context.Context, _ *cli.Command) error {
+			return a.agentClient.Lock(ctx)
+		},
+	}
+}
+
+func (a *AgentCommands) Unlock() *cli.Command {
+	return &cli.Command{
+		Name:  "unlock",
+		Usage: "ask master password and cache the encryption key in agent",
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			if _, err := a.keyProvider.Unlock(ctx); err != nil {
+				return err
+			}
+
+			status, err := a.agentClient.Status(ctx)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.Writer, "unlocked, expires in %s\n", status.ExpiresIn)
+
+			return nil
+		},
+	}
+}
+
+func (a *AgentCommands) listen(ctx context.Context) (net.Listener, error) {
+	if _, err := a.agentClient.Status(ctx); err == nil {
+		return nil, ErrAgentAlreadyRunning
+	}
+
+	if err := os.Remove(a.socketPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	listener, err := net.Listen("unix", a.socketPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = os.Chmod(a.socketPath, 0o600); err != nil {
+		listener.Close()
+
+		return nil, err
+	}
+
+	return listener, nil
+}
@@ -0,0 +1,85 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	usecase "github.com/llravell/go-pass/internal/usecase/client"
+	"github.com/urfave/cli/v3"
+)
+
+const defaultAuditPageSize = 50
+
+type AuditCommands struct {
+	auditUC *usecase.AuditUseCase
+}
+
+func NewAuditCommands(auditUC *usecase.AuditUseCase) *AuditCommands {
+	return &AuditCommands{
+		auditUC: auditUC,
+	}
+}
+
+func (a *AuditCommands) Audit() *cli.Command {
+	return &cli.Command{
+		Name:  "audit",
+		Usage: "show security events of your account, newest first",
+		Flags: []cli.Flag{
+			&cli.IntFlag{
+				Name:  "limit",
+				Usage: "number of events per page",
+				Value: defaultAuditPageSize,
+			},
+			&cli.IntFlag{
+				Name:  "before",
+				Usage: "show events older than the event with this id",
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			events, err := a.auditUC.GetPage(ctx, cmd.Int("before"), int(cmd.Int("limit")))
+			if err != nil {
+				return cli.Exit(err, 1)
+			}
+
+			writer := tabwriter.NewWriter(cmd.Writer, 0, 0, 2, ' ', 0)
+
+			fmt.Fprintln(writer, "ID\tTIME\tACTION\tENTRY\tOUTCOME\tSOURCE\tIP")
+
+			for _, event := range events {
+				source := "-"
+
+				switch {
+				case event.TokenID > 0:
+					source = "token #" + strconv.Itoa(event.TokenID)
+				case event.DeviceID > 0:
+					source = "device #" + strconv.Itoa(event.DeviceID)
+				}
+
+				fmt.Fprintf(
+					writer,
+					"%d\t%s\t%s\t%s\t%s\t%s\t%s\n",
+					event.ID,
+					event.CreatedAt.Local().Format(time.DateTime),
+					event.Action,
+					event.Entry,
+					event.Outcome,
+					source,
+					event.IP,
+				)
+			}
+
+			if err = writer.Flush(); err != nil {
+				return err
+			}
+
+			if len(events) > 0 && len(events) == int(cmd.Int("limit")) {
+				_, err = fmt.Fprintf(cmd.Writer, "\nolder events: gopass audit --before %d\n", events[len(events)-1].ID)
+			}
+
+			return err
+		},
+	}
+}
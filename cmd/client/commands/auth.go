@@ -2,19 +2,37 @@ package commands
 
 import (
 	"context"
+	"fmt"
+	"os"
 	"strings"
 
+	"github.com/llravell/go-pass/cmd/client/components"
 	usecase "github.com/llravell/go-pass/internal/usecase/client"
 	"github.com/urfave/cli/v3"
 )
 
+func deviceFlag() cli.Flag {
+	hostname, _ := os.Hostname()
+
+	return &cli.StringFlag{
+		Name:  "device",
+		Usage: "device name shown in devices list",
+		Value: hostname,
+	}
+}
+
 type AuthCommands struct {
-	authUC *usecase.AuthUseCase
+	authUC         *usecase.AuthUseCase
+	passwordReader *components.PasswordReader
 }
 
-func NewAuthCommands(authUC *usecase.AuthUseCase) *AuthCommands {
+func NewAuthCommands(
+	authUC *usecase.AuthUseCase,
+	passwordReader *components.PasswordReader,
+) *AuthCommands {
 	return &AuthCommands{
-		authUC: authUC,
+		authUC:         authUC,
+		passwordReader: passwordReader,
 	}
 }
 
@@ -27,21 +45,25 @@ func (auth *AuthCommands) Register() *cli.Command {
 				Aliases:  []string{"l"},
 				Required: true,
 			},
-			&cli.StringFlag{
-				Name:     "password",
-				Aliases:  []string{"p"},
-				Required: true,
-			},
+			deviceFlag(),
 		},
 		Action: func(ctx context.Context, c *cli.Command) error {
 			login := strings.TrimSpace(c.String("login"))
-			password := strings.TrimSpace(c.String("password"))
 
-			err := auth.authUC.Register(ctx, login, password)
+			password, err := auth.passwordReader.ReadNew("Enter master password: ", "Repeat master password: ")
+			if err != nil {
+				return cli.Exit(err, 1)
+			}
+
+			challenge, err := auth.authUC.Register(ctx, login, password, c.String("device"))
 			if err != nil {
 				return cli.Exit(err, 1)
 			}
 
+			if challenge != nil {
+				return auth.passSecondFactor(ctx, c, challenge)
+			}
+
 			return nil
 		},
 	}
@@ -56,22 +78,56 @@ func (auth *AuthCommands) Login() *cli.Command {
 				Aliases:  []string{"l"},
 				Required: true,
 			},
-			&cli.StringFlag{
-				Name:     "password",
-				Aliases:  []string{"p"},
-				Required: true,
-			},
+			deviceFlag(),
 		},
 		Action: func(ctx context.Context, c *cli.Command) error {
 			login := strings.TrimSpace(c.String("login"))
-			password := strings.TrimSpace(c.String("password"))
 
-			err := auth.authUC.Login(ctx, login, password)
+			password, err := auth.passwordReader.Read("Enter master password: ")
 			if err != nil {
 				return cli.Exit(err, 1)
 			}
 
+			challenge, err := auth.authUC.Login(ctx, login, password, c.String("device"))
+			if err != nil {
+				return cli.Exit(err, 1)
+			}
+
+			if challenge != nil {
+				return auth.passSecondFactor(ctx, c, challenge)
+			}
+
 			return nil
 		},
 	}
 }
+
+func (auth *AuthCommands) passSecondFactor(
+	ctx context.Context,
+	c *cli.Command,
+	challenge *usecase.SecondFactorChallenge,
+) error {
+	if challenge.EnrollmentRequired {
+		fmt.Fprintln(c.Writer, "Server requires two-factor authentication, set it up to continue.")
+
+		enrollment, err := auth.authUC.BeginEnrollment(ctx, challenge)
+		if err != nil {
+			return cli.Exit(err, 1)
+		}
+
+		if err = printTwoFactorEnrollment(c.Writer, enrollment); err != nil {
+			return err
+		}
+	}
+
+	code, err := components.StringPrompt("Enter authenticator code or recovery code: ")
+	if err != nil {
+		return cli.Exit(err, 1)
+	}
+
+	if err = auth.authUC.CompleteSecondFactor(ctx, challenge, code); err != nil {
+		return cli.Exit(err, 1)
+	}
+
+	return nil
+}
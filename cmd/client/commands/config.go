@@ -0,0 +1,97 @@
+package commands
+
+import (
+	"bufio"
+	"context"
+	"strings"
+
+	"github.com/llravell/go-pass/config"
+	"github.com/urfave/cli/v3"
+)
+
+type ConfigCommands struct {
+	cfg     *config.ClientConfig
+	profile string
+}
+
+func NewConfigCommands(cfg *config.ClientConfig, profile string) *ConfigCommands {
+	return &ConfigCommands{
+		cfg:     cfg,
+		profile: profile,
+	}
+}
+
+func (c *ConfigCommands) Config() *cli.Command {
+	return &cli.Command{
+		Name:  "config",
+		Usage: "manage client config, keys are [profile.]field, e.g. team.server",
+		Commands: []*cli.Command{
+			c.get(),
+			c.set(),
+			c.list(),
+		},
+	}
+}
+
+func (c *ConfigCommands) get() *cli.Command {
+	return &cli.Command{
+		Name: "get",
+		Action: func(_ context.Context, cmd *cli.Command) error {
+			key := strings.TrimSpace(cmd.Args().Get(0))
+			if len(key) == 0 {
+				return cli.Exit("got empty key", 1)
+			}
+
+			value, err := c.cfg.Get(c.profile, key)
+			if err != nil {
+				return cli.Exit(err, 1)
+			}
+
+			_, err = cmd.Writer.Write([]byte(value + "\n"))
+
+			return err
+		},
+	}
+}
+
+func (c *ConfigCommands) set() *cli.Command {
+	return &cli.Command{
+		Name: "set",
+		Action: func(_ context.Context, cmd *cli.Command) error {
+			key := strings.TrimSpace(cmd.Args().Get(0))
+			value := strings.TrimSpace(cmd.Args().Get(1))
+
+			if len(key) == 0 || cmd.Args().Len() < 2 {
+				return cli.Exit("got invalid args", 1)
+			}
+
+			if err := c.cfg.Set(c.profile, key, value); err != nil {
+				return cli.Exit(err, 1)
+			}
+
+			return c.cfg.Save()
+		},
+	}
+}
+
+func (c *ConfigCommands) list() *cli.Command {
+	return &cli.Command{
+		Name: "list",
+		Action: func(_ context.Context, cmd *cli.Command) error {
+			lines, err := c.cfg.List()
+			if err != nil {
+				return err
+			}
+
+			writer := bufio.NewWriter(cmd.Writer)
+
+			for _, line := range lines {
+				if _, err = writer.WriteString(line + "\n"); err != nil {
+					return err
+				}
+			}
+
+			return writer.Flush()
+		},
+	}
+}
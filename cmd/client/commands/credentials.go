@@ -0,0 +1,25 @@
+package commands
+
+import (
+	"context"
+	"errors"
+
+	"github.com/llravell/go-pass/internal/entity"
+	usecase "github.com/llravell/go-pass/internal/usecase/client"
+)
+
+// overrideOnConflict перезаписывает серверную версию при конфликте:
+// credential helper'ы вызываются неинтерактивно, поэтому спросить пользователя нельзя.
+func overrideOnConflict(
+	ctx context.Context,
+	passwordsUC *usecase.PasswordsUseCase,
+	err error,
+) error {
+	var conflictErr *entity.PasswordConflictError
+
+	if !errors.As(err, &conflictErr) {
+		return err
+	}
+
+	return passwordsUC.OverridePassword(ctx, conflictErr)
+}
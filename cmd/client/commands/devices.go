@@ -0,0 +1,94 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	usecase "github.com/llravell/go-pass/internal/usecase/client"
+	"github.com/urfave/cli/v3"
+)
+
+type DevicesCommands struct {
+	devicesUC *usecase.DevicesUseCase
+}
+
+func NewDevicesCommands(devicesUC *usecase.DevicesUseCase) *DevicesCommands {
+	return &DevicesCommands{
+		devicesUC: devicesUC,
+	}
+}
+
+func (d *DevicesCommands) Devices() *cli.Command {
+	return &cli.Command{
+		Name:  "devices",
+		Usage: "manage devices signed in to your account",
+		Commands: []*cli.Command{
+			d.list(),
+			d.revoke(),
+		},
+	}
+}
+
+func (d *DevicesCommands) list() *cli.Command {
+	return &cli.Command{
+		Name: "list",
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			devices, err := d.devicesUC.GetList(ctx)
+			if err != nil {
+				return cli.Exit(err, 1)
+			}
+
+			writer := tabwriter.NewWriter(cmd.Writer, 0, 0, 2, ' ', 0)
+
+			fmt.Fprintln(writer, "ID\tNAME\tVERSION\tIP\tLAST SEEN\tSTATUS")
+
+			for _, device := range devices {
+				state := "active"
+				if device.Revoked {
+					state = "revoked"
+				}
+
+				if device.Current {
+					state += " (current)"
+				}
+
+				fmt.Fprintf(
+					writer,
+					"%d\t%s\t%s\t%s\t%s\t%s\n",
+					device.ID,
+					device.Name,
+					device.ClientVersion,
+					device.IP,
+					device.LastSeenAt.Local().Format(time.DateTime),
+					state,
+				)
+			}
+
+			return writer.Flush()
+		},
+	}
+}
+
+func (d *DevicesCommands) revoke() *cli.Command {
+	return &cli.Command{
+		Name:      "revoke",
+		Usage:     "sign out the device, its tokens stop working on all servers",
+		ArgsUsage: "<id>",
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			id, err := strconv.Atoi(strings.TrimSpace(cmd.Args().Get(0)))
+			if err != nil {
+				return cli.Exit("got invalid device id", 1)
+			}
+
+			if err = d.devicesUC.RevokeDevice(ctx, id); err != nil {
+				return cli.Exit(err, 1)
+			}
+
+			return nil
+		},
+	}
+}
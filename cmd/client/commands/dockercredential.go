@@ -0,0 +1,214 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/llravell/go-pass/cmd/client/components"
+	"github.com/llravell/go-pass/internal/entity"
+	usecase "github.com/llravell/go-pass/internal/usecase/client"
+	"github.com/llravell/go-pass/pkg/credhelper"
+	"github.com/urfave/cli/v3"
+)
+
+const dockerCredentialsFolder = "docker"
+
+type DockerCredentialCommands struct {
+	passwordsUC *usecase.PasswordsUseCase
+	keyProvider *components.EncryptionKeyProvider
+}
+
+func NewDockerCredentialCommands(
+	passwordsUC *usecase.PasswordsUseCase,
+	keyProvider *components.EncryptionKeyProvider,
+) *DockerCredentialCommands {
+	return &DockerCredentialCommands{
+		passwordsUC: passwordsUC,
+		keyProvider: keyProvider,
+	}
+}
+
+func (d *DockerCredentialCommands) DockerCredential() *cli.Command {
+	return &cli.Command{
+		Name:  "docker-credential",
+		Usage: "docker credential helper, install as docker-credential-gopass symlink to this binary",
+		Commands: []*cli.Command{
+			{
+				Name:   credhelper.DockerActionGet,
+				Action: d.withProtocolErrors(d.get),
+			},
+			{
+				Name:   credhelper.DockerActionStore,
+				Action: d.withProtocolErrors(d.store),
+			},
+			{
+				Name:   credhelper.DockerActionErase,
+				Action: d.withProtocolErrors(d.erase),
+			},
+			{
+				Name:   credhelper.DockerActionList,
+				Action: d.withProtocolErrors(d.list),
+			},
+		},
+	}
+}
+
+// withProtocolErrors пишет текст ошибки в stdout: docker читает оттуда
+// сообщение, чтобы отличить отсутствие учетных данных от сбоя.
+func (d *DockerCredentialCommands) withProtocolErrors(action cli.ActionFunc) cli.ActionFunc {
+	return func(ctx context.Context, cmd *cli.Command) error {
+		err := action(ctx, cmd)
+		if err == nil {
+			return nil
+		}
+
+		if errors.Is(err, entity.ErrPasswordDoesNotExist) {
+			err = credhelper.ErrDockerCredentialsNotFound
+		}
+
+		fmt.Fprintln(cmd.Writer, err.Error())
+
+		return cli.Exit("", 1)
+	}
+}
+
+func (d *DockerCredentialCommands) get(ctx context.Context, cmd *cli.Command) error {
+	serverURL, err := credhelper.ReadDockerServerURL(cmd.Reader)
+	if err != nil {
+		return err
+	}
+
+	name, err := d.entryName(serverURL)
+	if err != nil {
+		return err
+	}
+
+	password, err := d.passwordsUC.GetPasswordByName(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	key, err := d.keyProvider.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	login := entity.NewLoginFromPassword(password)
+
+	if err = password.Open(key); err != nil {
+		return err
+	}
+
+	return credhelper.WriteDockerCredential(cmd.Writer, &credhelper.DockerCredential{
+		ServerURL: serverURL,
+		Username:  login.Username,
+		Secret:    password.Value,
+	})
+}
+
+func (d *DockerCredentialCommands) store(ctx context.Context, cmd *cli.Command) error {
+	cred, err := credhelper.ReadDockerCredential(cmd.Reader)
+	if err != nil {
+		return err
+	}
+
+	serverURL, err := credhelper.NormalizeDockerServerURL(cred.ServerURL)
+	if err != nil {
+		return err
+	}
+
+	name, err := d.entryName(cred.ServerURL)
+	if err != nil {
+		return err
+	}
+
+	existing, err := d.passwordsUC.GetPasswordByName(ctx, name)
+	if err != nil && !errors.Is(err, entity.ErrPasswordDoesNotExist) {
+		return err
+	}
+
+	key, err := d.keyProvider.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	login := &entity.Login{
+		Name:     name,
+		URL:      serverURL.String(),
+		Username: cred.Username,
+		Password: cred.Secret,
+		Version:  1,
+	}
+
+	if existing != nil {
+		login.Version = existing.Version + 1
+	}
+
+	password := login.ToPassword()
+	if err = password.Close(key); err != nil {
+		return err
+	}
+
+	if existing != nil {
+		err = d.passwordsUC.UpdatePassword(ctx, password)
+	} else {
+		err = d.passwordsUC.AddNewPassword(ctx, *password)
+	}
+
+	return overrideOnConflict(ctx, d.passwordsUC, err)
+}
+
+func (d *DockerCredentialCommands) erase(ctx context.Context, cmd *cli.Command) error {
+	serverURL, err := credhelper.ReadDockerServerURL(cmd.Reader)
+	if err != nil {
+		return err
+	}
+
+	name, err := d.entryName(serverURL)
+	if err != nil {
+		return err
+	}
+
+	err = d.passwordsUC.DeletePasswordByName(ctx, name)
+	if errors.Is(err, entity.ErrPasswordDoesNotExist) {
+		return nil
+	}
+
+	return err
+}
+
+func (d *DockerCredentialCommands) list(ctx context.Context, cmd *cli.Command) error {
+	logins, err := d.passwordsUC.GetLogins(ctx)
+	if err != nil {
+		return err
+	}
+
+	list := make(map[string]string, len(logins))
+
+	for _, login := range logins {
+		if strings.HasPrefix(login.Name, dockerCredentialsFolder+"/") {
+			list[login.URL] = login.Username
+		}
+	}
+
+	return credhelper.WriteDockerCredentialList(cmd.Writer, list)
+}
+
+func (d *DockerCredentialCommands) entryName(serverURL string) (string, error) {
+	parsed, err := credhelper.NormalizeDockerServerURL(serverURL)
+	if err != nil {
+		return "", err
+	}
+
+	// реестры по http и https на одном адресе — разные записи; "+" не встречается
+	// в именах хостов, поэтому имя со схемой не совпадет с другим реестром
+	host := parsed.Host
+	if parsed.Scheme != "https" {
+		host = parsed.Scheme + "+" + host
+	}
+
+	return path.Join(dockerCredentialsFolder, host, parsed.Path), nil
+}
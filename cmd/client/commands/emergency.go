@@ -0,0 +1,261 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/llravell/go-pass/cmd/client/components"
+	"github.com/llravell/go-pass/internal/entity"
+	usecase "github.com/llravell/go-pass/internal/usecase/client"
+	"github.com/urfave/cli/v3"
+)
+
+const defaultEmergencyWaitPeriod = 72 * time.Hour
+
+var emergencyExposedWarning = "warning: %s already had access and could save your vault key, " +
+	"it cannot be taken back; change your master password and re-encrypt your entries\n"
+
+type EmergencyCommands struct {
+	emergencyUC *usecase.EmergencyUseCase
+	keyProvider *components.EncryptionKeyProvider
+}
+
+func NewEmergencyCommands(
+	emergencyUC *usecase.EmergencyUseCase,
+	keyProvider *components.EncryptionKeyProvider,
+) *EmergencyCommands {
+	return &EmergencyCommands{
+		emergencyUC: emergencyUC,
+		keyProvider: keyProvider,
+	}
+}
+
+func (e *EmergencyCommands) Emergency() *cli.Command {
+	return &cli.Command{
+		Name:  "emergency",
+		Usage: "let trusted contacts access your vault if you are unreachable",
+		Commands: []*cli.Command{
+			e.grant(),
+			e.revoke(),
+			e.list(),
+			e.request(),
+			e.approve(),
+			e.reject(),
+			e.view(),
+		},
+	}
+}
+
+func (e *EmergencyCommands) grant() *cli.Command {
+	return &cli.Command{
+		Name:      "grant",
+		Usage:     "make user a trusted contact, granting again replaces the waiting period",
+		ArgsUsage: "<login>",
+		Flags: []cli.Flag{
+			&cli.DurationFlag{
+				Name:  "wait",
+				Usage: "time you have to reject a request before access is given",
+				Value: defaultEmergencyWaitPeriod,
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			login := strings.TrimSpace(cmd.Args().Get(0))
+			if len(login) == 0 {
+				return cli.Exit("got empty login", 1)
+			}
+
+			key, err := e.keyProvider.Get(ctx)
+			if err != nil {
+				return err
+			}
+
+			if err = e.emergencyUC.Grant(ctx, key, login, cmd.Duration("wait")); err != nil {
+				return cli.Exit(err, 1)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (e *EmergencyCommands) revoke() *cli.Command {
+	return &cli.Command{
+		Name:      "revoke",
+		Usage:     "remove trusted contact, a vault key they already received stays usable",
+		ArgsUsage: "<login>",
+		Action: e.contactAction(func(ctx context.Context, cmd *cli.Command, login string) error {
+			exposed, err := e.emergencyUC.Revoke(ctx, login)
+			if exposed {
+				fmt.Fprintf(cmd.ErrWriter, emergencyExposedWarning, login)
+			}
+
+			return err
+		}),
+	}
+}
+
+func (e *EmergencyCommands) list() *cli.Command {
+	return &cli.Command{
+		Name: "list",
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			outgoing, err := e.emergencyUC.GetList(ctx, false)
+			if err != nil {
+				return cli.Exit(err, 1)
+			}
+
+			incoming, err := e.emergencyUC.GetList(ctx, true)
+			if err != nil {
+				return cli.Exit(err, 1)
+			}
+
+			writer := tabwriter.NewWriter(cmd.Writer, 0, 0, 2, ' ', 0)
+
+			fmt.Fprintln(writer, "TRUSTED CONTACT\tVAULT OF\tWAIT\tSTATUS\tAVAILABLE AT")
+
+			for _, access := range outgoing {
+				fmt.Fprintf(writer, "%s\t-\t%s\t%s\t%s\n", access.Grantee, access.WaitPeriod, access.Status, availableAt(access))
+			}
+
+			for _, access := range incoming {
+				fmt.Fprintf(writer, "-\t%s\t%s\t%s\t%s\n", access.Grantor, access.WaitPeriod, access.Status, availableAt(access))
+			}
+
+			return writer.Flush()
+		},
+	}
+}
+
+func (e *EmergencyCommands) request() *cli.Command {
+	return &cli.Command{
+		Name:      "request",
+		Usage:     "request access to the vault of user who trusted you",
+		ArgsUsage: "<login>",
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			grantor := strings.TrimSpace(cmd.Args().Get(0))
+			if len(grantor) == 0 {
+				return cli.Exit("got empty login", 1)
+			}
+
+			access, err := e.emergencyUC.Request(ctx, grantor)
+			if err != nil {
+				return cli.Exit(err, 1)
+			}
+
+			if access.Approved() {
+				_, err = fmt.Fprintf(cmd.Writer, "access to %s vault is approved\n", grantor)
+
+				return err
+			}
+
+			_, err = fmt.Fprintf(
+				cmd.Writer,
+				"access to %s vault will be given at %s unless they reject it\n",
+				grantor,
+				availableAt(access),
+			)
+
+			return err
+		},
+	}
+}
+
+func (e *EmergencyCommands) approve() *cli.Command {
+	return &cli.Command{
+		Name:      "approve",
+		Usage:     "give requested access without waiting",
+		ArgsUsage: "<login>",
+		Action: e.contactAction(func(ctx context.Context, _ *cli.Command, login string) error {
+			return e.emergencyUC.Approve(ctx, login)
+		}),
+	}
+}
+
+func (e *EmergencyCommands) reject() *cli.Command {
+	return &cli.Command{
+		Name:      "reject",
+		Usage:     "reject request or close given access to entries, the contact stays trusted",
+		ArgsUsage: "<login>",
+		Action: e.contactAction(func(ctx context.Context, cmd *cli.Command, login string) error {
+			exposed, err := e.emergencyUC.Reject(ctx, login)
+			if exposed {
+				fmt.Fprintf(cmd.ErrWriter, emergencyExposedWarning, login)
+			}
+
+			return err
+		}),
+	}
+}
+
+func (e *EmergencyCommands) view() *cli.Command {
+	return &cli.Command{
+		Name:      "view",
+		Usage:     "list entries of the vault you were given access to, or show one of them",
+		ArgsUsage: "<login> [name]",
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			grantor := strings.TrimSpace(cmd.Args().Get(0))
+			name := strings.TrimSpace(cmd.Args().Get(1))
+
+			if len(grantor) == 0 {
+				return cli.Exit("got empty login", 1)
+			}
+
+			key, err := e.keyProvider.Get(ctx)
+			if err != nil {
+				return err
+			}
+
+			passwords, err := e.emergencyUC.GetGrantorPasswords(ctx, key, grantor)
+			if err != nil {
+				return cli.Exit(err, 1)
+			}
+
+			for _, password := range passwords {
+				if len(name) == 0 {
+					fmt.Fprintln(cmd.Writer, password.Name)
+
+					continue
+				}
+
+				if password.Name == name {
+					_, err = fmt.Fprintln(cmd.Writer, password.Value)
+
+					return err
+				}
+			}
+
+			if len(name) > 0 {
+				return cli.Exit(entity.ErrPasswordDoesNotExist, 1)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (e *EmergencyCommands) contactAction(
+	fn func(ctx context.Context, cmd *cli.Command, login string) error,
+) cli.ActionFunc {
+	return func(ctx context.Context, cmd *cli.Command) error {
+		login := strings.TrimSpace(cmd.Args().Get(0))
+		if len(login) == 0 {
+			return cli.Exit("got empty login", 1)
+		}
+
+		if err := fn(ctx, cmd, login); err != nil {
+			return cli.Exit(err, 1)
+		}
+
+		return nil
+	}
+}
+
+func availableAt(access *entity.EmergencyAccess) string {
+	if access.Status != entity.EmergencyStatusRequested {
+		return "-"
+	}
+
+	return access.AvailableAt().Local().Format(time.DateTime)
+}
@@ -0,0 +1,162 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"path"
+
+	"github.com/llravell/go-pass/cmd/client/components"
+	"github.com/llravell/go-pass/internal/entity"
+	usecase "github.com/llravell/go-pass/internal/usecase/client"
+	"github.com/llravell/go-pass/pkg/credhelper"
+	"github.com/urfave/cli/v3"
+)
+
+const gitCredentialsFolder = "git"
+
+type GitCredentialCommands struct {
+	passwordsUC *usecase.PasswordsUseCase
+	keyProvider *components.EncryptionKeyProvider
+}
+
+func NewGitCredentialCommands(
+	passwordsUC *usecase.PasswordsUseCase,
+	keyProvider *components.EncryptionKeyProvider,
+) *GitCredentialCommands {
+	return &GitCredentialCommands{
+		passwordsUC: passwordsUC,
+		keyProvider: keyProvider,
+	}
+}
+
+func (g *GitCredentialCommands) GitCredential() *cli.Command {
+	return &cli.Command{
+		Name:  "git-credential",
+		Usage: "git credential helper, configure with: git config credential.helper '!gopass git-credential'",
+		Commands: []*cli.Command{
+			{
+				Name:    credhelper.GitActionGet,
+				Aliases: []string{"fill"},
+				Action:  g.get,
+			},
+			{
+				Name:    credhelper.GitActionStore,
+				Aliases: []string{"approve"},
+				Action:  g.store,
+			},
+			{
+				Name:    credhelper.GitActionErase,
+				Aliases: []string{"reject"},
+				Action:  g.erase,
+			},
+		},
+	}
+}
+
+func (g *GitCredentialCommands) get(ctx context.Context, cmd *cli.Command) error {
+	cred, err := credhelper.ReadGitCredential(cmd.Reader)
+	if err != nil {
+		return err
+	}
+
+	login, err := g.passwordsUC.FindLogin(ctx, cred.URL(), cred.Username)
+	if err != nil {
+		if errors.Is(err, entity.ErrPasswordDoesNotExist) {
+			return nil
+		}
+
+		return err
+	}
+
+	key, err := g.keyProvider.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	password := login.ToPassword()
+	if err = password.Open(key); err != nil {
+		return err
+	}
+
+	cred.Username = login.Username
+	cred.Password = password.Value
+
+	return credhelper.WriteGitCredential(cmd.Writer, cred)
+}
+
+func (g *GitCredentialCommands) store(ctx context.Context, cmd *cli.Command) error {
+	cred, err := credhelper.ReadGitCredential(cmd.Reader)
+	if err != nil {
+		return err
+	}
+
+	if len(cred.Host) == 0 || len(cred.Username) == 0 || len(cred.Password) == 0 {
+		return nil
+	}
+
+	key, err := g.keyProvider.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	login := &entity.Login{
+		Name:     g.entryName(cred),
+		URL:      cred.URL(),
+		Username: cred.Username,
+		Password: cred.Password,
+		Version:  1,
+	}
+
+	existing, err := g.passwordsUC.GetPasswordByName(ctx, login.Name)
+	if err != nil && !errors.Is(err, entity.ErrPasswordDoesNotExist) {
+		return err
+	}
+
+	if existing != nil {
+		login.Version = existing.Version + 1
+	}
+
+	password := login.ToPassword()
+	if err = password.Close(key); err != nil {
+		return err
+	}
+
+	if existing != nil {
+		err = g.passwordsUC.UpdatePassword(ctx, password)
+	} else {
+		err = g.passwordsUC.AddNewPassword(ctx, *password)
+	}
+
+	return overrideOnConflict(ctx, g.passwordsUC, err)
+}
+
+func (g *GitCredentialCommands) erase(ctx context.Context, cmd *cli.Command) error {
+	cred, err := credhelper.ReadGitCredential(cmd.Reader)
+	if err != nil {
+		return err
+	}
+
+	login, err := g.passwordsUC.FindLogin(ctx, cred.URL(), cred.Username)
+	if err != nil {
+		if errors.Is(err, entity.ErrPasswordDoesNotExist) {
+			return nil
+		}
+
+		return err
+	}
+
+	if !cred.Rejects(login.Password) {
+		return nil
+	}
+
+	login.Stale = true
+
+	password := login.ToPassword()
+	password.BumpVersion()
+
+	return overrideOnConflict(ctx, g.passwordsUC, g.passwordsUC.UpdatePassword(ctx, password))
+}
+
+func (g *GitCredentialCommands) entryName(cred *credhelper.GitCredential) string {
+	return path.Join(gitCredentialsFolder, cred.Host, cred.Path, cred.Username)
+}
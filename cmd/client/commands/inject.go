@@ -0,0 +1,180 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/llravell/go-pass/cmd/client/components"
+	"github.com/llravell/go-pass/internal/entity"
+	usecase "github.com/llravell/go-pass/internal/usecase/client"
+	"github.com/llravell/go-pass/pkg/inject"
+	"github.com/urfave/cli/v3"
+)
+
+type InjectCommands struct {
+	passwordsUC *usecase.PasswordsUseCase
+	keyProvider *components.EncryptionKeyProvider
+}
+
+func NewInjectCommands(
+	passwordsUC *usecase.PasswordsUseCase,
+	keyProvider *components.EncryptionKeyProvider,
+) *InjectCommands {
+	return &InjectCommands{
+		passwordsUC: passwordsUC,
+		keyProvider: keyProvider,
+	}
+}
+
+func (i *InjectCommands) Inject() *cli.Command {
+	return &cli.Command{
+		Name:  "inject",
+		Usage: "render template replacing secret references with values from the vault",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:      "in",
+				Aliases:   []string{"i"},
+				Usage:     "template file, stdin if empty",
+				TakesFile: true,
+			},
+			&cli.StringFlag{
+				Name:      "out",
+				Aliases:   []string{"o"},
+				Usage:     "output file, stdout if empty",
+				TakesFile: true,
+			},
+			&cli.BoolFlag{
+				Name:  "check",
+				Usage: "only check that every reference resolves",
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			tmpl, err := i.readTemplate(cmd)
+			if err != nil {
+				return err
+			}
+
+			if cmd.Bool("check") {
+				return i.check(ctx, cmd, tmpl)
+			}
+
+			rendered, err := inject.Render(ctx, tmpl, inject.ResolverFunc(i.resolve))
+			if err != nil {
+				return cli.Exit(err, 1)
+			}
+
+			out := strings.TrimSpace(cmd.String("out"))
+			if len(out) == 0 {
+				_, err = cmd.Writer.Write([]byte(rendered))
+
+				return err
+			}
+
+			return writeSecretFile(out, []byte(rendered))
+		},
+	}
+}
+
+func (i *InjectCommands) readTemplate(cmd *cli.Command) (string, error) {
+	in := strings.TrimSpace(cmd.String("in"))
+	if len(in) == 0 {
+		data, err := io.ReadAll(cmd.Reader)
+		if err != nil {
+			return "", err
+		}
+
+		return string(data), nil
+	}
+
+	data, err := os.ReadFile(in)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+func (i *InjectCommands) check(ctx context.Context, cmd *cli.Command, tmpl string) error {
+	refs, err := inject.References(tmpl)
+	if err != nil {
+		return cli.Exit(err, 1)
+	}
+
+	unresolved := 0
+
+	for _, ref := range refs {
+		_, err = i.passwordsUC.GetPasswordByName(ctx, ref.Name)
+		if err != nil {
+			unresolved++
+
+			fmt.Fprintf(cmd.ErrWriter, "%s: %s\n", ref, err)
+		}
+	}
+
+	if unresolved > 0 {
+		return cli.Exit(fmt.Sprintf("%d of %d references can not be resolved", unresolved, len(refs)), 1)
+	}
+
+	fmt.Fprintf(cmd.Writer, "all %d references resolved\n", len(refs))
+
+	return nil
+}
+
+func (i *InjectCommands) resolve(ctx context.Context, ref inject.Reference) (string, error) {
+	pass, err := i.passwordsUC.GetPasswordByName(ctx, ref.Name)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", ref.Name, err)
+	}
+
+	if ref.Field == inject.FieldMeta {
+		return pass.Meta, nil
+	}
+
+	return i.openValue(ctx, pass)
+}
+
+func (i *InjectCommands) openValue(ctx context.Context, pass *entity.Password) (string, error) {
+	key, err := i.keyProvider.Get(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if err = pass.Open(key); err != nil {
+		return "", err
+	}
+
+	return pass.Value, nil
+}
+
+// writeSecretFile атомарно записывает файл с правами 0600,
+// не оставляя на диске частично записанных секретов.
+func writeSecretFile(path string, data []byte) error {
+	tmpFile, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(tmpFile.Name())
+
+	if err = tmpFile.Chmod(0o600); err != nil {
+		tmpFile.Close()
+
+		return err
+	}
+
+	if _, err = tmpFile.Write(data); err != nil {
+		tmpFile.Close()
+
+		return err
+	}
+
+	if err = tmpFile.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpFile.Name(), path)
+}
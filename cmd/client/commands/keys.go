@@ -0,0 +1,128 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/llravell/go-pass/cmd/client/components"
+	usecase "github.com/llravell/go-pass/internal/usecase/client"
+	"github.com/urfave/cli/v3"
+)
+
+var keysForgetPromptTemplate = `
+Pinned key of %s: %s
+Forget it and pin the key the server returns next time?`
+
+type KeysCommands struct {
+	userKeysUC  *usecase.UserKeysUseCase
+	keyProvider *components.EncryptionKeyProvider
+}
+
+func NewKeysCommands(
+	userKeysUC *usecase.UserKeysUseCase,
+	keyProvider *components.EncryptionKeyProvider,
+) *KeysCommands {
+	return &KeysCommands{
+		userKeysUC:  userKeysUC,
+		keyProvider: keyProvider,
+	}
+}
+
+// PrintNewKey сообщает об отпечатке только что закрепленного ключа получателя.
+func PrintNewKey(w io.Writer) func(login string, fingerprint string) {
+	return func(login string, fingerprint string) {
+		fmt.Fprintf(
+			w,
+			"pinned public key of %s: %s\ncompare it with the output of `gopass keys show` on their side\n",
+			login,
+			fingerprint,
+		)
+	}
+}
+
+func (k *KeysCommands) Keys() *cli.Command {
+	return &cli.Command{
+		Name:  "keys",
+		Usage: "verify public keys used to share entries, collections and emergency access",
+		Commands: []*cli.Command{
+			k.show(),
+			k.forget(),
+		},
+	}
+}
+
+func (k *KeysCommands) show() *cli.Command {
+	return &cli.Command{
+		Name:      "show",
+		Usage:     "show fingerprint of your key or of the pinned key of another user",
+		ArgsUsage: "[login]",
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			login := strings.TrimSpace(cmd.Args().Get(0))
+
+			var (
+				fingerprint string
+				err         error
+			)
+
+			if len(login) == 0 {
+				key, keyErr := k.keyProvider.Get(ctx)
+				if keyErr != nil {
+					return keyErr
+				}
+
+				fingerprint, err = k.userKeysUC.Fingerprint(ctx, key)
+			} else {
+				fingerprint, err = k.userKeysUC.RecipientFingerprint(ctx, login)
+			}
+
+			if err != nil {
+				return cli.Exit(err, 1)
+			}
+
+			_, err = fmt.Fprintln(cmd.Writer, fingerprint)
+
+			return err
+		},
+	}
+}
+
+func (k *KeysCommands) forget() *cli.Command {
+	return &cli.Command{
+		Name:      "forget",
+		Usage:     "unpin the key of another user after they legitimately changed it",
+		ArgsUsage: "<login>",
+		Flags: []cli.Flag{
+			&cli.BoolFlag{
+				Name:    "yes",
+				Aliases: []string{"y"},
+				Usage:   "do not ask confirmation",
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			login := strings.TrimSpace(cmd.Args().Get(0))
+			if len(login) == 0 {
+				return cli.Exit("got empty login", 1)
+			}
+
+			fingerprint, err := k.userKeysUC.RecipientFingerprint(ctx, login)
+			if err != nil {
+				return cli.Exit(err, 1)
+			}
+
+			if !cmd.Bool("yes") {
+				confirmed, err := components.BoolPrompt(fmt.Sprintf(keysForgetPromptTemplate, login, fingerprint))
+				if err != nil {
+					return err
+				}
+
+				if !confirmed {
+					return nil
+				}
+			}
+
+			return k.userKeysUC.ForgetRecipientKey(ctx, login)
+		},
+	}
+}
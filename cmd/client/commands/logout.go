@@ -0,0 +1,113 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/llravell/go-pass/internal/entity"
+	usecase "github.com/llravell/go-pass/internal/usecase/client"
+	"github.com/llravell/go-pass/pkg/keyagent"
+	"github.com/urfave/cli/v3"
+)
+
+const agentLockTimeout = time.Second
+
+var ErrUnsyncedChanges = errors.New("local vault has unsynced changes, run `gopass passwords sync` or pass --force to discard them")
+
+type LogoutCommands struct {
+	authUC      *usecase.AuthUseCase
+	passwordsUC *usecase.PasswordsUseCase
+	agentClient *keyagent.Client
+}
+
+func NewLogoutCommands(
+	authUC *usecase.AuthUseCase,
+	passwordsUC *usecase.PasswordsUseCase,
+	agentClient *keyagent.Client,
+) *LogoutCommands {
+	return &LogoutCommands{
+		authUC:      authUC,
+		passwordsUC: passwordsUC,
+		agentClient: agentClient,
+	}
+}
+
+func (l *LogoutCommands) Logout() *cli.Command {
+	return &cli.Command{
+		Name:  "logout",
+		Usage: "end session on this machine and revoke it on server",
+		Flags: []cli.Flag{
+			&cli.BoolFlag{
+				Name:  "wipe",
+				Usage: "also securely delete local vault",
+			},
+			&cli.BoolFlag{
+				Name:  "force",
+				Usage: "wipe even if local vault has unsynced changes",
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			wipe := cmd.Bool("wipe")
+
+			if wipe && !cmd.Bool("force") {
+				if err := l.checkPendingChanges(ctx, cmd); err != nil {
+					return cli.Exit(err, 1)
+				}
+			}
+
+			err := l.authUC.Logout(ctx)
+
+			switch {
+			case errors.Is(err, entity.ErrServerLogoutFailed):
+				fmt.Fprintf(cmd.ErrWriter, "warning: %s, revoke this device with `gopass devices revoke` later\n", err)
+			case errors.Is(err, entity.ErrNoSession) && wipe:
+			case err != nil:
+				return cli.Exit(err, 1)
+			}
+
+			// ключ из кеша агента больше не нужен, агент может быть не запущен
+			lockCtx, cancel := context.WithTimeout(ctx, agentLockTimeout)
+			_ = l.agentClient.Lock(lockCtx)
+
+			cancel()
+
+			if !wipe {
+				return nil
+			}
+
+			if err = l.passwordsUC.WipeLocal(ctx); err != nil {
+				return cli.Exit(err, 1)
+			}
+
+			_, err = fmt.Fprintln(cmd.Writer, "local vault wiped")
+
+			return err
+		},
+	}
+}
+
+func (l *LogoutCommands) checkPendingChanges(ctx context.Context, cmd *cli.Command) error {
+	pending, err := l.passwordsUC.GetPendingChanges(ctx)
+	if err != nil {
+		return fmt.Errorf("can't check unsynced changes (%w), pass --force to wipe anyway", err)
+	}
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	fmt.Fprintln(cmd.ErrWriter, "Unsynced local changes:")
+
+	for _, password := range pending {
+		change := "modified"
+		if password.Deleted {
+			change = "deleted"
+		}
+
+		fmt.Fprintf(cmd.ErrWriter, "  %s (v%d, %s)\n", password.Name, password.Version, change)
+	}
+
+	return ErrUnsyncedChanges
+}
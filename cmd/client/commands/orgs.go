@@ -0,0 +1,266 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/llravell/go-pass/cmd/client/components"
+	"github.com/llravell/go-pass/internal/entity"
+	usecase "github.com/llravell/go-pass/internal/usecase/client"
+	"github.com/urfave/cli/v3"
+)
+
+type OrgsCommands struct {
+	orgsUC      *usecase.OrgsUseCase
+	keyProvider *components.EncryptionKeyProvider
+}
+
+func NewOrgsCommands(
+	orgsUC *usecase.OrgsUseCase,
+	keyProvider *components.EncryptionKeyProvider,
+) *OrgsCommands {
+	return &OrgsCommands{
+		orgsUC:      orgsUC,
+		keyProvider: keyProvider,
+	}
+}
+
+func (o *OrgsCommands) Org() *cli.Command {
+	return &cli.Command{
+		Name:  "org",
+		Usage: "manage organizations, their members and collections",
+		Commands: []*cli.Command{
+			o.create(),
+			o.list(),
+			o.members(),
+			o.addMember(),
+			o.removeMember(),
+			o.collection(),
+		},
+	}
+}
+
+func (o *OrgsCommands) create() *cli.Command {
+	return &cli.Command{
+		Name:      "create",
+		Usage:     "create organization, you become its owner",
+		ArgsUsage: "<org>",
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			name := strings.TrimSpace(cmd.Args().Get(0))
+			if len(name) == 0 {
+				return cli.Exit("got empty name", 1)
+			}
+
+			if _, err := o.orgsUC.Create(ctx, name); err != nil {
+				return cli.Exit(err, 1)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (o *OrgsCommands) list() *cli.Command {
+	return &cli.Command{
+		Name: "list",
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			orgs, err := o.orgsUC.GetList(ctx)
+			if err != nil {
+				return cli.Exit(err, 1)
+			}
+
+			collections, err := o.orgsUC.GetCollections(ctx)
+			if err != nil {
+				return cli.Exit(err, 1)
+			}
+
+			writer := tabwriter.NewWriter(cmd.Writer, 0, 0, 2, ' ', 0)
+
+			fmt.Fprintln(writer, "ORGANIZATION\tROLE\tCOLLECTIONS")
+
+			for _, org := range orgs {
+				names := make([]string, 0)
+
+				for _, collection := range collections {
+					if collection.OrgID == org.ID {
+						names = append(names, collection.Name)
+					}
+				}
+
+				fmt.Fprintf(writer, "%s\t%s\t%s\n", org.Name, org.Role, strings.Join(names, ", "))
+			}
+
+			return writer.Flush()
+		},
+	}
+}
+
+func (o *OrgsCommands) members() *cli.Command {
+	return &cli.Command{
+		Name:      "members",
+		ArgsUsage: "<org>",
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			org := strings.TrimSpace(cmd.Args().Get(0))
+			if len(org) == 0 {
+				return cli.Exit("got empty organization", 1)
+			}
+
+			members, err := o.orgsUC.GetMembers(ctx, org)
+			if err != nil {
+				return cli.Exit(err, 1)
+			}
+
+			writer := tabwriter.NewWriter(cmd.Writer, 0, 0, 2, ' ', 0)
+
+			fmt.Fprintln(writer, "LOGIN\tROLE")
+
+			for _, member := range members {
+				fmt.Fprintf(writer, "%s\t%s\n", member.Login, member.Role)
+			}
+
+			return writer.Flush()
+		},
+	}
+}
+
+func (o *OrgsCommands) addMember() *cli.Command {
+	return &cli.Command{
+		Name:      "add-member",
+		Usage:     "add user to organization or change their role",
+		ArgsUsage: "<org> <login>",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "role",
+				Usage: "owner, admin, editor or viewer",
+				Value: entity.OrgRoleViewer.String(),
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			org := strings.TrimSpace(cmd.Args().Get(0))
+			login := strings.TrimSpace(cmd.Args().Get(1))
+
+			if len(org) == 0 || len(login) == 0 {
+				return cli.Exit("got invalid args", 1)
+			}
+
+			role, err := entity.ParseOrgRole(cmd.String("role"))
+			if err != nil {
+				return cli.Exit(err, 1)
+			}
+
+			if err = o.orgsUC.SetMember(ctx, org, login, role); err != nil {
+				return cli.Exit(err, 1)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (o *OrgsCommands) removeMember() *cli.Command {
+	return &cli.Command{
+		Name:      "remove-member",
+		ArgsUsage: "<org> <login>",
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			org := strings.TrimSpace(cmd.Args().Get(0))
+			login := strings.TrimSpace(cmd.Args().Get(1))
+
+			if len(org) == 0 || len(login) == 0 {
+				return cli.Exit("got invalid args", 1)
+			}
+
+			if err := o.orgsUC.RemoveMember(ctx, org, login); err != nil {
+				return cli.Exit(err, 1)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (o *OrgsCommands) collection() *cli.Command {
+	return &cli.Command{
+		Name:  "collection",
+		Usage: "manage organization collections",
+		Commands: []*cli.Command{
+			{
+				Name:      "create",
+				ArgsUsage: "<org>/<collection>",
+				Action: func(ctx context.Context, cmd *cli.Command) error {
+					org, name, ok := entity.ParseCollectionPath(strings.TrimSpace(cmd.Args().Get(0)))
+					if !ok {
+						return cli.Exit("collection must be given as org/collection", 1)
+					}
+
+					key, err := o.keyProvider.Get(ctx)
+					if err != nil {
+						return err
+					}
+
+					if _, err = o.orgsUC.CreateCollection(ctx, key, org, name); err != nil {
+						return cli.Exit(err, 1)
+					}
+
+					return nil
+				},
+			},
+			{
+				Name:      "grant",
+				Usage:     "give organization member access to the collection",
+				ArgsUsage: "<org>/<collection> <login>",
+				Action: func(ctx context.Context, cmd *cli.Command) error {
+					collection, login, err := o.collectionAndLogin(ctx, cmd)
+					if err != nil {
+						return err
+					}
+
+					key, err := o.keyProvider.Get(ctx)
+					if err != nil {
+						return err
+					}
+
+					if err = o.orgsUC.Grant(ctx, key, collection, login); err != nil {
+						return cli.Exit(err, 1)
+					}
+
+					return nil
+				},
+			},
+			{
+				Name:      "revoke",
+				ArgsUsage: "<org>/<collection> <login>",
+				Action: func(ctx context.Context, cmd *cli.Command) error {
+					collection, login, err := o.collectionAndLogin(ctx, cmd)
+					if err != nil {
+						return err
+					}
+
+					if err = o.orgsUC.Revoke(ctx, collection, login); err != nil {
+						return cli.Exit(err, 1)
+					}
+
+					return nil
+				},
+			},
+		},
+	}
+}
+
+func (o *OrgsCommands) collectionAndLogin(
+	ctx context.Context,
+	cmd *cli.Command,
+) (*entity.Collection, string, error) {
+	login := strings.TrimSpace(cmd.Args().Get(1))
+	if len(login) == 0 {
+		return nil, "", cli.Exit("got empty login", 1)
+	}
+
+	collection, err := o.orgsUC.FindCollection(ctx, strings.TrimSpace(cmd.Args().Get(0)))
+	if err != nil {
+		return nil, "", cli.Exit(err, 1)
+	}
+
+	return collection, login, nil
+}
@@ -11,11 +11,14 @@ import (
 	"github.com/llravell/go-pass/cmd/client/components"
 	"github.com/llravell/go-pass/internal/entity"
 	usecase "github.com/llravell/go-pass/internal/usecase/client"
+	"github.com/llravell/go-pass/pkg/encryption"
 	"github.com/urfave/cli/v3"
 )
 
 var ErrUnexpectedConflictType = errors.New("got unexpected conflict type")
 
+var ErrShareConflict = errors.New("shared entry was changed by both sides, your version was kept")
+
 var deleteConflictPromptTemplate = `
 Password "%s" has been deleted.
 Do you want to recover it?
@@ -37,15 +40,21 @@ Do you want to override server version?
 
 type PasswordsCommands struct {
 	passwordsUC *usecase.PasswordsUseCase
+	sharesUC    *usecase.SharesUseCase
+	orgsUC      *usecase.OrgsUseCase
 	keyProvider *components.EncryptionKeyProvider
 }
 
 func NewPasswordsCommands(
 	passwordsUC *usecase.PasswordsUseCase,
+	sharesUC *usecase.SharesUseCase,
+	orgsUC *usecase.OrgsUseCase,
 	keyProvider *components.EncryptionKeyProvider,
 ) *PasswordsCommands {
 	return &PasswordsCommands{
 		passwordsUC: passwordsUC,
+		sharesUC:    sharesUC,
+		orgsUC:      orgsUC,
 		keyProvider: keyProvider,
 	}
 }
@@ -59,7 +68,17 @@ func (p *PasswordsCommands) List() *cli.Command {
 				return err
 			}
 
-			if len(passwords) == 0 {
+			shares, err := p.sharesUC.GetIncoming(ctx)
+			if err != nil {
+				return err
+			}
+
+			collections, err := p.orgsUC.GetAvailableCollections(ctx)
+			if err != nil {
+				return err
+			}
+
+			if len(passwords) == 0 && len(shares) == 0 && len(collections) == 0 {
 				_, err = cmd.Writer.Write([]byte("you don't have any passwords yet\n"))
 
 				return err
@@ -74,6 +93,27 @@ func (p *PasswordsCommands) List() *cli.Command {
 				}
 			}
 
+			for _, share := range shares {
+				_, err = writer.WriteString(fmt.Sprintf("%s (shared by %s, %s)\n", share.Address(), share.Owner, share.Permission))
+				if err != nil {
+					return err
+				}
+			}
+
+			for _, collection := range collections {
+				collectionPasswords, err := p.passwordsUC.GetCollectionList(ctx, collection.ID)
+				if err != nil {
+					return err
+				}
+
+				for _, password := range collectionPasswords {
+					_, err = writer.WriteString(fmt.Sprintf("[%s] %s\n", collection.Path(), password.Name))
+					if err != nil {
+						return err
+					}
+				}
+			}
+
 			writer.Flush()
 
 			return nil
@@ -84,12 +124,26 @@ func (p *PasswordsCommands) List() *cli.Command {
 func (p *PasswordsCommands) Show() *cli.Command {
 	return &cli.Command{
 		Name: "show",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "collection",
+				Usage: "organization collection as org/collection",
+			},
+		},
 		Action: func(ctx context.Context, cmd *cli.Command) error {
 			name := strings.TrimSpace(cmd.Args().Get(0))
 			if len(name) == 0 {
 				return cli.Exit("got empty name", 1)
 			}
 
+			if path := cmd.String("collection"); len(path) > 0 {
+				return p.showInCollection(ctx, cmd, path, name)
+			}
+
+			if _, _, ok := entity.ParseShareAddress(name); ok {
+				return p.showShared(ctx, cmd, name)
+			}
+
 			pass, err := p.passwordsUC.GetPasswordByName(ctx, name)
 			if err != nil {
 				return err
@@ -119,16 +173,30 @@ func (p *PasswordsCommands) Add() *cli.Command {
 				Name:    "meta",
 				Aliases: []string{"m"},
 			},
+			&cli.StringFlag{
+				Name:  "collection",
+				Usage: "organization collection as org/collection",
+			},
 		},
 		Action: func(ctx context.Context, cmd *cli.Command) error {
 			name := strings.TrimSpace(cmd.Args().Get(0))
 			rawPassword := strings.TrimSpace(cmd.Args().Get(1))
 			meta := strings.TrimSpace(cmd.String("meta"))
 
-			if len(name) == 0 || len(rawPassword) == 0 {
+			if len(name) == 0 {
 				return cli.Exit("got invalid args", 1)
 			}
 
+			// значение не передано аргументом, чтобы не светить его в ps и истории shell
+			if len(rawPassword) == 0 {
+				var err error
+
+				rawPassword, err = components.ReadSecret(fmt.Sprintf("Enter value for %s: ", name))
+				if err != nil {
+					return cli.Exit(err, 1)
+				}
+			}
+
 			password := entity.Password{
 				Name:    name,
 				Value:   rawPassword,
@@ -136,6 +204,10 @@ func (p *PasswordsCommands) Add() *cli.Command {
 				Version: 1,
 			}
 
+			if path := cmd.String("collection"); len(path) > 0 {
+				return p.addToCollection(ctx, path, &password)
+			}
+
 			key, err := p.keyProvider.Get(ctx)
 			if err != nil {
 				return err
@@ -164,12 +236,26 @@ func (p *PasswordsCommands) Add() *cli.Command {
 func (p *PasswordsCommands) Edit() *cli.Command {
 	return &cli.Command{
 		Name: "edit",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "collection",
+				Usage: "organization collection as org/collection",
+			},
+		},
 		Action: func(ctx context.Context, cmd *cli.Command) error {
 			name := strings.TrimSpace(cmd.Args().Get(0))
 			if len(name) == 0 {
 				return cli.Exit("got empty name", 1)
 			}
 
+			if path := cmd.String("collection"); len(path) > 0 {
+				return p.editInCollection(ctx, path, name)
+			}
+
+			if _, _, ok := entity.ParseShareAddress(name); ok {
+				return p.editShared(ctx, name)
+			}
+
 			pass, err := p.passwordsUC.GetPasswordByName(ctx, name)
 			if err != nil {
 				return err
@@ -184,14 +270,12 @@ func (p *PasswordsCommands) Edit() *cli.Command {
 				return err
 			}
 
-			updatedText, err := components.EditViaVI(p.buildPasswordEditText(pass))
+			updatedText, err := components.EditViaVI(pass.EditText())
 			if err != nil {
 				return err
 			}
 
-			if err = p.parsePasswordEditText(updatedText, pass); err != nil {
-				return err
-			}
+			pass.ApplyEditText(updatedText)
 
 			if err = pass.Close(key); err != nil {
 				return err
@@ -218,12 +302,27 @@ func (p *PasswordsCommands) Edit() *cli.Command {
 func (p *PasswordsCommands) Delete() *cli.Command {
 	return &cli.Command{
 		Name: "delete",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "collection",
+				Usage: "organization collection as org/collection",
+			},
+		},
 		Action: func(ctx context.Context, cmd *cli.Command) error {
 			name := strings.TrimSpace(cmd.Args().Get(0))
 			if len(name) == 0 {
 				return cli.Exit("got empty name", 1)
 			}
 
+			if path := cmd.String("collection"); len(path) > 0 {
+				collection, err := p.orgsUC.FindCollection(ctx, path)
+				if err != nil {
+					return cli.Exit(err, 1)
+				}
+
+				return p.passwordsUC.DeleteCollectionPassword(ctx, collection.ID, name)
+			}
+
 			return p.passwordsUC.DeletePasswordByName(ctx, name)
 		},
 	}
@@ -261,6 +360,24 @@ func (p *PasswordsCommands) Sync() *cli.Command {
 				return nil
 			}
 
+			sharesResult, err := p.syncShares(ctx)
+			if err != nil {
+				operationErrors = append(operationErrors, err)
+			} else {
+				if _, err = w.WriteString(fmt.Sprintf(
+					"Shared: %d pushed, %d pulled, %d incoming\n",
+					sharesResult.Pushed,
+					sharesResult.Pulled,
+					sharesResult.Incoming,
+				)); err != nil {
+					return nil
+				}
+
+				for _, conflict := range sharesResult.Conflicts {
+					operationErrors = append(operationErrors, fmt.Errorf("%w: %s", ErrShareConflict, conflict))
+				}
+			}
+
 			if len(operationErrors) > 0 {
 				if _, err = w.WriteString("-------------------------\n"); err != nil {
 					return err
@@ -278,33 +395,166 @@ func (p *PasswordsCommands) Sync() *cli.Command {
 	}
 }
 
-func (p *PasswordsCommands) buildPasswordEditText(
-	password *entity.Password,
-) string {
-	return fmt.Sprintf("%s\n%s", password.Value, password.Meta)
+func (p *PasswordsCommands) syncShares(ctx context.Context) (*usecase.SharesSyncResult, error) {
+	key, err := p.keyProvider.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.sharesUC.Sync(ctx, key)
 }
 
-func (p *PasswordsCommands) parsePasswordEditText(
-	text string,
-	password *entity.Password,
-) error {
-	var rawPassword, meta string
+func (p *PasswordsCommands) showShared(ctx context.Context, cmd *cli.Command, address string) error {
+	share, err := p.sharesUC.GetIncomingByAddress(ctx, address)
+	if err != nil {
+		return err
+	}
+
+	key, err := p.keyProvider.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err = share.Open(key); err != nil {
+		return err
+	}
+
+	_, err = cmd.Writer.Write([]byte(share.Value + "\n"))
+
+	return err
+}
 
-	parts := strings.Split(text, "\n")
-	if len(parts) == 0 {
-		return cli.Exit("empty password", 1)
+func (p *PasswordsCommands) editShared(ctx context.Context, address string) error {
+	share, err := p.sharesUC.GetIncomingByAddress(ctx, address)
+	if err != nil {
+		return err
+	}
+
+	if !share.Writable() {
+		return cli.Exit(entity.ErrShareReadOnly, 1)
+	}
+
+	key, err := p.keyProvider.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err = share.Open(key); err != nil {
+		return err
+	}
+
+	pass := &entity.Password{Value: share.Value, Meta: share.Meta}
+
+	updatedText, err := components.EditViaVI(pass.EditText())
+	if err != nil {
+		return err
 	}
 
-	rawPassword = strings.TrimSpace(parts[0])
+	pass.ApplyEditText(updatedText)
 
-	if len(parts) > 1 {
-		meta = strings.TrimSpace(parts[1])
+	share.Value = pass.Value
+	share.Meta = pass.Meta
+
+	return p.sharesUC.UpdateIncoming(ctx, key, share)
+}
+
+// openCollection находит коллекцию и расшифровывает ее ключ.
+func (p *PasswordsCommands) openCollection(
+	ctx context.Context,
+	path string,
+) (*entity.Collection, *encryption.Key, error) {
+	collection, err := p.orgsUC.FindCollection(ctx, path)
+	if err != nil {
+		return nil, nil, cli.Exit(err, 1)
+	}
+
+	vaultKey, err := p.keyProvider.Get(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key, err := p.orgsUC.CollectionKey(ctx, vaultKey, collection)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return collection, key, nil
+}
+
+func (p *PasswordsCommands) showInCollection(ctx context.Context, cmd *cli.Command, path, name string) error {
+	collection, key, err := p.openCollection(ctx, path)
+	if err != nil {
+		return err
+	}
+
+	pass, err := p.passwordsUC.GetCollectionPasswordByName(ctx, collection.ID, name)
+	if err != nil {
+		return err
+	}
+
+	if err = pass.Open(key); err != nil {
+		return err
+	}
+
+	_, err = cmd.Writer.Write([]byte(pass.Value + "\n"))
+
+	return err
+}
+
+func (p *PasswordsCommands) addToCollection(ctx context.Context, path string, password *entity.Password) error {
+	collection, key, err := p.openCollection(ctx, path)
+	if err != nil {
+		return err
+	}
+
+	if !collection.Role.CanWrite() {
+		return cli.Exit(entity.ErrPermissionDenied, 1)
+	}
+
+	password.CollectionID = collection.ID
+
+	if err = password.Close(key); err != nil {
+		return err
+	}
+
+	return p.passwordsUC.AddCollectionPassword(ctx, password)
+}
+
+// editInCollection правит запись коллекции сразу на сервере, при конфликте
+// изменение не сохраняется: записи коллекций не хранятся локально.
+func (p *PasswordsCommands) editInCollection(ctx context.Context, path, name string) error {
+	collection, key, err := p.openCollection(ctx, path)
+	if err != nil {
+		return err
+	}
+
+	if !collection.Role.CanWrite() {
+		return cli.Exit(entity.ErrPermissionDenied, 1)
+	}
+
+	pass, err := p.passwordsUC.GetCollectionPasswordByName(ctx, collection.ID, name)
+	if err != nil {
+		return err
+	}
+
+	if err = pass.Open(key); err != nil {
+		return err
+	}
+
+	updatedText, err := components.EditViaVI(pass.EditText())
+	if err != nil {
+		return err
+	}
+
+	pass.ApplyEditText(updatedText)
+
+	if err = pass.Close(key); err != nil {
+		return err
 	}
 
-	password.Value = rawPassword
-	password.Meta = meta
+	pass.BumpVersion()
 
-	return nil
+	return p.passwordsUC.UpdateCollectionPassword(ctx, pass)
 }
 
 func (p *PasswordsCommands) resolveConflict(
@@ -0,0 +1,166 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/llravell/go-pass/cmd/client/components"
+	usecase "github.com/llravell/go-pass/internal/usecase/client"
+	"github.com/urfave/cli/v3"
+)
+
+const (
+	defaultSendExpiration = time.Hour
+	defaultSendMaxViews   = 1
+)
+
+type SendsCommands struct {
+	sendsUC     *usecase.SendsUseCase
+	passwordsUC *usecase.PasswordsUseCase
+	keyProvider *components.EncryptionKeyProvider
+}
+
+func NewSendsCommands(
+	sendsUC *usecase.SendsUseCase,
+	passwordsUC *usecase.PasswordsUseCase,
+	keyProvider *components.EncryptionKeyProvider,
+) *SendsCommands {
+	return &SendsCommands{
+		sendsUC:     sendsUC,
+		passwordsUC: passwordsUC,
+		keyProvider: keyProvider,
+	}
+}
+
+func (s *SendsCommands) Send() *cli.Command {
+	return &cli.Command{
+		Name:      "send",
+		Usage:     "create a self-destructing link to the entry for someone without go-pass",
+		ArgsUsage: "<name>",
+		Flags: []cli.Flag{
+			&cli.DurationFlag{
+				Name:  "expires",
+				Usage: "link lifetime",
+				Value: defaultSendExpiration,
+			},
+			&cli.IntFlag{
+				Name:  "max-views",
+				Usage: "number of times the link can be opened",
+				Value: defaultSendMaxViews,
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			name := strings.TrimSpace(cmd.Args().Get(0))
+			if len(name) == 0 {
+				return cli.Exit("got empty name", 1)
+			}
+
+			pass, err := s.passwordsUC.GetPasswordByName(ctx, name)
+			if err != nil {
+				return err
+			}
+
+			key, err := s.keyProvider.Get(ctx)
+			if err != nil {
+				return err
+			}
+
+			if err = pass.Open(key); err != nil {
+				return err
+			}
+
+			text := pass.Value
+			if len(pass.Meta) > 0 {
+				text += "\n" + pass.Meta
+			}
+
+			link, expiresAt, err := s.sendsUC.Create(ctx, text, cmd.Duration("expires"), int(cmd.Int("max-views")))
+			if err != nil {
+				return cli.Exit(err, 1)
+			}
+
+			_, err = fmt.Fprintf(cmd.Writer, "%s\nexpires at %s\n", link, expiresAt.Local().Format(time.DateTime))
+
+			return err
+		},
+	}
+}
+
+func (s *SendsCommands) Receive() *cli.Command {
+	return &cli.Command{
+		Name:      "receive",
+		Usage:     "open a send link",
+		ArgsUsage: "<link>",
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			link := strings.TrimSpace(cmd.Args().Get(0))
+			if len(link) == 0 {
+				return cli.Exit("got empty link", 1)
+			}
+
+			text, err := s.sendsUC.Receive(ctx, link)
+			if err != nil {
+				return cli.Exit(err, 1)
+			}
+
+			_, err = fmt.Fprintln(cmd.Writer, text)
+
+			return err
+		},
+	}
+}
+
+func (s *SendsCommands) Sends() *cli.Command {
+	return &cli.Command{
+		Name:  "sends",
+		Usage: "manage links created with send",
+		Commands: []*cli.Command{
+			{
+				Name: "list",
+				Action: func(ctx context.Context, cmd *cli.Command) error {
+					sends, err := s.sendsUC.GetList(ctx)
+					if err != nil {
+						return cli.Exit(err, 1)
+					}
+
+					writer := tabwriter.NewWriter(cmd.Writer, 0, 0, 2, ' ', 0)
+
+					fmt.Fprintln(writer, "ID\tVIEWS\tCREATED\tEXPIRES")
+
+					for _, send := range sends {
+						fmt.Fprintf(
+							writer,
+							"%s\t%d/%d\t%s\t%s\n",
+							send.ID,
+							send.Views,
+							send.MaxViews,
+							send.CreatedAt.Local().Format(time.DateTime),
+							send.ExpiresAt.Local().Format(time.DateTime),
+						)
+					}
+
+					return writer.Flush()
+				},
+			},
+			{
+				Name:      "delete",
+				Usage:     "destroy the link before it expires",
+				ArgsUsage: "<id>",
+				Action: func(ctx context.Context, cmd *cli.Command) error {
+					id := strings.TrimSpace(cmd.Args().Get(0))
+					if len(id) == 0 {
+						return cli.Exit("got empty id", 1)
+					}
+
+					if err := s.sendsUC.Delete(ctx, id); err != nil {
+						return cli.Exit(err, 1)
+					}
+
+					return nil
+				},
+			},
+		},
+	}
+}
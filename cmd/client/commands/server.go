@@ -0,0 +1,97 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/llravell/go-pass/cmd/client/components"
+	"github.com/llravell/go-pass/config"
+	"github.com/llravell/go-pass/internal/grpc/client"
+	usecase "github.com/llravell/go-pass/internal/usecase/client"
+	"github.com/urfave/cli/v3"
+)
+
+var ErrTrustInsecure = errors.New("server connection is insecure, nothing to pin")
+
+var serverTrustPromptTemplate = `
+Pinned server key: %s
+Current server key: %s
+Trust the current key?`
+
+type ServerCommands struct {
+	authUC  *usecase.AuthUseCase
+	profile *config.ClientProfile
+}
+
+func NewServerCommands(authUC *usecase.AuthUseCase, profile *config.ClientProfile) *ServerCommands {
+	return &ServerCommands{
+		authUC:  authUC,
+		profile: profile,
+	}
+}
+
+func (s *ServerCommands) Server() *cli.Command {
+	return &cli.Command{
+		Name: "server",
+		Commands: []*cli.Command{
+			s.trust(),
+		},
+	}
+}
+
+func (s *ServerCommands) trust() *cli.Command {
+	return &cli.Command{
+		Name:  "trust",
+		Usage: "show pinned server key fingerprint or re-pin it after a legitimate key rotation",
+		Flags: []cli.Flag{
+			&cli.BoolFlag{
+				Name:  "reset",
+				Usage: "pin the key currently presented by server",
+			},
+			&cli.BoolFlag{
+				Name:    "yes",
+				Aliases: []string{"y"},
+				Usage:   "do not ask confirmation",
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			pinned, err := s.authUC.GetServerPin(ctx)
+			if err != nil {
+				return err
+			}
+
+			if !cmd.Bool("reset") {
+				if len(pinned) == 0 {
+					pinned = "<none>"
+				}
+
+				_, err = fmt.Fprintln(cmd.Writer, pinned)
+
+				return err
+			}
+
+			if s.profile.TLS.Insecure {
+				return cli.Exit(ErrTrustInsecure, 1)
+			}
+
+			current, err := client.FetchServerFingerprint(ctx, s.profile.Server, s.profile.TLS.ServerName)
+			if err != nil {
+				return err
+			}
+
+			if !cmd.Bool("yes") {
+				confirmed, err := components.BoolPrompt(fmt.Sprintf(serverTrustPromptTemplate, pinned, current))
+				if err != nil {
+					return err
+				}
+
+				if !confirmed {
+					return nil
+				}
+			}
+
+			return s.authUC.SetServerPin(ctx, current)
+		},
+	}
+}
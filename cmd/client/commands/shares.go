@@ -0,0 +1,142 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/llravell/go-pass/cmd/client/components"
+	"github.com/llravell/go-pass/internal/entity"
+	usecase "github.com/llravell/go-pass/internal/usecase/client"
+	"github.com/urfave/cli/v3"
+)
+
+type SharesCommands struct {
+	sharesUC    *usecase.SharesUseCase
+	keyProvider *components.EncryptionKeyProvider
+}
+
+func NewSharesCommands(
+	sharesUC *usecase.SharesUseCase,
+	keyProvider *components.EncryptionKeyProvider,
+) *SharesCommands {
+	return &SharesCommands{
+		sharesUC:    sharesUC,
+		keyProvider: keyProvider,
+	}
+}
+
+func (s *SharesCommands) Share() *cli.Command {
+	return &cli.Command{
+		Name:      "share",
+		Usage:     "share the entry with another go-pass user",
+		ArgsUsage: "<name>",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     "with",
+				Usage:    "recipient login",
+				Required: true,
+			},
+			&cli.BoolFlag{
+				Name:  "write",
+				Usage: "allow the recipient to edit the entry",
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			name := strings.TrimSpace(cmd.Args().Get(0))
+			if len(name) == 0 {
+				return cli.Exit("got empty name", 1)
+			}
+
+			permission := entity.SharePermissionRead
+			if cmd.Bool("write") {
+				permission = entity.SharePermissionReadWrite
+			}
+
+			key, err := s.keyProvider.Get(ctx)
+			if err != nil {
+				return err
+			}
+
+			share, err := s.sharesUC.Share(ctx, key, name, strings.TrimSpace(cmd.String("with")), permission)
+			if err != nil {
+				return cli.Exit(err, 1)
+			}
+
+			_, err = fmt.Fprintf(
+				cmd.Writer,
+				"%s is shared with %s (%s), it will appear as %s after their sync\n",
+				share.Name,
+				share.Recipient,
+				share.Permission,
+				share.Address(),
+			)
+
+			return err
+		},
+	}
+}
+
+func (s *SharesCommands) Shares() *cli.Command {
+	return &cli.Command{
+		Name:  "shares",
+		Usage: "manage entries shared by you and with you",
+		Commands: []*cli.Command{
+			s.list(),
+			s.revoke(),
+		},
+	}
+}
+
+func (s *SharesCommands) list() *cli.Command {
+	return &cli.Command{
+		Name: "list",
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			outgoing, err := s.sharesUC.GetOutgoing(ctx)
+			if err != nil {
+				return cli.Exit(err, 1)
+			}
+
+			incoming, err := s.sharesUC.GetIncoming(ctx)
+			if err != nil {
+				return cli.Exit(err, 1)
+			}
+
+			writer := tabwriter.NewWriter(cmd.Writer, 0, 0, 2, ' ', 0)
+
+			fmt.Fprintln(writer, "ID\tENTRY\tSHARED WITH\tSHARED BY\tPERMISSION")
+
+			for _, share := range outgoing {
+				fmt.Fprintf(writer, "%d\t%s\t%s\t-\t%s\n", share.ID, share.Name, share.Recipient, share.Permission)
+			}
+
+			for _, share := range incoming {
+				fmt.Fprintf(writer, "%d\t%s\t-\t%s\t%s\n", share.ID, share.Address(), share.Owner, share.Permission)
+			}
+
+			return writer.Flush()
+		},
+	}
+}
+
+func (s *SharesCommands) revoke() *cli.Command {
+	return &cli.Command{
+		Name:      "revoke",
+		Usage:     "stop sharing the entry, recipients can also remove entries shared with them",
+		ArgsUsage: "<id>",
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			id, err := strconv.Atoi(strings.TrimSpace(cmd.Args().Get(0)))
+			if err != nil {
+				return cli.Exit("got invalid share id", 1)
+			}
+
+			if err = s.sharesUC.Revoke(ctx, id); err != nil {
+				return cli.Exit(err, 1)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,174 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/llravell/go-pass/cmd/client/components"
+	usecase "github.com/llravell/go-pass/internal/usecase/client"
+	"github.com/llravell/go-pass/pkg/sshagent"
+	"github.com/urfave/cli/v3"
+	"golang.org/x/crypto/ssh"
+)
+
+const sshKeysFolder = "ssh"
+
+var ErrNoSSHKeys = errors.New("no ssh keys found in vault")
+
+var sshSignConfirmPromptTemplate = `
+Allow signing with key "%s" (%s)?`
+
+type SSHAgentCommands struct {
+	passwordsUC       *usecase.PasswordsUseCase
+	keyProvider       *components.EncryptionKeyProvider
+	defaultSocketPath string
+}
+
+func NewSSHAgentCommands(
+	passwordsUC *usecase.PasswordsUseCase,
+	keyProvider *components.EncryptionKeyProvider,
+	defaultSocketPath string,
+) *SSHAgentCommands {
+	return &SSHAgentCommands{
+		passwordsUC:       passwordsUC,
+		keyProvider:       keyProvider,
+		defaultSocketPath: defaultSocketPath,
+	}
+}
+
+func (s *SSHAgentCommands) SSHAgent() *cli.Command {
+	return &cli.Command{
+		Name:  "ssh-agent",
+		Usage: "serve ssh agent protocol with private keys stored in the vault",
+		Flags: []cli.Flag{
+			&cli.StringSliceFlag{
+				Name:    "key",
+				Aliases: []string{"k"},
+				Usage:   "entry with a private key, all entries from ssh/ folder if empty",
+			},
+			&cli.StringFlag{
+				Name:    "socket",
+				Aliases: []string{"s"},
+				Value:   s.defaultSocketPath,
+			},
+			&cli.BoolFlag{
+				Name:  "confirm",
+				Usage: "ask confirmation for every signature",
+			},
+			&cli.DurationFlag{
+				Name:  "lifetime",
+				Usage: "remove keys from agent after this duration",
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			var confirm sshagent.Confirmer
+
+			if cmd.Bool("confirm") {
+				confirm = s.confirmSign
+			}
+
+			sshAgent := sshagent.New(confirm)
+
+			loaded, err := s.loadKeys(ctx, cmd, sshAgent)
+			if err != nil {
+				return err
+			}
+
+			socketPath := cmd.String("socket")
+
+			listener, err := s.listen(socketPath)
+			if err != nil {
+				return err
+			}
+
+			defer os.Remove(socketPath)
+
+			fmt.Fprintf(cmd.Writer, "SSH_AUTH_SOCK=%s; export SSH_AUTH_SOCK;\n", socketPath)
+			fmt.Fprintf(cmd.ErrWriter, "agent serves %d keys\n", loaded)
+
+			ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			return sshAgent.Serve(ctx, listener)
+		},
+	}
+}
+
+func (s *SSHAgentCommands) loadKeys(
+	ctx context.Context,
+	cmd *cli.Command,
+	sshAgent *sshagent.Agent,
+) (int, error) {
+	names, err := s.keyNames(ctx, cmd.StringSlice("key"))
+	if err != nil {
+		return 0, err
+	}
+
+	if len(names) == 0 {
+		return 0, ErrNoSSHKeys
+	}
+
+	key, err := s.keyProvider.Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, name := range names {
+		pass, err := s.passwordsUC.GetPasswordByName(ctx, name)
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", name, err)
+		}
+
+		if err = pass.Open(key); err != nil {
+			return 0, fmt.Errorf("%s: %w", name, err)
+		}
+
+		_, err = sshAgent.AddKey([]byte(pass.Value), name, cmd.Duration("lifetime"))
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", name, err)
+		}
+	}
+
+	return len(names), nil
+}
+
+func (s *SSHAgentCommands) keyNames(ctx context.Context, names []string) ([]string, error) {
+	if len(names) > 0 {
+		return names, nil
+	}
+
+	passwords, err := s.passwordsUC.GetList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pass := range passwords {
+		if !pass.Deleted && strings.HasPrefix(pass.Name, sshKeysFolder+"/") {
+			names = append(names, pass.Name)
+		}
+	}
+
+	return names, nil
+}
+
+func (s *SSHAgentCommands) listen(socketPath string) (net.Listener, error) {
+	if err := os.Remove(socketPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	return sshagent.Listen(socketPath)
+}
+
+func (s *SSHAgentCommands) confirmSign(key ssh.PublicKey, comment string) (bool, error) {
+	return components.BoolPrompt(fmt.Sprintf(
+		sshSignConfirmPromptTemplate,
+		comment,
+		ssh.FingerprintSHA256(key),
+	))
+}
@@ -0,0 +1,342 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/llravell/go-pass/cmd/client/components"
+	"github.com/llravell/go-pass/internal/entity"
+	usecase "github.com/llravell/go-pass/internal/usecase/client"
+	tokens "github.com/llravell/go-pass/pkg/auth"
+	"github.com/llravell/go-pass/pkg/encryption"
+	"github.com/urfave/cli/v3"
+)
+
+const (
+	// TokenEnvVar включает режим токена: клиент авторизуется токеном
+	// сервисного аккаунта вместо сессии пользователя.
+	TokenEnvVar = "GOPASS_TOKEN"
+
+	defaultTokenExpiration = 90 * 24 * time.Hour
+)
+
+// TokensCommands управляют токенами сервисных аккаунтов и работают с записями
+// по токену. machineToken задан, если клиент запущен в режиме токена.
+type TokensCommands struct {
+	tokensUC     *usecase.TokensUseCase
+	orgsUC       *usecase.OrgsUseCase
+	passwordsUC  *usecase.PasswordsUseCase
+	keyProvider  *components.EncryptionKeyProvider
+	machineToken *tokens.MachineToken
+}
+
+func NewTokensCommands(
+	tokensUC *usecase.TokensUseCase,
+	orgsUC *usecase.OrgsUseCase,
+	passwordsUC *usecase.PasswordsUseCase,
+	keyProvider *components.EncryptionKeyProvider,
+	machineToken *tokens.MachineToken,
+) *TokensCommands {
+	return &TokensCommands{
+		tokensUC:     tokensUC,
+		orgsUC:       orgsUC,
+		passwordsUC:  passwordsUC,
+		keyProvider:  keyProvider,
+		machineToken: machineToken,
+	}
+}
+
+func (t *TokensCommands) Token() *cli.Command {
+	return &cli.Command{
+		Name:  "token",
+		Usage: "manage machine tokens for CI and services",
+		Commands: []*cli.Command{
+			t.create(),
+			t.list(),
+			t.revoke(),
+			t.entries(),
+			t.get(),
+			t.put(),
+		},
+	}
+}
+
+func (t *TokensCommands) create() *cli.Command {
+	return &cli.Command{
+		Name:      "create",
+		Usage:     "issue a token with access to one collection, it is shown only once",
+		ArgsUsage: "<org/collection>",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     "name",
+				Usage:    "token name, e.g. the service using it",
+				Required: true,
+			},
+			&cli.BoolFlag{
+				Name:  "write",
+				Usage: "allow adding, changing and deleting entries",
+			},
+			&cli.DurationFlag{
+				Name:  "expires",
+				Usage: "token lifetime",
+				Value: defaultTokenExpiration,
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			path := strings.TrimSpace(cmd.Args().Get(0))
+			if len(path) == 0 {
+				return cli.Exit("got empty collection", 1)
+			}
+
+			collection, err := t.orgsUC.FindCollection(ctx, path)
+			if err != nil {
+				return cli.Exit(err, 1)
+			}
+
+			vaultKey, err := t.keyProvider.Get(ctx)
+			if err != nil {
+				return err
+			}
+
+			token, err := t.tokensUC.Create(
+				ctx,
+				vaultKey,
+				collection,
+				strings.TrimSpace(cmd.String("name")),
+				cmd.Bool("write"),
+				cmd.Duration("expires"),
+			)
+			if err != nil {
+				return cli.Exit(err, 1)
+			}
+
+			_, err = fmt.Fprintln(cmd.Writer, token)
+
+			return err
+		},
+	}
+}
+
+func (t *TokensCommands) list() *cli.Command {
+	return &cli.Command{
+		Name: "list",
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			machineTokens, err := t.tokensUC.GetList(ctx)
+			if err != nil {
+				return cli.Exit(err, 1)
+			}
+
+			writer := tabwriter.NewWriter(cmd.Writer, 0, 0, 2, ' ', 0)
+
+			fmt.Fprintln(writer, "ID\tNAME\tCOLLECTION\tACCESS\tEXPIRES\tLAST USED\tSTATUS")
+
+			now := time.Now()
+
+			for _, token := range machineTokens {
+				access := "read"
+				if token.Writable {
+					access = "read-write"
+				}
+
+				lastUsed := "never"
+				if !token.LastUsedAt.IsZero() {
+					lastUsed = token.LastUsedAt.Local().Format(time.DateTime) + " " + token.LastUsedIP
+				}
+
+				tokenStatus := "active"
+
+				switch {
+				case token.Revoked():
+					tokenStatus = "revoked"
+				case token.Expired(now):
+					tokenStatus = "expired"
+				}
+
+				fmt.Fprintf(
+					writer,
+					"%d\t%s\t%s\t%s\t%s\t%s\t%s\n",
+					token.ID,
+					token.Name,
+					token.Collection,
+					access,
+					token.ExpiresAt.Local().Format(time.DateTime),
+					lastUsed,
+					tokenStatus,
+				)
+			}
+
+			return writer.Flush()
+		},
+	}
+}
+
+func (t *TokensCommands) revoke() *cli.Command {
+	return &cli.Command{
+		Name:      "revoke",
+		ArgsUsage: "<id>",
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			id, err := strconv.Atoi(strings.TrimSpace(cmd.Args().Get(0)))
+			if err != nil {
+				return cli.Exit("got invalid id", 1)
+			}
+
+			if err = t.tokensUC.Revoke(ctx, id); err != nil {
+				return cli.Exit(err, 1)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (t *TokensCommands) entries() *cli.Command {
+	return &cli.Command{
+		Name:  "entries",
+		Usage: "list entries available to the token from " + TokenEnvVar,
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			token, _, err := t.open(ctx)
+			if err != nil {
+				return err
+			}
+
+			passwords, err := t.passwordsUC.GetCollectionList(ctx, token.CollectionID)
+			if err != nil {
+				return cli.Exit(err, 1)
+			}
+
+			for _, password := range passwords {
+				if _, err = fmt.Fprintln(cmd.Writer, password.Name); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (t *TokensCommands) get() *cli.Command {
+	return &cli.Command{
+		Name:      "get",
+		Usage:     "print entry value using the token from " + TokenEnvVar,
+		ArgsUsage: "<name>",
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			name := strings.TrimSpace(cmd.Args().Get(0))
+			if len(name) == 0 {
+				return cli.Exit("got empty name", 1)
+			}
+
+			token, key, err := t.open(ctx)
+			if err != nil {
+				return err
+			}
+
+			pass, err := t.passwordsUC.GetCollectionPasswordByName(ctx, token.CollectionID, name)
+			if err != nil {
+				return cli.Exit(err, 1)
+			}
+
+			if err = pass.Open(key); err != nil {
+				return err
+			}
+
+			_, err = cmd.Writer.Write([]byte(pass.Value + "\n"))
+
+			return err
+		},
+	}
+}
+
+func (t *TokensCommands) put() *cli.Command {
+	return &cli.Command{
+		Name:      "put",
+		Usage:     "add or replace entry using the read-write token from " + TokenEnvVar,
+		ArgsUsage: "<name> [value]",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:    "meta",
+				Aliases: []string{"m"},
+			},
+		},
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			name := strings.TrimSpace(cmd.Args().Get(0))
+			value := strings.TrimSpace(cmd.Args().Get(1))
+
+			if len(name) == 0 {
+				return cli.Exit("got empty name", 1)
+			}
+
+			token, key, err := t.open(ctx)
+			if err != nil {
+				return err
+			}
+
+			if !token.Writable {
+				return cli.Exit(entity.ErrMachineTokenScope, 1)
+			}
+
+			if len(value) == 0 {
+				value, err = components.ReadSecret(fmt.Sprintf("Enter value for %s: ", name))
+				if err != nil {
+					return cli.Exit(err, 1)
+				}
+			}
+
+			return t.putEntry(ctx, token, key, &entity.Password{
+				Name:         name,
+				Value:        value,
+				Meta:         strings.TrimSpace(cmd.String("meta")),
+				CollectionID: token.CollectionID,
+			})
+		},
+	}
+}
+
+func (t *TokensCommands) putEntry(
+	ctx context.Context,
+	token *entity.MachineToken,
+	key *encryption.Key,
+	password *entity.Password,
+) error {
+	current, err := t.passwordsUC.GetCollectionPasswordByName(ctx, token.CollectionID, password.Name)
+	if err != nil && !errors.Is(err, entity.ErrPasswordDoesNotExist) {
+		return cli.Exit(err, 1)
+	}
+
+	if err = password.Close(key); err != nil {
+		return err
+	}
+
+	if current == nil {
+		err = t.passwordsUC.AddCollectionPassword(ctx, password)
+	} else {
+		password.Version = current.Version
+		password.BumpVersion()
+
+		err = t.passwordsUC.UpdateCollectionPassword(ctx, password)
+	}
+
+	if err != nil {
+		return cli.Exit(err, 1)
+	}
+
+	return nil
+}
+
+func (t *TokensCommands) open(ctx context.Context) (*entity.MachineToken, *encryption.Key, error) {
+	if t.machineToken == nil {
+		return nil, nil, cli.Exit(TokenEnvVar+" is not set", 1)
+	}
+
+	token, key, err := t.tokensUC.Open(ctx, t.machineToken)
+	if err != nil {
+		return nil, nil, cli.Exit(err, 1)
+	}
+
+	return token, key, nil
+}
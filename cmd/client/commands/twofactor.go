@@ -0,0 +1,107 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/llravell/go-pass/cmd/client/components"
+	"github.com/llravell/go-pass/internal/entity"
+	usecase "github.com/llravell/go-pass/internal/usecase/client"
+	"github.com/urfave/cli/v3"
+)
+
+var twoFactorEnrollmentTemplate = `
+Add this account to your authenticator app:
+%s
+
+Or enter the secret manually: %s
+
+Recovery codes, each can be used once instead of an authenticator code.
+Store them somewhere safe, they will not be shown again:
+  %s
+
+`
+
+func printTwoFactorEnrollment(w io.Writer, enrollment *entity.TwoFactorEnrollment) error {
+	_, err := fmt.Fprintf(
+		w,
+		twoFactorEnrollmentTemplate,
+		enrollment.URI,
+		enrollment.Secret,
+		strings.Join(enrollment.RecoveryCodes, "\n  "),
+	)
+
+	return err
+}
+
+type TwoFactorCommands struct {
+	twoFactorUC *usecase.TwoFactorUseCase
+}
+
+func NewTwoFactorCommands(twoFactorUC *usecase.TwoFactorUseCase) *TwoFactorCommands {
+	return &TwoFactorCommands{
+		twoFactorUC: twoFactorUC,
+	}
+}
+
+func (t *TwoFactorCommands) TwoFactor() *cli.Command {
+	return &cli.Command{
+		Name:  "2fa",
+		Usage: "manage two-factor authentication for server login",
+		Commands: []*cli.Command{
+			t.enroll(),
+			t.disable(),
+		},
+	}
+}
+
+func (t *TwoFactorCommands) enroll() *cli.Command {
+	return &cli.Command{
+		Name: "enroll",
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			enrollment, err := t.twoFactorUC.Enroll(ctx)
+			if err != nil {
+				return cli.Exit(err, 1)
+			}
+
+			if err = printTwoFactorEnrollment(cmd.Writer, enrollment); err != nil {
+				return err
+			}
+
+			code, err := components.StringPrompt("Enter code from authenticator app to confirm: ")
+			if err != nil {
+				return cli.Exit(err, 1)
+			}
+
+			if err = t.twoFactorUC.Confirm(ctx, code); err != nil {
+				return cli.Exit(err, 1)
+			}
+
+			_, err = fmt.Fprintln(cmd.Writer, "two-factor authentication enabled")
+
+			return err
+		},
+	}
+}
+
+func (t *TwoFactorCommands) disable() *cli.Command {
+	return &cli.Command{
+		Name: "disable",
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			code, err := components.StringPrompt("Enter authenticator code or recovery code: ")
+			if err != nil {
+				return cli.Exit(err, 1)
+			}
+
+			if err = t.twoFactorUC.Disable(ctx, code); err != nil {
+				return cli.Exit(err, 1)
+			}
+
+			_, err = fmt.Fprintln(cmd.Writer, "two-factor authentication disabled")
+
+			return err
+		},
+	}
+}
@@ -1,47 +1,48 @@
 package components
 
 import (
-	"bufio"
 	"context"
 	"errors"
-	"fmt"
-	"io"
-	"os"
-	"strings"
+	"time"
 
 	usecase "github.com/llravell/go-pass/internal/usecase/client"
 	"github.com/llravell/go-pass/pkg/encryption"
+	"github.com/llravell/go-pass/pkg/keyagent"
 )
 
+const agentCallTimeout = time.Second
+
 var ErrEmptyMasterPassword = errors.New("got empty master password")
 
 type EncryptionKeyProvider struct {
-	authUC *usecase.AuthUseCase
-	key    *encryption.Key
+	authUC         *usecase.AuthUseCase
+	agent          *keyagent.Client
+	passwordReader *PasswordReader
+	key            *encryption.Key
 }
 
-func NewEncryptionKeyProvider(authUC *usecase.AuthUseCase) *EncryptionKeyProvider {
+func NewEncryptionKeyProvider(
+	authUC *usecase.AuthUseCase,
+	agent *keyagent.Client,
+	passwordReader *PasswordReader,
+) *EncryptionKeyProvider {
 	return &EncryptionKeyProvider{
-		authUC: authUC,
+		authUC:         authUC,
+		agent:          agent,
+		passwordReader: passwordReader,
 	}
 }
 
 func (p *EncryptionKeyProvider) promptMasterPassword(ctx context.Context) (string, error) {
-	reader := bufio.NewReader(os.Stdin)
-
-	//nolint:forbidigo
-	fmt.Print("Enter master password: ")
+	masterPassword, err := p.passwordReader.Read("Enter master password: ")
+	if err != nil {
+		if errors.Is(err, ErrEmptyPassword) {
+			return "", ErrEmptyMasterPassword
+		}
 
-	input, err := reader.ReadString('\n')
-	if err != nil && !errors.Is(err, io.EOF) {
 		return "", err
 	}
 
-	masterPassword := strings.TrimSpace(input[:len(input)-1])
-	if len(masterPassword) == 0 {
-		return "", ErrEmptyMasterPassword
-	}
-
 	err = p.authUC.ValidateMasterPassword(ctx, masterPassword)
 	if err != nil {
 		return "", err
@@ -55,17 +56,47 @@ func (p *EncryptionKeyProvider) Get(ctx context.Context) (*encryption.Key, error
 		return p.key, nil
 	}
 
-	masterPassword, err := p.promptMasterPassword(ctx)
-	if err != nil {
-		return nil, err
+	if key, err := p.getFromAgent(ctx); err == nil {
+		p.key = key
+
+		return p.key, nil
 	}
 
-	err = p.authUC.ValidateMasterPassword(ctx, masterPassword)
+	return p.Unlock(ctx)
+}
+
+// Unlock запрашивает мастер-пароль и, если агент запущен, кэширует в нем ключ.
+func (p *EncryptionKeyProvider) Unlock(ctx context.Context) (*encryption.Key, error) {
+	masterPassword, err := p.promptMasterPassword(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	p.key = encryption.GenerateKeyFromMasterPass(masterPassword)
 
+	p.cacheInAgent(ctx, p.key)
+
 	return p.key, nil
 }
+
+func (p *EncryptionKeyProvider) getFromAgent(ctx context.Context) (*encryption.Key, error) {
+	if p.agent == nil {
+		return nil, keyagent.ErrLocked
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, agentCallTimeout)
+	defer cancel()
+
+	return p.agent.Get(ctx)
+}
+
+func (p *EncryptionKeyProvider) cacheInAgent(ctx context.Context, key *encryption.Key) {
+	if p.agent == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, agentCallTimeout)
+	defer cancel()
+
+	_ = p.agent.Set(ctx, key)
+}
@@ -0,0 +1,185 @@
+package components
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+const (
+	PasswordEnvVar = "GOPASS_PASSWORD"
+	PinentryEnvVar = "GOPASS_PINENTRY"
+
+	ttyPath = "/dev/tty"
+)
+
+var (
+	ErrEmptyPassword      = errors.New("got empty password")
+	ErrPasswordsMismatch  = errors.New("passwords do not match")
+	ErrNoPasswordSource   = errors.New("no terminal to read password from, use --password-fd, --password-file or --pinentry")
+	ErrEmptyPasswordInEnv = errors.New(PasswordEnvVar + " is empty")
+	ErrInvalidPasswordFD  = errors.New("invalid password file descriptor")
+)
+
+// PasswordSourceOptions источники пароля в порядке приоритета:
+// файловый дескриптор, файл, переменная окружения (только явно разрешенная),
+// pinentry и терминал без эха.
+type PasswordSourceOptions struct {
+	FD       int
+	File     string
+	FromEnv  bool
+	Pinentry string
+}
+
+type PasswordReader struct {
+	opts PasswordSourceOptions
+}
+
+func NewPasswordReader() *PasswordReader {
+	return &PasswordReader{
+		opts: PasswordSourceOptions{FD: -1},
+	}
+}
+
+func (r *PasswordReader) SetOptions(opts PasswordSourceOptions) {
+	r.opts = opts
+}
+
+// Interactive сообщает, будет ли пароль запрошен у пользователя.
+func (r *PasswordReader) Interactive() bool {
+	return r.opts.FD < 0 && len(r.opts.File) == 0 && !r.opts.FromEnv
+}
+
+func (r *PasswordReader) Read(prompt string) (string, error) {
+	password, err := r.read(prompt)
+	if err != nil {
+		return "", err
+	}
+
+	if len(password) == 0 {
+		return "", ErrEmptyPassword
+	}
+
+	return password, nil
+}
+
+// ReadNew читает новый пароль, при интерактивном вводе просит повторить его.
+func (r *PasswordReader) ReadNew(prompt, confirmPrompt string) (string, error) {
+	password, err := r.Read(prompt)
+	if err != nil {
+		return "", err
+	}
+
+	if !r.Interactive() {
+		return password, nil
+	}
+
+	confirmation, err := r.Read(confirmPrompt)
+	if err != nil {
+		return "", err
+	}
+
+	if password != confirmation {
+		return "", ErrPasswordsMismatch
+	}
+
+	return password, nil
+}
+
+func (r *PasswordReader) read(prompt string) (string, error) {
+	switch {
+	case r.opts.FD >= 0:
+		file := os.NewFile(uintptr(r.opts.FD), "password-fd")
+		if file == nil {
+			return "", fmt.Errorf("%w: %d", ErrInvalidPasswordFD, r.opts.FD)
+		}
+
+		defer file.Close()
+
+		return readPasswordLine(file)
+	case len(r.opts.File) > 0:
+		file, err := os.Open(r.opts.File)
+		if err != nil {
+			return "", err
+		}
+
+		defer file.Close()
+
+		return readPasswordLine(file)
+	case r.opts.FromEnv:
+		password, ok := os.LookupEnv(PasswordEnvVar)
+		if !ok || len(password) == 0 {
+			return "", ErrEmptyPasswordInEnv
+		}
+
+		return password, nil
+	case len(r.opts.Pinentry) > 0:
+		return pinentryGetPin(r.opts.Pinentry, "go-pass needs a password", prompt)
+	default:
+		return readPasswordTTY(prompt)
+	}
+}
+
+// ReadSecret читает значение записи с терминала без эха.
+func ReadSecret(prompt string) (string, error) {
+	secret, err := readPasswordTTY(prompt)
+	if err != nil {
+		return "", err
+	}
+
+	if len(secret) == 0 {
+		return "", ErrEmptyPassword
+	}
+
+	return secret, nil
+}
+
+// readPasswordLine читает первую строку, в том числе без завершающего перевода строки.
+func readPasswordLine(reader io.Reader) (string, error) {
+	line, err := bufio.NewReader(reader).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+
+	return trimLineTerminator(line), nil
+}
+
+// trimLineTerminator убирает только перевод строки: пробелы по краям — часть пароля,
+// и из любого источника должен получиться один и тот же ключ хранилища.
+func trimLineTerminator(line string) string {
+	return strings.TrimRight(line, "\r\n")
+}
+
+// readPasswordTTY читает пароль с управляющего терминала без эха,
+// поэтому работает даже если stdin занят (например, протоколом credential helper'а).
+func readPasswordTTY(prompt string) (string, error) {
+	tty, err := os.OpenFile(ttyPath, os.O_RDWR, 0)
+	if err != nil {
+		if !term.IsTerminal(int(os.Stdin.Fd())) {
+			return "", ErrNoPasswordSource
+		}
+
+		tty = os.Stdin
+	} else {
+		defer tty.Close()
+	}
+
+	if _, err = fmt.Fprint(tty, prompt); err != nil {
+		return "", err
+	}
+
+	password, err := term.ReadPassword(int(tty.Fd()))
+
+	fmt.Fprintln(tty)
+
+	if err != nil {
+		return "", err
+	}
+
+	return trimLineTerminator(string(password)), nil
+}
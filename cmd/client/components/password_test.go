@@ -0,0 +1,40 @@
+package components_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/llravell/go-pass/cmd/client/components"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPasswordReader(t *testing.T) {
+	const password = "  master pass  "
+
+	t.Run("file keeps surrounding spaces", func(t *testing.T) {
+		for _, content := range []string{password, password + "\n", password + "\r\nsecond line\n"} {
+			path := filepath.Join(t.TempDir(), "password")
+			require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+			reader := components.NewPasswordReader()
+			reader.SetOptions(components.PasswordSourceOptions{FD: -1, File: path})
+
+			got, err := reader.Read("")
+			require.NoError(t, err)
+			assert.Equal(t, password, got)
+		}
+	})
+
+	t.Run("env keeps surrounding spaces", func(t *testing.T) {
+		t.Setenv(components.PasswordEnvVar, password)
+
+		reader := components.NewPasswordReader()
+		reader.SetOptions(components.PasswordSourceOptions{FD: -1, FromEnv: true})
+
+		got, err := reader.Read("")
+		require.NoError(t, err)
+		assert.Equal(t, password, got)
+	})
+}
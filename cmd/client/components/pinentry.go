@@ -0,0 +1,126 @@
+package components
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os/exec"
+	"strings"
+)
+
+var (
+	ErrPinentryCancelled = errors.New("pinentry: operation cancelled")
+	ErrPinentryProtocol  = errors.New("pinentry: unexpected response")
+)
+
+// pinentryGetPin запрашивает пароль через внешнюю программу pinentry
+// по протоколу Assuan: https://www.gnupg.org/documentation/manuals/assuan/
+func pinentryGetPin(program, description, prompt string) (string, error) {
+	//nolint:gosec
+	cmd := exec.Command(program)
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return "", err
+	}
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return "", err
+	}
+
+	if err = cmd.Start(); err != nil {
+		return "", err
+	}
+
+	defer func() {
+		stdin.Close()
+		_ = cmd.Wait()
+	}()
+
+	session := &assuanSession{
+		writer: stdin,
+		reader: bufio.NewReader(stdout),
+	}
+
+	if _, err = session.readResponse(); err != nil {
+		return "", err
+	}
+
+	commands := []string{
+		"SETTITLE go-pass",
+		"SETDESC " + assuanEscape(description),
+		"SETPROMPT " + assuanEscape(prompt),
+	}
+
+	for _, command := range commands {
+		if _, err = session.call(command); err != nil {
+			return "", err
+		}
+	}
+
+	pin, err := session.call("GETPIN")
+	if err != nil {
+		return "", err
+	}
+
+	_, _ = session.call("BYE")
+
+	return pin, nil
+}
+
+type assuanSession struct {
+	writer io.Writer
+	reader *bufio.Reader
+}
+
+func (s *assuanSession) call(command string) (string, error) {
+	if _, err := io.WriteString(s.writer, command+"\n"); err != nil {
+		return "", err
+	}
+
+	return s.readResponse()
+}
+
+func (s *assuanSession) readResponse() (string, error) {
+	var data strings.Builder
+
+	for {
+		line, err := s.reader.ReadString('\n')
+		if err != nil {
+			return "", err
+		}
+
+		line = strings.TrimRight(line, "\r\n")
+
+		switch {
+		case line == "OK" || strings.HasPrefix(line, "OK "):
+			return data.String(), nil
+		case strings.HasPrefix(line, "D "):
+			decoded, err := url.PathUnescape(line[2:])
+			if err != nil {
+				return "", err
+			}
+
+			data.WriteString(decoded)
+		case strings.HasPrefix(line, "ERR "):
+			if strings.Contains(strings.ToLower(line), "cancel") {
+				return "", ErrPinentryCancelled
+			}
+
+			return "", fmt.Errorf("%w: %s", ErrPinentryProtocol, line)
+		case strings.HasPrefix(line, "S ") || strings.HasPrefix(line, "#"):
+			continue
+		default:
+			return "", fmt.Errorf("%w: %s", ErrPinentryProtocol, line)
+		}
+	}
+}
+
+func assuanEscape(text string) string {
+	replacer := strings.NewReplacer("%", "%25", "\n", "%0A", "\r", "%0D")
+
+	return replacer.Replace(text)
+}
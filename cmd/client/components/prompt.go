@@ -52,3 +52,18 @@ func BoolPrompt(text string) (bool, error) {
 
 	return false, ErrInvalidUserResponse
 }
+
+func StringPrompt(text string) (string, error) {
+	if _, err := os.Stdout.WriteString(text); err != nil {
+		return "", err
+	}
+
+	reader := bufio.NewReader(os.Stdin)
+
+	input, err := reader.ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+
+	return strings.TrimSpace(input), nil
+}
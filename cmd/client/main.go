@@ -4,20 +4,30 @@ import (
 	"context"
 	"database/sql"
 	"embed"
+	"errors"
 	"log"
 	"os"
 	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
 
+	"github.com/XSAM/otelsql"
 	"github.com/llravell/go-pass/cmd/client/commands"
 	"github.com/llravell/go-pass/cmd/client/components"
+	"github.com/llravell/go-pass/config"
 	"github.com/llravell/go-pass/internal/grpc/client"
 	"github.com/llravell/go-pass/internal/repository"
 	usecase "github.com/llravell/go-pass/internal/usecase/client"
+	"github.com/llravell/go-pass/pkg/auth"
 	pb "github.com/llravell/go-pass/pkg/grpc"
+	"github.com/llravell/go-pass/pkg/keyagent"
+	"github.com/llravell/go-pass/pkg/tracing"
 	"github.com/pressly/goose/v3"
 	"github.com/urfave/cli/v3"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	_ "modernc.org/sqlite"
 )
 
@@ -25,10 +35,28 @@ import (
 var embedMigrations embed.FS
 
 const (
-	passDir = ".go_pass"
-	dbName  = "pass.db"
+	sshAgentSocketName = "ssh-agent"
+	agentSocketName    = "agent"
+
+	profileEnvVar  = "GOPASS_PROFILE"
+	configEnvVar   = "GOPASS_CONFIG"
+	insecureEnvVar = "GOPASS_INSECURE"
+	traceEnvVar    = "GOPASS_TRACE"
+
+	dockerCredentialHelperName = "docker-credential-gopass"
 )
 
+// version задается при сборке: -ldflags "-X main.version=..."
+var version = "dev"
+
+type clientEnv struct {
+	cfg          *config.ClientConfig
+	profileName  string
+	profile      *config.ClientProfile
+	passDirPath  string
+	machineToken *auth.MachineToken
+}
+
 func runMigrations(db *sql.DB) error {
 	goose.SetBaseFS(embedMigrations)
 
@@ -43,35 +71,151 @@ func runMigrations(db *sql.DB) error {
 	return nil
 }
 
-func initStorage() (*sql.DB, error) {
-	homeDirPath, err := os.UserHomeDir()
+func initStorage(dbPath string) (*sql.DB, error) {
+	err := os.MkdirAll(filepath.Dir(dbPath), os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := otelsql.Open("sqlite", dbPath, otelsql.WithAttributes(attribute.String("db.system", "sqlite")))
+	if err != nil {
+		return nil, err
+	}
+
+	return db, nil
+}
+
+// flagFromArgs находит значение глобального флага до разбора команды:
+// от профиля зависят база и подключение к серверу, которые нужны для построения команд.
+func flagFromArgs(args []string, name, envVar string) string {
+	for i := 1; i < len(args); i++ {
+		arg := args[i]
+
+		if arg == "--" {
+			break
+		}
+
+		if arg == "--"+name && i+1 < len(args) {
+			return args[i+1]
+		}
+
+		if value, ok := strings.CutPrefix(arg, "--"+name+"="); ok {
+			return value
+		}
+	}
+
+	return os.Getenv(envVar)
+}
+
+func boolFlagFromArgs(args []string, name, envVar string) bool {
+	for _, arg := range args[1:] {
+		if arg == "--" {
+			break
+		}
+
+		if arg == "--"+name {
+			return true
+		}
+
+		if value, ok := strings.CutPrefix(arg, "--"+name+"="); ok {
+			enabled, _ := strconv.ParseBool(value)
+
+			return enabled
+		}
+	}
+
+	enabled, _ := strconv.ParseBool(os.Getenv(envVar))
+
+	return enabled
+}
+
+// socketPath возвращает путь сокета агента, у каждого профиля свой агент.
+func socketPath(env *clientEnv, name string) string {
+	if env.profileName == config.DefaultProfileName {
+		return path.Join(env.passDirPath, name+".sock")
+	}
+
+	return path.Join(env.passDirPath, name+"-"+env.profileName+".sock")
+}
+
+func loadClientEnv(args []string) (*clientEnv, error) {
+	configPath := flagFromArgs(args, "config", configEnvVar)
+	if len(configPath) == 0 {
+		defaultPath, err := config.DefaultClientConfigPath()
+		if err != nil {
+			return nil, err
+		}
+
+		configPath = defaultPath
+	}
+
+	cfg, err := config.LoadClientConfig(configPath)
 	if err != nil {
 		return nil, err
 	}
 
-	passDirPath := path.Join(homeDirPath, passDir)
+	profileName := cfg.ResolveProfileName(flagFromArgs(args, "profile", profileEnvVar))
 
-	err = os.MkdirAll(passDirPath, os.ModePerm)
+	profile, err := cfg.Profile(profileName)
 	if err != nil {
 		return nil, err
 	}
 
-	db, err := sql.Open("sqlite", path.Join(passDirPath, dbName))
+	if boolFlagFromArgs(args, "insecure", insecureEnvVar) {
+		profile.TLS.Insecure = true
+	}
+
+	passDirPath, err := config.ClientPassDir()
 	if err != nil {
 		return nil, err
 	}
 
-	return db, nil
+	var machineToken *auth.MachineToken
+
+	if value := os.Getenv(commands.TokenEnvVar); len(value) > 0 {
+		machineToken, err = auth.ParseMachineToken(value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &clientEnv{
+		cfg:          cfg,
+		profileName:  profileName,
+		profile:      profile,
+		passDirPath:  passDirPath,
+		machineToken: machineToken,
+	}, nil
 }
 
-func buildCmd(db *sql.DB) *cli.Command {
+func buildCmd(db *sql.DB, env *clientEnv) *cli.Command {
 	sessionRepo := repository.NewSessionSqliteRepository(db)
 	passwordsRepo := repository.NewPasswordsSqliteRepository(db)
+	sharesRepo := repository.NewSharesSqliteRepository(db)
+	recipientKeysRepo := repository.NewRecipientKeysSqliteRepository(db)
+
+	serverPinner := client.NewServerPinner(sessionRepo)
+
+	transportCredentials, err := client.TransportCredentials(env.profile.TLS, serverPinner)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// в режиме токена сессия пользователя не используется
+	authInterceptor := client.AuthInterceptor(sessionRepo)
+	if env.machineToken != nil {
+		authInterceptor = client.MachineTokenInterceptor(env.machineToken.Bearer())
+	}
 
 	conn, err := grpc.NewClient(
-		":3200",
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithUnaryInterceptor(client.AuthInterceptor(sessionRepo)),
+		env.profile.Server,
+		grpc.WithTransportCredentials(transportCredentials),
+		grpc.WithUserAgent("gopass/"+version),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		grpc.WithChainUnaryInterceptor(
+			client.TimeoutInterceptor(env.profile.Timeout),
+			authInterceptor,
+		),
 	)
 	if err != nil {
 		log.Fatal(err)
@@ -79,19 +223,149 @@ func buildCmd(db *sql.DB) *cli.Command {
 
 	authClient := pb.NewAuthClient(conn)
 	passwordsClient := pb.NewPasswordsClient(conn)
+	devicesClient := pb.NewDevicesClient(conn)
+	twoFactorClient := pb.NewTwoFactorClient(conn)
+	sharesClient := pb.NewSharesClient(conn)
+	orgsClient := pb.NewOrgsClient(conn)
+	emergencyClient := pb.NewEmergencyClient(conn)
+	sendsClient := pb.NewSendsClient(conn)
+	sendsAccessClient := pb.NewSendsAccessClient(conn)
+	tokensClient := pb.NewTokensClient(conn)
+	auditClient := pb.NewAuditClient(conn)
 
-	authUseCase := usecase.NewAuthUseCase(sessionRepo, authClient)
+	authUseCase := usecase.NewAuthUseCase(sessionRepo, authClient, serverPinner)
 	passwordsUseCase := usecase.NewPasswordsUseCase(passwordsRepo, passwordsClient)
+	userKeysUseCase := usecase.NewUserKeysUseCase(sharesClient, recipientKeysRepo)
+	userKeysUseCase.OnNewKey(commands.PrintNewKey(os.Stderr))
+	sharesUseCase := usecase.NewSharesUseCase(sharesRepo, passwordsUseCase, userKeysUseCase, sharesClient)
+	orgsUseCase := usecase.NewOrgsUseCase(orgsClient, userKeysUseCase)
+	emergencyUseCase := usecase.NewEmergencyUseCase(emergencyClient, passwordsClient, userKeysUseCase)
+	sendsUseCase := usecase.NewSendsUseCase(sendsClient, sendsAccessClient, env.profile.Server)
+	tokensUseCase := usecase.NewTokensUseCase(tokensClient, orgsUseCase)
+	auditUseCase := usecase.NewAuditUseCase(auditClient)
+	devicesUseCase := usecase.NewDevicesUseCase(devicesClient)
+	twoFactorUseCase := usecase.NewTwoFactorUseCase(twoFactorClient)
 
-	encryptionKeyProvider := components.NewEncryptionKeyProvider(authUseCase)
-	authCommands := commands.NewAuthCommands(authUseCase)
-	passwordsCommands := commands.NewPasswordsCommands(passwordsUseCase, encryptionKeyProvider)
+	agentSocketPath := socketPath(env, agentSocketName)
+	agentClient := keyagent.NewClient(agentSocketPath)
+
+	passwordReader := components.NewPasswordReader()
+	encryptionKeyProvider := components.NewEncryptionKeyProvider(authUseCase, agentClient, passwordReader)
+	authCommands := commands.NewAuthCommands(authUseCase, passwordReader)
+	passwordsCommands := commands.NewPasswordsCommands(
+		passwordsUseCase,
+		sharesUseCase,
+		orgsUseCase,
+		encryptionKeyProvider,
+	)
+	injectCommands := commands.NewInjectCommands(passwordsUseCase, encryptionKeyProvider)
+	gitCredentialCommands := commands.NewGitCredentialCommands(passwordsUseCase, encryptionKeyProvider)
+	dockerCredentialCommands := commands.NewDockerCredentialCommands(passwordsUseCase, encryptionKeyProvider)
+	agentCommands := commands.NewAgentCommands(agentClient, encryptionKeyProvider, agentSocketPath)
+	sshAgentCommands := commands.NewSSHAgentCommands(
+		passwordsUseCase,
+		encryptionKeyProvider,
+		socketPath(env, sshAgentSocketName),
+	)
+	configCommands := commands.NewConfigCommands(env.cfg, env.profileName)
+	serverCommands := commands.NewServerCommands(authUseCase, env.profile)
+	sharesCommands := commands.NewSharesCommands(sharesUseCase, encryptionKeyProvider)
+	keysCommands := commands.NewKeysCommands(userKeysUseCase, encryptionKeyProvider)
+	orgsCommands := commands.NewOrgsCommands(orgsUseCase, encryptionKeyProvider)
+	emergencyCommands := commands.NewEmergencyCommands(emergencyUseCase, encryptionKeyProvider)
+	sendsCommands := commands.NewSendsCommands(sendsUseCase, passwordsUseCase, encryptionKeyProvider)
+	tokensCommands := commands.NewTokensCommands(
+		tokensUseCase,
+		orgsUseCase,
+		passwordsUseCase,
+		encryptionKeyProvider,
+		env.machineToken,
+	)
+	auditCommands := commands.NewAuditCommands(auditUseCase)
+	devicesCommands := commands.NewDevicesCommands(devicesUseCase)
+	twoFactorCommands := commands.NewTwoFactorCommands(twoFactorUseCase)
+	logoutCommands := commands.NewLogoutCommands(authUseCase, passwordsUseCase, agentClient)
 
 	return &cli.Command{
-		Name: "GOPASS",
+		Name:    "GOPASS",
+		Version: version,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:    "profile",
+				Usage:   "client profile from config file",
+				Sources: cli.EnvVars(profileEnvVar),
+			},
+			&cli.StringFlag{
+				Name:      "config",
+				Usage:     "client config file",
+				TakesFile: true,
+				Sources:   cli.EnvVars(configEnvVar),
+			},
+			&cli.BoolFlag{
+				Name:    "insecure",
+				Usage:   "allow connection to server without TLS",
+				Sources: cli.EnvVars(insecureEnvVar),
+			},
+			&cli.BoolFlag{
+				Name:    "trace",
+				Usage:   "print OpenTelemetry spans of the command to stderr",
+				Sources: cli.EnvVars(traceEnvVar),
+			},
+			&cli.IntFlag{
+				Name:  "password-fd",
+				Usage: "read master password from file descriptor",
+				Value: -1,
+			},
+			&cli.StringFlag{
+				Name:      "password-file",
+				Usage:     "read master password from file",
+				TakesFile: true,
+			},
+			&cli.BoolFlag{
+				Name:  "password-from-env",
+				Usage: "read master password from " + components.PasswordEnvVar + " env variable",
+			},
+			&cli.StringFlag{
+				Name:    "pinentry",
+				Usage:   "pinentry program to ask master password with",
+				Sources: cli.EnvVars(components.PinentryEnvVar),
+			},
+		},
+		Before: func(ctx context.Context, cmd *cli.Command) (context.Context, error) {
+			passwordReader.SetOptions(components.PasswordSourceOptions{
+				FD:       int(cmd.Int("password-fd")),
+				File:     cmd.String("password-file"),
+				FromEnv:  cmd.Bool("password-from-env"),
+				Pinentry: cmd.String("pinentry"),
+			})
+
+			return ctx, nil
+		},
 		Commands: []*cli.Command{
 			authCommands.Login(),
 			authCommands.Register(),
+			logoutCommands.Logout(),
+			injectCommands.Inject(),
+			gitCredentialCommands.GitCredential(),
+			dockerCredentialCommands.DockerCredential(),
+			sshAgentCommands.SSHAgent(),
+			agentCommands.Agent(),
+			agentCommands.Lock(),
+			agentCommands.Unlock(),
+			configCommands.Config(),
+			serverCommands.Server(),
+			sharesCommands.Share(),
+			sharesCommands.Shares(),
+			keysCommands.Keys(),
+			orgsCommands.Org(),
+			emergencyCommands.Emergency(),
+			sendsCommands.Send(),
+			sendsCommands.Receive(),
+			sendsCommands.Sends(),
+			tokensCommands.Token(),
+			auditCommands.Audit(),
+			devicesCommands.Devices(),
+			twoFactorCommands.TwoFactor(),
 
 			{
 				Name: "init",
@@ -107,6 +381,7 @@ func buildCmd(db *sql.DB) *cli.Command {
 					passwordsCommands.Add(),
 					passwordsCommands.Edit(),
 					passwordsCommands.Delete(),
+					passwordsCommands.Sync(),
 				},
 			},
 		},
@@ -117,14 +392,47 @@ func buildCmd(db *sql.DB) *cli.Command {
 }
 
 func main() {
-	db, err := initStorage()
+	args := os.Args
+
+	// docker вызывает хелпер как docker-credential-gopass <action>
+	if filepath.Base(args[0]) == dockerCredentialHelperName {
+		args = append([]string{args[0], "docker-credential"}, args[1:]...)
+	}
+
+	env, err := loadClientEnv(args)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	db, err := initStorage(env.profile.DBPath)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	cmd := buildCmd(db)
+	cmd := buildCmd(db, env)
 
-	if err := cmd.Run(context.Background(), os.Args); err != nil {
+	if err = run(cmd, args); err != nil {
 		log.Fatal(err)
 	}
 }
+
+// run выполняет команду в корневом спане. С --trace спаны команды, вызовов
+// сервера и запросов к локальной базе выводятся в stderr после ее завершения.
+func run(cmd *cli.Command, args []string) error {
+	exporter := tracing.ExporterNone
+	if boolFlagFromArgs(args, "trace", traceEnvVar) {
+		exporter = tracing.ExporterStdout
+	}
+
+	shutdownTracing, err := tracing.Setup(context.Background(), "gopass", version, exporter, os.Stderr)
+	if err != nil {
+		return err
+	}
+
+	ctx, span := tracing.Start(context.Background(), "gopass")
+	err = cmd.Run(ctx, args)
+
+	span.End()
+
+	return errors.Join(err, shutdownTracing(context.Background()))
+}
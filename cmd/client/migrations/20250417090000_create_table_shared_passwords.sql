@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE shared_passwords (
+  id INTEGER PRIMARY KEY,
+  name TEXT NOT NULL,
+  owner TEXT NOT NULL,
+  encrypted_pass TEXT NOT NULL,
+  meta TEXT,
+  version INTEGER DEFAULT 0,
+  permission INTEGER DEFAULT 0,
+  share_key TEXT NOT NULL,
+  UNIQUE (owner, name)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE shared_passwords;
+-- +goose StatementEnd
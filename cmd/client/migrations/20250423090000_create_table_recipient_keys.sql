@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE recipient_keys (
+  login TEXT PRIMARY KEY,
+  public_key TEXT NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE recipient_keys;
+-- +goose StatementEnd
@@ -0,0 +1,100 @@
+// server-audit-export выгружает журнал аудита в формате JSON Lines для
+// администратора сервера: по событию на строку, в порядке записи.
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"github.com/caarlos0/env"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/llravell/go-pass/internal/entity"
+	"github.com/llravell/go-pass/internal/repository"
+	usecase "github.com/llravell/go-pass/internal/usecase/server"
+)
+
+type Opts struct {
+	DatabaseURI string `env:"DATABASE_URI"`
+}
+
+func parseTime(value string) time.Time {
+	if len(value) == 0 {
+		return time.Time{}
+	}
+
+	if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+		return parsed
+	}
+
+	parsed, err := time.ParseInLocation(time.DateOnly, value, time.Local)
+	if err != nil {
+		log.Fatalf("invalid time %q, expected RFC 3339 or YYYY-MM-DD", value)
+	}
+
+	return parsed
+}
+
+func main() {
+	var (
+		opts   Opts
+		userID int
+		since  string
+		until  string
+		output string
+	)
+
+	if err := env.Parse(&opts); err != nil {
+		log.Fatal(err)
+	}
+
+	flag.StringVar(&opts.DatabaseURI, "d", opts.DatabaseURI, "Database connect uri")
+	flag.IntVar(&userID, "user", 0, "Export events of this user id only")
+	flag.StringVar(&since, "since", "", "Export events from this time (RFC 3339 or YYYY-MM-DD)")
+	flag.StringVar(&until, "until", "", "Export events before this time (RFC 3339 or YYYY-MM-DD)")
+	flag.StringVar(&output, "o", "", "Output file, stdout by default")
+	flag.Parse()
+
+	if len(opts.DatabaseURI) == 0 {
+		log.Fatal("got empty database uri")
+	}
+
+	filter := entity.AuditFilter{
+		UserID: userID,
+		Since:  parseTime(since),
+		Until:  parseTime(until),
+	}
+
+	db, err := sql.Open("pgx", opts.DatabaseURI)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	defer db.Close()
+
+	var writer io.Writer = os.Stdout
+
+	if len(output) > 0 {
+		file, err := os.OpenFile(output, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		defer file.Close()
+
+		writer = file
+	}
+
+	auditUC := usecase.NewAuditUseCase(repository.NewAuditPostgresRepository(db), repository.NewUsersRepository(db))
+
+	count, err := auditUC.Export(context.Background(), filter, writer)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf("exported %d audit events", count)
+}
@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE user_keys (
+  user_id INTEGER PRIMARY KEY,
+  public_key TEXT NOT NULL,
+  encrypted_private_key TEXT NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT fk_user FOREIGN KEY(user_id) REFERENCES users(id)
+);
+
+CREATE TABLE shares (
+  id SERIAL PRIMARY KEY,
+  owner_id INTEGER NOT NULL,
+  recipient_id INTEGER NOT NULL,
+  name TEXT NOT NULL,
+  encrypted_pass TEXT NOT NULL,
+  meta TEXT NOT NULL DEFAULT '',
+  version INTEGER NOT NULL DEFAULT 1,
+  source_version INTEGER NOT NULL DEFAULT 0,
+  is_recipient_modified boolean DEFAULT FALSE,
+  permission SMALLINT NOT NULL DEFAULT 0,
+  owner_key TEXT NOT NULL,
+  recipient_key TEXT NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE (owner_id, recipient_id, name),
+  CONSTRAINT fk_owner FOREIGN KEY(owner_id) REFERENCES users(id),
+  CONSTRAINT fk_recipient FOREIGN KEY(recipient_id) REFERENCES users(id)
+);
+
+CREATE INDEX shares_recipient_id_idx ON shares (recipient_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE shares;
+
+DROP TABLE user_keys;
+-- +goose StatementEnd
@@ -0,0 +1,65 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE organizations (
+  id SERIAL PRIMARY KEY,
+  name TEXT NOT NULL UNIQUE,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE org_members (
+  org_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  role SMALLINT NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (org_id, user_id),
+  CONSTRAINT fk_org FOREIGN KEY(org_id) REFERENCES organizations(id) ON DELETE CASCADE,
+  CONSTRAINT fk_user FOREIGN KEY(user_id) REFERENCES users(id)
+);
+
+CREATE TABLE collections (
+  id SERIAL PRIMARY KEY,
+  org_id INTEGER NOT NULL,
+  name TEXT NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE (org_id, name),
+  CONSTRAINT fk_org FOREIGN KEY(org_id) REFERENCES organizations(id) ON DELETE CASCADE
+);
+
+CREATE TABLE collection_members (
+  collection_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  wrapped_key TEXT NOT NULL,
+  PRIMARY KEY (collection_id, user_id),
+  CONSTRAINT fk_collection FOREIGN KEY(collection_id) REFERENCES collections(id) ON DELETE CASCADE,
+  CONSTRAINT fk_user FOREIGN KEY(user_id) REFERENCES users(id)
+);
+
+ALTER TABLE passwords ADD COLUMN collection_id INTEGER REFERENCES collections(id) ON DELETE CASCADE;
+
+ALTER TABLE passwords DROP CONSTRAINT passwords_user_id_name_key;
+
+CREATE UNIQUE INDEX passwords_user_id_name_key ON passwords (user_id, name) WHERE collection_id IS NULL;
+
+CREATE UNIQUE INDEX passwords_collection_id_name_key ON passwords (collection_id, name) WHERE collection_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM passwords WHERE collection_id IS NOT NULL;
+
+DROP INDEX passwords_collection_id_name_key;
+
+DROP INDEX passwords_user_id_name_key;
+
+ALTER TABLE passwords ADD CONSTRAINT passwords_user_id_name_key UNIQUE (user_id, name);
+
+ALTER TABLE passwords DROP COLUMN collection_id;
+
+DROP TABLE collection_members;
+
+DROP TABLE collections;
+
+DROP TABLE org_members;
+
+DROP TABLE organizations;
+-- +goose StatementEnd
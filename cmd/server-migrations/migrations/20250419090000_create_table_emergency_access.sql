@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE emergency_access (
+  id SERIAL PRIMARY KEY,
+  grantor_id INTEGER NOT NULL,
+  grantee_id INTEGER NOT NULL,
+  wait_period_seconds BIGINT NOT NULL,
+  status SMALLINT NOT NULL DEFAULT 0,
+  requested_at TIMESTAMP WITH TIME ZONE,
+  wrapped_key TEXT NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE (grantor_id, grantee_id),
+  CONSTRAINT fk_grantor FOREIGN KEY(grantor_id) REFERENCES users(id),
+  CONSTRAINT fk_grantee FOREIGN KEY(grantee_id) REFERENCES users(id)
+);
+
+CREATE INDEX emergency_access_grantee_id_idx ON emergency_access (grantee_id);
+CREATE INDEX emergency_access_requested_idx ON emergency_access (requested_at) WHERE status = 1;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE emergency_access;
+-- +goose StatementEnd
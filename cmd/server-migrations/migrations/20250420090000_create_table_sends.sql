@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE sends (
+  id TEXT PRIMARY KEY,
+  user_id INTEGER NOT NULL,
+  ciphertext TEXT NOT NULL,
+  views INTEGER NOT NULL DEFAULT 0,
+  max_views INTEGER NOT NULL,
+  expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT fk_user FOREIGN KEY(user_id) REFERENCES users(id)
+);
+
+CREATE INDEX sends_user_id_idx ON sends (user_id);
+CREATE INDEX sends_expires_at_idx ON sends (expires_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE sends;
+-- +goose StatementEnd
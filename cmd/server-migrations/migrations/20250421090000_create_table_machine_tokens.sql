@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE machine_tokens (
+  id SERIAL PRIMARY KEY,
+  user_id INTEGER NOT NULL,
+  name TEXT NOT NULL,
+  collection_id INTEGER NOT NULL,
+  writable boolean NOT NULL DEFAULT FALSE,
+  secret_hash TEXT NOT NULL,
+  wrapped_key TEXT NOT NULL,
+  expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+  revoked_at TIMESTAMP WITH TIME ZONE,
+  last_used_at TIMESTAMP WITH TIME ZONE,
+  last_used_ip TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+  CONSTRAINT fk_user FOREIGN KEY(user_id) REFERENCES users(id),
+  CONSTRAINT fk_collection FOREIGN KEY(collection_id) REFERENCES collections(id) ON DELETE CASCADE
+);
+
+CREATE INDEX machine_tokens_user_id_idx ON machine_tokens (user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE machine_tokens;
+-- +goose StatementEnd
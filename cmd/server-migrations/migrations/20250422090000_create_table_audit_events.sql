@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE audit_events (
+  id BIGSERIAL PRIMARY KEY,
+  user_id INTEGER,
+  login TEXT NOT NULL DEFAULT '',
+  device_id INTEGER NOT NULL DEFAULT 0,
+  token_id INTEGER NOT NULL DEFAULT 0,
+  action TEXT NOT NULL,
+  entry TEXT NOT NULL DEFAULT '',
+  outcome TEXT NOT NULL,
+  ip TEXT NOT NULL DEFAULT '',
+  created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX audit_events_user_id_idx ON audit_events (user_id, id);
+CREATE INDEX audit_events_created_at_idx ON audit_events (created_at);
+
+-- журнал только дополняется, изменить или удалить события нельзя
+CREATE FUNCTION audit_events_append_only() RETURNS trigger AS $$
+BEGIN
+  RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_events_append_only
+  BEFORE UPDATE OR DELETE OR TRUNCATE ON audit_events
+  FOR EACH STATEMENT EXECUTE FUNCTION audit_events_append_only();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE audit_events;
+DROP FUNCTION audit_events_append_only;
+-- +goose StatementEnd
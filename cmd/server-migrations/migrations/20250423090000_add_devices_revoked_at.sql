@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE devices ADD COLUMN revoked_at TIMESTAMP WITH TIME ZONE;
+
+UPDATE devices SET revoked_at=CURRENT_TIMESTAMP WHERE is_revoked;
+
+CREATE INDEX devices_revoked_at_idx ON devices (revoked_at) WHERE is_revoked;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE devices DROP COLUMN revoked_at;
+-- +goose StatementEnd
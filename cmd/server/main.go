@@ -1,36 +1,315 @@
 package main
 
 import (
+	"context"
 	"database/sql"
+	"errors"
+	"flag"
 	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
+	"github.com/XSAM/otelsql"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
 	_ "github.com/jackc/pgx/v5/stdlib"
 	"github.com/llravell/go-pass/config"
 	"github.com/llravell/go-pass/internal/grpc/server"
+	"github.com/llravell/go-pass/internal/httpserver"
+	"github.com/llravell/go-pass/internal/metrics"
 	"github.com/llravell/go-pass/internal/repository"
 	usecase "github.com/llravell/go-pass/internal/usecase/server"
 	"github.com/llravell/go-pass/logger"
 	"github.com/llravell/go-pass/pkg/auth"
+	"github.com/llravell/go-pass/pkg/certs"
 	pb "github.com/llravell/go-pass/pkg/grpc"
+	"github.com/llravell/go-pass/pkg/tracing"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 )
 
+const (
+	sendsReadHeaderTimeout   = 10 * time.Second
+	metricsReadHeaderTimeout = 10 * time.Second
+
+	serviceName = "gopass-server"
+)
+
+// version задается при сборке: -ldflags "-X main.version=..."
+var version = "dev"
+
+// reloadOnSignal по SIGHUP перечитывает сертификаты и конфигурацию. Новые
+// подключения сразу получают обновленный сертификат, безопасные настройки
+// применяются через apply, об остальных изменениях пишется предупреждение:
+// они вступят в силу только после перезапуска.
+func reloadOnSignal(
+	ctx context.Context,
+	cfg *config.ServerConfig,
+	reloader *certs.Reloader,
+	apply func(cfg *config.ServerConfig),
+	log *zerolog.Logger,
+) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP)
+
+	defer signal.Stop(signals)
+
+	applied := cfg
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-signals:
+		}
+
+		if reloader != nil {
+			if err := reloader.Reload(); err != nil {
+				log.Error().Err(err).Msg("tls certificates reloading failed")
+			} else {
+				log.Info().Msg("tls certificates reloaded")
+			}
+		}
+
+		next, err := config.NewServerConfig()
+		if err != nil {
+			log.Error().Err(err).Msg("config reloading failed, current settings are kept")
+
+			continue
+		}
+
+		reloaded, _, err := applied.Changes(next)
+		if err != nil {
+			log.Error().Err(err).Msg("config comparing failed")
+
+			continue
+		}
+
+		if _, restartRequired, err := cfg.Changes(next); err == nil && len(restartRequired) > 0 {
+			log.Warn().Strs("keys", restartRequired).Msg("changed settings are applied only after restart")
+		}
+
+		apply(next)
+		applied = next
+
+		log.Info().Strs("keys", reloaded).Msg("config reloaded")
+	}
+}
+
+func authSettings(cfg *config.ServerConfig) usecase.AuthSettings {
+	return usecase.AuthSettings{
+		AccessTokenTTL:         cfg.Tokens.AccessTTL,
+		RefreshTokenTTL:        cfg.Tokens.RefreshTTL,
+		BcryptCost:             cfg.Auth.BcryptCost,
+		RegistrationClosed:     cfg.Auth.Registration == config.RegistrationClosed,
+		RefreshTokensRetention: cfg.Retention.RefreshTokens,
+	}
+}
+
+func rateLimits(cfg *config.ServerConfig) usecase.RateLimits {
+	return usecase.RateLimits{
+		MaxFailures:     cfg.RateLimit.MaxFailures,
+		BaseDelay:       cfg.RateLimit.BaseDelay,
+		MaxDelay:        cfg.RateLimit.MaxDelay,
+		LockoutDuration: cfg.RateLimit.LockoutDuration,
+		FailureWindow:   cfg.RateLimit.FailureWindow,
+	}
+}
+
+func quotas(cfg *config.ServerConfig) usecase.Quotas {
+	return usecase.Quotas{
+		MaxEntrySize: cfg.Quotas.MaxEntrySize,
+		MaxEntries:   cfg.Quotas.MaxEntries,
+	}
+}
+
+// buildJWTManager создает менеджер токенов. Для асимметричных алгоритмов
+// ключи берутся из базы и ротируются в фоне, для HS256 используется общий секрет.
+func buildJWTManager(
+	ctx context.Context,
+	cfg *config.ServerConfig,
+	db *sql.DB,
+	log *zerolog.Logger,
+) (*auth.JWTManager, *usecase.SigningKeysUseCase, error) {
+	if !auth.IsAsymmetric(cfg.JWT.Algorithm) {
+		if cfg.DevMode {
+			log.Warn().Msg("dev mode: tokens are signed with a shared HS256 secret")
+		}
+
+		return auth.NewJWTManager(cfg.JWT.Secret), nil, nil
+	}
+
+	jwtManager := auth.NewRotatingJWTManager(nil)
+	signingKeysUsecase := usecase.NewSigningKeysUseCase(
+		repository.NewSigningKeysPostgresRepository(db),
+		jwtManager,
+		cfg.JWT.Algorithm,
+		cfg.JWT.KeyRotation,
+		cfg.JWT.KeyGracePeriod,
+	)
+
+	if err := signingKeysUsecase.Rotate(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	go signingKeysUsecase.Run(ctx, func(err error) {
+		log.Error().Err(err).Msg("jwt signing keys rotation failed")
+	})
+
+	return jwtManager, signingKeysUsecase, nil
+}
+
+// serveSends отдает страницы одноразовых передач по HTTPS. Клиентские
+// сертификаты здесь не требуются: ссылки открывают люди без go-pass.
+// Открытый HTTP Validate допускает только в dev mode.
+func serveSends(addr string, handler *httpserver.SendsHandler, reloader *certs.Reloader, log *zerolog.Logger) *http.Server {
+	httpServer := &http.Server{
+		Addr:              addr,
+		Handler:           handler.Routes(),
+		ReadHeaderTimeout: sendsReadHeaderTimeout,
+	}
+
+	log.Info().Msgf("sends http server started on %s", addr)
+
+	go func() {
+		var err error
+
+		if reloader != nil {
+			httpServer.TLSConfig = reloader.PublicTLSConfig()
+			err = httpServer.ListenAndServeTLS("", "")
+		} else {
+			log.Warn().Msg("sends http server runs without tls, use it only in dev mode")
+			err = httpServer.ListenAndServe()
+		}
+
+		if !errors.Is(err, http.ErrServerClosed) {
+			log.Error().Err(err).Msg("sends http server has been closed")
+		}
+	}()
+
+	return httpServer
+}
+
+// serveMetrics отдает метрики на отдельном адресе без TLS: его не публикуют
+// наружу, метрики собирает Prometheus внутри кластера.
+func serveMetrics(addr string, handler http.Handler, log *zerolog.Logger) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("GET /metrics", handler)
+
+	httpServer := &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: metricsReadHeaderTimeout,
+	}
+
+	log.Info().Msgf("metrics http server started on %s", addr)
+
+	go func() {
+		err := httpServer.ListenAndServe()
+		if !errors.Is(err, http.ErrServerClosed) {
+			log.Error().Err(err).Msg("metrics http server has been closed")
+		}
+	}()
+
+	return httpServer
+}
+
+// shutdown переводит health в NOT_SERVING, чтобы балансировщик перестал слать
+// новые запросы, еще delay обслуживает их, пока он это не заметит, и дожидается
+// завершения текущих. Если за timeout они не закончились, соединения
+// закрываются принудительно.
+func shutdown(
+	srv *grpc.Server,
+	healthServer *server.HealthServer,
+	httpServers []*http.Server,
+	delay, timeout time.Duration,
+	log *zerolog.Logger,
+) {
+	healthServer.Shutdown()
+
+	if delay > 0 {
+		log.Info().Msgf("waiting %s for load balancers to stop routing", delay)
+		time.Sleep(delay)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	stopped := make(chan struct{})
+
+	go func() {
+		srv.GracefulStop()
+		close(stopped)
+	}()
+
+	for _, httpServer := range httpServers {
+		if err := httpServer.Shutdown(ctx); err != nil {
+			log.Error().Err(err).Str("addr", httpServer.Addr).Msg("http server shutdown failed")
+		}
+	}
+
+	select {
+	case <-stopped:
+		log.Info().Msg("server stopped gracefully")
+	case <-ctx.Done():
+		log.Warn().Msg("shutdown timeout exceeded, closing remaining connections")
+		srv.Stop()
+	}
+}
+
 func main() {
 	log := logger.Get()
 
+	defer logger.Sync()
+
 	cfg, err := config.NewServerConfig()
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+
 	if err != nil {
 		log.Fatal().Err(err).Msg("config building failed")
 	}
 
-	db, err := sql.Open("pgx", cfg.DatabaseURI)
+	if cfg.PrintConfig {
+		if err := cfg.Print(os.Stdout); err != nil {
+			log.Fatal().Err(err).Msg("config printing failed")
+		}
+
+		return
+	}
+
+	logger.SetLevel(cfg.LogLevel())
+
+	shutdownTracing, err := tracing.Setup(context.Background(), serviceName, version, cfg.Telemetry.TracingExporter, os.Stdout)
+	if err != nil {
+		log.Fatal().Err(err).Msg("tracing setup failed")
+	}
+
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			log.Error().Err(err).Msg("tracing shutdown failed")
+		}
+	}()
+
+	// каждый запрос к базе попадает в трассу отдельным спаном
+	db, err := otelsql.Open("pgx", cfg.DatabaseURI, otelsql.WithAttributes(attribute.String("db.system", "postgresql")))
 	if err != nil {
 		log.Fatal().Err(err).Msg("open db error")
 	}
 
 	defer db.Close()
 
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
 	listen, err := net.Listen("tcp", cfg.Addr)
 	if err != nil {
 		log.Error().Err(err).Msg("tcp listen error")
@@ -38,32 +317,192 @@ func main() {
 		return
 	}
 
-	jwtManager := auth.NewJWTManager(cfg.JWTSecret)
+	jwtManager, signingKeysUsecase, err := buildJWTManager(ctx, cfg, db, &log)
+	if err != nil {
+		log.Error().Err(err).Msg("jwt signing keys loading failed")
+
+		return
+	}
 
 	usersRepository := repository.NewUsersRepository(db)
+	refreshTokensRepository := repository.NewRefreshTokensPostgresRepository(db)
+	devicesRepository := repository.NewDevicesPostgresRepository(db)
+	authAttemptsRepository := repository.NewAuthAttemptsPostgresRepository(db)
 	passwordsRepository := repository.NewPasswordsPostgresRepository(db)
+	sharesRepository := repository.NewSharesPostgresRepository(db)
+	orgsRepository := repository.NewOrgsPostgresRepository(db)
+	emergencyRepository := repository.NewEmergencyPostgresRepository(db)
+	sendsRepository := repository.NewSendsPostgresRepository(db)
+	machineTokensRepository := repository.NewMachineTokensPostgresRepository(db)
+	auditRepository := repository.NewAuditPostgresRepository(db)
 
-	authUsecase := usecase.NewAuthUseCase(usersRepository, jwtManager)
-	passwordsUsecase := usecase.NewPasswordsUseCase(passwordsRepository)
+	devicesUsecase := usecase.NewDevicesUseCase(
+		devicesRepository,
+		cfg.Tokens.RevocationCacheTTL,
+		cfg.Tokens.RefreshTTL,
+	)
+	authUsecase := usecase.NewAuthUseCase(
+		usersRepository,
+		refreshTokensRepository,
+		devicesRepository,
+		devicesUsecase,
+		jwtManager,
+		authSettings(cfg),
+	)
+	passwordsUsecase := usecase.NewPasswordsUseCase(
+		passwordsRepository,
+		orgsRepository,
+		emergencyRepository,
+		quotas(cfg),
+	)
+	sharesUsecase := usecase.NewSharesUseCase(sharesRepository)
+	orgsUsecase := usecase.NewOrgsUseCase(orgsRepository)
+	emergencyUsecase := usecase.NewEmergencyUseCase(emergencyRepository, usersRepository)
+	sendsUsecase := usecase.NewSendsUseCase(sendsRepository, cfg.Sends.MaxTTL, cfg.Sends.PublicURL)
+	machineTokensUsecase := usecase.NewMachineTokensUseCase(machineTokensRepository, orgsRepository)
+	auditUsecase := usecase.NewAuditUseCase(auditRepository, usersRepository)
+	twoFactorUsecase := usecase.NewTwoFactorUseCase(usersRepository, jwtManager, cfg.Auth.Require2FA)
+	rateLimitUsecase := usecase.NewRateLimitUseCase(authAttemptsRepository, rateLimits(cfg))
 
-	authServer := server.NewAuthServer(authUsecase, &log)
+	authServer := server.NewAuthServer(authUsecase, twoFactorUsecase, &log)
 	passwordsServer := server.NewPasswordsServer(passwordsUsecase, &log)
+	sharesServer := server.NewSharesServer(sharesUsecase, &log)
+	orgsServer := server.NewOrgsServer(orgsUsecase, &log)
+	emergencyServer := server.NewEmergencyServer(emergencyUsecase, &log)
+	sendsServer := server.NewSendsServer(sendsUsecase, &log)
+	sendsAccessServer := server.NewSendsAccessServer(sendsUsecase, &log)
+	tokensServer := server.NewTokensServer(machineTokensUsecase, &log)
+	auditServer := server.NewAuditServer(auditUsecase, &log)
+	devicesServer := server.NewDevicesServer(devicesUsecase, &log)
+	twoFactorServer := server.NewTwoFactorServer(twoFactorUsecase, &log)
+
+	go emergencyUsecase.Run(ctx, func(count int64) {
+		log.Info().Int64("count", count).Msg("emergency access requests approved after waiting period")
+	}, func(err error) {
+		log.Error().Err(err).Msg("emergency access deadlines checking failed")
+	})
+
+	go sendsUsecase.Run(ctx, func(err error) {
+		log.Error().Err(err).Msg("expired sends cleanup failed")
+	})
+
+	go authUsecase.Run(ctx, func(err error) {
+		log.Error().Err(err).Msg("expired refresh tokens cleanup failed")
+	})
 
 	loggingOpts := []logging.Option{
 		logging.WithLogOnEvents(logging.StartCall, logging.FinishCall),
+		logging.WithFieldsFromContext(server.TraceFields),
 	}
-	srv := grpc.NewServer(
-		grpc.ChainUnaryInterceptor(
-			server.AuthInterceptor(jwtManager),
-			logging.UnaryServerInterceptor(server.Logger(&log), loggingOpts...),
-		),
+	var serverMetrics *metrics.Metrics
+
+	interceptors := make([]grpc.UnaryServerInterceptor, 0)
+
+	// метрики первыми, чтобы учитывать и отклоненные лимитом или авторизацией вызовы
+	if cfg.Telemetry.MetricsAddr != "" {
+		serverMetrics = metrics.New(db)
+		interceptors = append(interceptors, serverMetrics.UnaryServerInterceptors()...)
+	}
+
+	// аудит перед авторизацией и лимитом, чтобы записывать и отклоненные ими вызовы;
+	// лимит после авторизации, чтобы ограничивать второй фактор по пользователю
+	interceptors = append(interceptors,
+		server.AuditInterceptor(auditUsecase, &log),
+		server.AuthInterceptor(jwtManager, devicesUsecase, machineTokensUsecase),
+		server.RateLimitInterceptor(rateLimitUsecase, jwtManager),
+		logging.UnaryServerInterceptor(server.Logger(&log), loggingOpts...),
 	)
+
+	serverOpts := []grpc.ServerOption{
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(interceptors...),
+	}
+
+	var reloader *certs.Reloader
+
+	if cfg.TLSEnabled() {
+		reloader, err = certs.NewReloader(cfg.TLS.CertFile, cfg.TLS.KeyFile, cfg.TLS.ClientCAFile)
+		if err != nil {
+			log.Error().Err(err).Msg("tls certificates loading failed")
+
+			return
+		}
+
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(reloader.TLSConfig())))
+	} else {
+		log.Warn().Msg("tls is disabled, tokens and passwords are sent in cleartext")
+	}
+
+	srv := grpc.NewServer(serverOpts...)
 	pb.RegisterAuthServer(srv, authServer)
 	pb.RegisterPasswordsServer(srv, passwordsServer)
+	pb.RegisterSharesServer(srv, sharesServer)
+	pb.RegisterOrgsServer(srv, orgsServer)
+	pb.RegisterEmergencyServer(srv, emergencyServer)
+	pb.RegisterSendsServer(srv, sendsServer)
+	pb.RegisterSendsAccessServer(srv, sendsAccessServer)
+	pb.RegisterTokensServer(srv, tokensServer)
+	pb.RegisterAuditServer(srv, auditServer)
+	pb.RegisterDevicesServer(srv, devicesServer)
+	pb.RegisterTwoFactorServer(srv, twoFactorServer)
+
+	healthServer := server.NewHealthServer(db, &log)
+	healthpb.RegisterHealthServer(srv, healthServer)
+
+	if cfg.JWT.PublishKeys {
+		if signingKeysUsecase != nil {
+			pb.RegisterKeysServer(srv, server.NewKeysServer(signingKeysUsecase, &log))
+		} else {
+			log.Warn().Msg("jwt keys publishing is unavailable for HS256")
+		}
+	}
+
+	go reloadOnSignal(ctx, cfg, reloader, func(next *config.ServerConfig) {
+		logger.SetLevel(next.LogLevel())
+		authUsecase.SetSettings(authSettings(next))
+		devicesUsecase.SetWindow(next.Tokens.RefreshTTL)
+		rateLimitUsecase.SetLimits(rateLimits(next))
+		passwordsUsecase.SetQuotas(quotas(next))
+	}, &log)
+
+	services := make([]string, 0, len(srv.GetServiceInfo()))
+	for service := range srv.GetServiceInfo() {
+		services = append(services, service)
+	}
+
+	go healthServer.Run(ctx, services, cfg.HealthCheckInterval)
+
+	if cfg.Reflection {
+		reflection.Register(srv)
+	}
+
+	httpServers := make([]*http.Server, 0, 2)
+
+	if serverMetrics != nil {
+		serverMetrics.InitializeMetrics(srv)
+
+		httpServers = append(httpServers, serveMetrics(cfg.Telemetry.MetricsAddr, serverMetrics.Handler(), &log))
+	}
+
+	if cfg.Sends.HTTPAddr != "" {
+		handler := httpserver.NewSendsHandler(sendsUsecase, &log)
+		httpServers = append(httpServers, serveSends(cfg.Sends.HTTPAddr, handler, reloader, &log))
+	}
+
+	serveErr := make(chan error, 1)
+
+	go func() {
+		serveErr <- srv.Serve(listen)
+	}()
 
 	log.Info().Msgf("server started on %s", cfg.Addr)
 
-	if err := srv.Serve(listen); err != nil {
+	select {
+	case err := <-serveErr:
 		log.Error().Err(err).Msg("server has been closed")
+	case <-ctx.Done():
+		log.Info().Msg("shutdown signal received, draining connections")
+		stop()
+		shutdown(srv, healthServer, httpServers, cfg.ShutdownDelay, cfg.ShutdownTimeout, &log)
 	}
 }
@@ -0,0 +1,326 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	DefaultProfileName = "default"
+
+	_defaultClientServer  = ":3200"
+	_defaultClientTimeout = 10 * time.Second
+
+	clientConfigDir  = "go-pass"
+	clientConfigFile = "config.yaml"
+	clientPassDir    = ".go_pass"
+	clientDBName     = "pass.db"
+)
+
+var (
+	ErrUnknownConfigKey = errors.New("unknown config key")
+	ErrUnknownProfile   = errors.New("unknown profile")
+)
+
+type ClientTLSConfig struct {
+	Insecure   bool   `yaml:"insecure"`
+	CAFile     string `yaml:"ca_file,omitempty"`
+	ServerName string `yaml:"server_name,omitempty"`
+	CertFile   string `yaml:"cert_file,omitempty"`
+	KeyFile    string `yaml:"key_file,omitempty"`
+}
+
+// ClientProfile настройки подключения к одному серверу под одним аккаунтом.
+type ClientProfile struct {
+	Server  string          `yaml:"server"`
+	Timeout time.Duration   `yaml:"timeout"`
+	DBPath  string          `yaml:"db_path,omitempty"`
+	TLS     ClientTLSConfig `yaml:"tls"`
+}
+
+type ClientConfig struct {
+	DefaultProfile string                    `yaml:"default_profile"`
+	Profiles       map[string]*ClientProfile `yaml:"profiles"`
+
+	path string
+}
+
+type profileField struct {
+	get func(p *ClientProfile) string
+	set func(p *ClientProfile, value string) error
+}
+
+func stringField(field func(p *ClientProfile) *string) profileField {
+	return profileField{
+		get: func(p *ClientProfile) string { return *field(p) },
+		set: func(p *ClientProfile, value string) error {
+			*field(p) = value
+
+			return nil
+		},
+	}
+}
+
+var profileFields = map[string]profileField{
+	"server":          stringField(func(p *ClientProfile) *string { return &p.Server }),
+	"db_path":         stringField(func(p *ClientProfile) *string { return &p.DBPath }),
+	"tls.ca_file":     stringField(func(p *ClientProfile) *string { return &p.TLS.CAFile }),
+	"tls.server_name": stringField(func(p *ClientProfile) *string { return &p.TLS.ServerName }),
+	"tls.cert_file":   stringField(func(p *ClientProfile) *string { return &p.TLS.CertFile }),
+	"tls.key_file":    stringField(func(p *ClientProfile) *string { return &p.TLS.KeyFile }),
+	"timeout": {
+		get: func(p *ClientProfile) string { return p.Timeout.String() },
+		set: func(p *ClientProfile, value string) error {
+			timeout, err := time.ParseDuration(value)
+			if err != nil {
+				return err
+			}
+
+			p.Timeout = timeout
+
+			return nil
+		},
+	},
+	"tls.insecure": {
+		get: func(p *ClientProfile) string { return strconv.FormatBool(p.TLS.Insecure) },
+		set: func(p *ClientProfile, value string) error {
+			insecure, err := strconv.ParseBool(value)
+			if err != nil {
+				return err
+			}
+
+			p.TLS.Insecure = insecure
+
+			return nil
+		},
+	},
+}
+
+func newDefaultClientProfile() *ClientProfile {
+	return &ClientProfile{
+		Server:  _defaultClientServer,
+		Timeout: _defaultClientTimeout,
+	}
+}
+
+func DefaultClientConfigPath() (string, error) {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(configDir, clientConfigDir, clientConfigFile), nil
+}
+
+// ClientPassDir каталог с локальными данными клиента.
+func ClientPassDir() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(homeDir, clientPassDir), nil
+}
+
+// LoadClientConfig читает конфиг, отсутствующий файл равносилен конфигу по умолчанию.
+func LoadClientConfig(path string) (*ClientConfig, error) {
+	cfg := &ClientConfig{
+		DefaultProfile: DefaultProfileName,
+		Profiles:       make(map[string]*ClientProfile),
+		path:           path,
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return cfg, nil
+		}
+
+		return nil, err
+	}
+
+	if err = yaml.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	if cfg.Profiles == nil {
+		cfg.Profiles = make(map[string]*ClientProfile)
+	}
+
+	if len(cfg.DefaultProfile) == 0 {
+		cfg.DefaultProfile = DefaultProfileName
+	}
+
+	return cfg, nil
+}
+
+func (c *ClientConfig) Save() error {
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(c.path), 0o700); err != nil {
+		return err
+	}
+
+	return os.WriteFile(c.path, data, 0o600)
+}
+
+func (c *ClientConfig) ResolveProfileName(name string) string {
+	if len(name) == 0 {
+		return c.DefaultProfile
+	}
+
+	return name
+}
+
+// Profile возвращает профиль с заполненными значениями по умолчанию.
+func (c *ClientConfig) Profile(name string) (*ClientProfile, error) {
+	name = c.ResolveProfileName(name)
+
+	profile, ok := c.Profiles[name]
+	if !ok {
+		if name != DefaultProfileName {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownProfile, name)
+		}
+
+		profile = newDefaultClientProfile()
+	}
+
+	resolved := *profile
+
+	if len(resolved.Server) == 0 {
+		resolved.Server = _defaultClientServer
+	}
+
+	if resolved.Timeout == 0 {
+		resolved.Timeout = _defaultClientTimeout
+	}
+
+	if len(resolved.DBPath) == 0 {
+		dbPath, err := defaultDBPath(name)
+		if err != nil {
+			return nil, err
+		}
+
+		resolved.DBPath = dbPath
+	}
+
+	return &resolved, nil
+}
+
+func defaultDBPath(profile string) (string, error) {
+	passDir, err := ClientPassDir()
+	if err != nil {
+		return "", err
+	}
+
+	if profile == DefaultProfileName {
+		return filepath.Join(passDir, clientDBName), nil
+	}
+
+	return filepath.Join(passDir, profile+".db"), nil
+}
+
+// Get возвращает значение по ключу вида [profile.]field,
+// без префикса используется профиль currentProfile.
+func (c *ClientConfig) Get(currentProfile, key string) (string, error) {
+	if key == "default_profile" {
+		return c.DefaultProfile, nil
+	}
+
+	name, field, err := c.splitKey(currentProfile, key)
+	if err != nil {
+		return "", err
+	}
+
+	profile, err := c.Profile(name)
+	if err != nil {
+		return "", err
+	}
+
+	return field.get(profile), nil
+}
+
+func (c *ClientConfig) Set(currentProfile, key, value string) error {
+	if key == "default_profile" {
+		c.DefaultProfile = value
+
+		return nil
+	}
+
+	name, field, err := c.splitKey(currentProfile, key)
+	if err != nil {
+		return err
+	}
+
+	profile, ok := c.Profiles[name]
+	if !ok {
+		profile = newDefaultClientProfile()
+		c.Profiles[name] = profile
+	}
+
+	return field.set(profile, value)
+}
+
+// List возвращает все настройки в виде строк profile.field=value.
+func (c *ClientConfig) List() ([]string, error) {
+	names := make([]string, 0, len(c.Profiles)+1)
+
+	for name := range c.Profiles {
+		names = append(names, name)
+	}
+
+	if _, ok := c.Profiles[DefaultProfileName]; !ok {
+		names = append(names, DefaultProfileName)
+	}
+
+	sort.Strings(names)
+
+	fields := make([]string, 0, len(profileFields))
+
+	for field := range profileFields {
+		fields = append(fields, field)
+	}
+
+	sort.Strings(fields)
+
+	lines := []string{"default_profile=" + c.DefaultProfile}
+
+	for _, name := range names {
+		profile, err := c.Profile(name)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, field := range fields {
+			lines = append(lines, fmt.Sprintf("%s.%s=%s", name, field, profileFields[field].get(profile)))
+		}
+	}
+
+	return lines, nil
+}
+
+func (c *ClientConfig) splitKey(currentProfile, key string) (string, profileField, error) {
+	if field, ok := profileFields[key]; ok {
+		return c.ResolveProfileName(currentProfile), field, nil
+	}
+
+	name, fieldName, ok := strings.Cut(key, ".")
+	if ok {
+		if field, ok := profileFields[fieldName]; ok {
+			return name, field, nil
+		}
+	}
+
+	return "", profileField{}, fmt.Errorf("%w: %s", ErrUnknownConfigKey, key)
+}
@@ -0,0 +1,69 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/llravell/go-pass/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientConfig(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	configPath := filepath.Join(t.TempDir(), "go-pass", "config.yaml")
+
+	t.Run("missing file gives default profile", func(t *testing.T) {
+		cfg, err := config.LoadClientConfig(configPath)
+		require.NoError(t, err)
+
+		profile, err := cfg.Profile("")
+		require.NoError(t, err)
+
+		assert.Equal(t, ":3200", profile.Server)
+		assert.Equal(t, 10*time.Second, profile.Timeout)
+		assert.Equal(t, filepath.Join(os.Getenv("HOME"), ".go_pass", "pass.db"), profile.DBPath)
+
+		_, err = cfg.Profile("team")
+		assert.ErrorIs(t, err, config.ErrUnknownProfile)
+	})
+
+	t.Run("set values and save", func(t *testing.T) {
+		cfg, err := config.LoadClientConfig(configPath)
+		require.NoError(t, err)
+
+		require.NoError(t, cfg.Set("", "team.server", "pass.example.com:443"))
+		require.NoError(t, cfg.Set("team", "timeout", "30s"))
+		require.NoError(t, cfg.Set("team", "tls.insecure", "false"))
+		require.ErrorIs(t, cfg.Set("team", "unknown", "1"), config.ErrUnknownConfigKey)
+		require.Error(t, cfg.Set("team", "timeout", "soon"))
+		require.NoError(t, cfg.Save())
+
+		info, err := os.Stat(configPath)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+		loaded, err := config.LoadClientConfig(configPath)
+		require.NoError(t, err)
+
+		profile, err := loaded.Profile("team")
+		require.NoError(t, err)
+
+		assert.Equal(t, "pass.example.com:443", profile.Server)
+		assert.Equal(t, 30*time.Second, profile.Timeout)
+		assert.False(t, profile.TLS.Insecure)
+		assert.Equal(t, filepath.Join(os.Getenv("HOME"), ".go_pass", "team.db"), profile.DBPath)
+
+		value, err := loaded.Get("team", "server")
+		require.NoError(t, err)
+		assert.Equal(t, "pass.example.com:443", value)
+
+		lines, err := loaded.List()
+		require.NoError(t, err)
+		assert.Contains(t, lines, "team.timeout=30s")
+		assert.Contains(t, lines, "default.server=:3200")
+	})
+}
@@ -1,40 +1,263 @@
 package config
 
 import (
+	"bytes"
 	"errors"
 	"flag"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/caarlos0/env"
+	"github.com/llravell/go-pass/pkg/auth"
+	"github.com/llravell/go-pass/pkg/tracing"
+	"github.com/rs/zerolog"
+	"golang.org/x/crypto/bcrypt"
+	"gopkg.in/yaml.v3"
 )
 
 const (
+	RegistrationOpen   = "open"
+	RegistrationClosed = "closed"
+
 	_defaultAddr        = ":3200"
 	_defaultDatabaseURI = ""
 	_defaultJWTSecret   = "secret"
+
+	_defaultJWTAlgorithm         = auth.AlgorithmEdDSA
+	_defaultJWTKeyRotationPeriod = 30 * 24 * time.Hour
+	_defaultJWTKeyGracePeriod    = 24 * time.Hour
+
+	_defaultAccessTokenTTL  = 15 * time.Minute
+	_defaultRefreshTokenTTL = 30 * 24 * time.Hour
+
+	_defaultRevocationCacheTTL = 10 * time.Second
+
+	_defaultBcryptCost   = bcrypt.DefaultCost
+	_defaultRegistration = RegistrationOpen
+
+	_defaultAuthMaxFailures     = 5
+	_defaultAuthBaseDelay       = time.Second
+	_defaultAuthMaxDelay        = time.Minute
+	_defaultAuthLockoutDuration = 15 * time.Minute
+	_defaultAuthFailureWindow   = 15 * time.Minute
+
+	_defaultRefreshTokensRetention = 30 * 24 * time.Hour
+
+	_defaultSendsMaxTTL = 7 * 24 * time.Hour
+
+	_defaultLogLevel = "info"
+
+	_defaultShutdownTimeout     = 30 * time.Second
+	_defaultShutdownDelay       = 5 * time.Second
+	_defaultHealthCheckInterval = 5 * time.Second
+
+	// так же url.URL.Redacted скрывает пароль в URI базы
+	redactedValue = "xxxxx"
 )
 
-var ErrEmptyDatabaseURI = errors.New("got empty database uri")
+var (
+	ErrEmptyDatabaseURI    = errors.New("got empty database uri")
+	ErrIncompleteTLSPair   = errors.New("both tls cert and key files must be set")
+	ErrClientCAWithoutTLS  = errors.New("tls client ca requires tls cert and key files")
+	ErrInvalidTokenTTL     = errors.New("token ttl must be positive")
+	ErrInvalidAuthLimits   = errors.New("auth max failures and delays must be positive")
+	ErrUnsupportedJWTAlg   = errors.New("jwt algorithm must be one of EdDSA, ES256, HS256")
+	ErrDefaultJWTSecret    = errors.New("default jwt secret is allowed only in dev mode")
+	ErrInvalidKeyRotation  = errors.New("jwt key rotation period must be positive and grace period must cover access token ttl")
+	ErrInvalidSendsURL     = errors.New("sends public url must be an absolute http(s) url")
+	ErrInvalidSendsTTL     = errors.New("sends max ttl must be positive")
+	ErrSendsWithoutTLS     = errors.New("sends http server requires tls cert and key files outside dev mode")
+	ErrInsecureSendsURL    = errors.New("sends public url must use https outside dev mode")
+	ErrInvalidLifecycle    = errors.New("shutdown timeout and health check interval must be positive, shutdown delay must not be negative")
+	ErrInvalidBcryptCost   = fmt.Errorf("bcrypt cost must be between %d and %d", bcrypt.MinCost, bcrypt.MaxCost)
+	ErrInvalidRegistration = errors.New("registration must be open or closed")
+	ErrInvalidLogLevel     = errors.New("log level must be one of trace, debug, info, warn, error")
+	ErrInvalidQuota        = errors.New("quotas must not be negative, 0 disables the limit")
+	ErrInvalidRetention    = errors.New("retention windows must not be negative, 0 keeps records forever")
+)
+
+// reloadableKeys — настройки, которые применяются по SIGHUP без перезапуска.
+// Ключ секции покрывает все ее поля.
+var reloadableKeys = map[string]bool{
+	"log.level":          true,
+	"tokens.access_ttl":  true,
+	"tokens.refresh_ttl": true,
+	"auth.bcrypt_cost":   true,
+	"auth.registration":  true,
+	"rate_limit":         true,
+	"quotas":             true,
+	"retention":          true,
+}
+
+var dsnPasswordRe = regexp.MustCompile(`password=\S+`)
+
+type ServerTLSConfig struct {
+	CertFile     string `yaml:"cert_file"      env:"TLS_CERT_FILE"`
+	KeyFile      string `yaml:"key_file"       env:"TLS_KEY_FILE"`
+	ClientCAFile string `yaml:"client_ca_file" env:"TLS_CLIENT_CA_FILE"`
+}
+
+type JWTConfig struct {
+	Secret         string        `yaml:"secret"              env:"JWT_SECRET"`
+	Algorithm      string        `yaml:"algorithm"           env:"JWT_ALGORITHM"`
+	KeyRotation    time.Duration `yaml:"key_rotation_period" env:"JWT_KEY_ROTATION_PERIOD"`
+	KeyGracePeriod time.Duration `yaml:"key_grace_period"    env:"JWT_KEY_GRACE_PERIOD"`
+	PublishKeys    bool          `yaml:"publish_keys"        env:"JWT_PUBLISH_KEYS"`
+}
+
+type TokensConfig struct {
+	AccessTTL          time.Duration `yaml:"access_ttl"           env:"ACCESS_TOKEN_TTL"`
+	RefreshTTL         time.Duration `yaml:"refresh_ttl"          env:"REFRESH_TOKEN_TTL"`
+	RevocationCacheTTL time.Duration `yaml:"revocation_cache_ttl" env:"REVOCATION_CACHE_TTL"`
+}
+
+type AuthConfig struct {
+	Require2FA   bool   `yaml:"require_2fa"  env:"REQUIRE_2FA"`
+	BcryptCost   int    `yaml:"bcrypt_cost"  env:"BCRYPT_COST"`
+	Registration string `yaml:"registration" env:"REGISTRATION_MODE"`
+}
+
+type RateLimitConfig struct {
+	MaxFailures     int           `yaml:"max_failures"     env:"AUTH_MAX_FAILURES"`
+	BaseDelay       time.Duration `yaml:"base_delay"       env:"AUTH_BASE_DELAY"`
+	MaxDelay        time.Duration `yaml:"max_delay"        env:"AUTH_MAX_DELAY"`
+	LockoutDuration time.Duration `yaml:"lockout_duration" env:"AUTH_LOCKOUT_DURATION"`
+	FailureWindow   time.Duration `yaml:"failure_window"   env:"AUTH_FAILURE_WINDOW"`
+}
+
+type QuotasConfig struct {
+	MaxEntrySize int `yaml:"max_entry_size" env:"QUOTA_MAX_ENTRY_SIZE"`
+	MaxEntries   int `yaml:"max_entries"    env:"QUOTA_MAX_ENTRIES"`
+}
 
+type RetentionConfig struct {
+	RefreshTokens time.Duration `yaml:"refresh_tokens" env:"RETENTION_REFRESH_TOKENS"`
+}
+
+type SendsConfig struct {
+	HTTPAddr  string        `yaml:"http_addr"  env:"SENDS_HTTP_ADDRESS"`
+	PublicURL string        `yaml:"public_url" env:"SENDS_PUBLIC_URL"`
+	MaxTTL    time.Duration `yaml:"max_ttl"    env:"SENDS_MAX_TTL"`
+}
+
+type LogConfig struct {
+	Level string `yaml:"level" env:"LOG_LEVEL"`
+}
+
+type TelemetryConfig struct {
+	MetricsAddr     string `yaml:"metrics_addr"     env:"METRICS_ADDRESS"`
+	TracingExporter string `yaml:"tracing_exporter" env:"TRACING_EXPORTER"`
+}
+
+// ServerConfig собирается из значений по умолчанию, YAML файла, переменных
+// окружения и флагов, каждый следующий источник перекрывает предыдущий.
 type ServerConfig struct {
-	Addr        string `env:"GRPC_ADDRESS"`
-	DatabaseURI string `env:"DATABASE_URI"`
-	JWTSecret   string `env:"JWT_SECRET"`
+	Addr                string        `yaml:"addr"                  env:"GRPC_ADDRESS"`
+	DatabaseURI         string        `yaml:"database_uri"          env:"DATABASE_URI"`
+	DevMode             bool          `yaml:"dev_mode"              env:"DEV_MODE"`
+	Reflection          bool          `yaml:"reflection"            env:"GRPC_REFLECTION"`
+	ShutdownTimeout     time.Duration `yaml:"shutdown_timeout"      env:"SHUTDOWN_TIMEOUT"`
+	ShutdownDelay       time.Duration `yaml:"shutdown_delay"        env:"SHUTDOWN_DELAY"`
+	HealthCheckInterval time.Duration `yaml:"health_check_interval" env:"HEALTH_CHECK_INTERVAL"`
+
+	TLS       ServerTLSConfig `yaml:"tls"`
+	JWT       JWTConfig       `yaml:"jwt"`
+	Tokens    TokensConfig    `yaml:"tokens"`
+	Auth      AuthConfig      `yaml:"auth"`
+	RateLimit RateLimitConfig `yaml:"rate_limit"`
+	Quotas    QuotasConfig    `yaml:"quotas"`
+	Retention RetentionConfig `yaml:"retention"`
+	Sends     SendsConfig     `yaml:"sends"`
+	Log       LogConfig       `yaml:"log"`
+	Telemetry TelemetryConfig `yaml:"telemetry"`
+
+	ConfigFile  string `yaml:"-" env:"CONFIG_FILE"`
+	PrintConfig bool   `yaml:"-"`
+}
+
+func defaultServerConfig() *ServerConfig {
+	return &ServerConfig{
+		Addr:                _defaultAddr,
+		DatabaseURI:         _defaultDatabaseURI,
+		ShutdownTimeout:     _defaultShutdownTimeout,
+		ShutdownDelay:       _defaultShutdownDelay,
+		HealthCheckInterval: _defaultHealthCheckInterval,
+		JWT: JWTConfig{
+			Secret:         _defaultJWTSecret,
+			Algorithm:      _defaultJWTAlgorithm,
+			KeyRotation:    _defaultJWTKeyRotationPeriod,
+			KeyGracePeriod: _defaultJWTKeyGracePeriod,
+		},
+		Tokens: TokensConfig{
+			AccessTTL:          _defaultAccessTokenTTL,
+			RefreshTTL:         _defaultRefreshTokenTTL,
+			RevocationCacheTTL: _defaultRevocationCacheTTL,
+		},
+		Auth: AuthConfig{
+			BcryptCost:   _defaultBcryptCost,
+			Registration: _defaultRegistration,
+		},
+		RateLimit: RateLimitConfig{
+			MaxFailures:     _defaultAuthMaxFailures,
+			BaseDelay:       _defaultAuthBaseDelay,
+			MaxDelay:        _defaultAuthMaxDelay,
+			LockoutDuration: _defaultAuthLockoutDuration,
+			FailureWindow:   _defaultAuthFailureWindow,
+		},
+		Retention: RetentionConfig{
+			RefreshTokens: _defaultRefreshTokensRetention,
+		},
+		Sends: SendsConfig{
+			MaxTTL: _defaultSendsMaxTTL,
+		},
+		Log: LogConfig{
+			Level: _defaultLogLevel,
+		},
+	}
 }
 
 func NewServerConfig() (*ServerConfig, error) {
-	cfg := &ServerConfig{
-		Addr:        _defaultAddr,
-		DatabaseURI: _defaultDatabaseURI,
-		JWTSecret:   _defaultJWTSecret,
+	return LoadServerConfig(os.Args[1:])
+}
+
+// LoadServerConfig читает конфигурацию для переданных аргументов командной строки.
+// При перечитывании по SIGHUP вызывается с теми же аргументами, поэтому флаги
+// по-прежнему перекрывают файл.
+func LoadServerConfig(args []string) (*ServerConfig, error) {
+	// первый проход нужен только чтобы узнать путь к файлу
+	cfg := defaultServerConfig()
+	if err := cfg.flagSet(flag.ContinueOnError).Parse(args); err != nil {
+		return nil, err
+	}
+
+	path := cfg.ConfigFile
+	if path == "" {
+		path = os.Getenv("CONFIG_FILE")
 	}
 
-	if err := env.Parse(cfg); err != nil {
+	cfg = defaultServerConfig()
+
+	if path != "" {
+		if err := cfg.readFile(path); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := cfg.parseEnv(); err != nil {
 		return nil, err
 	}
 
-	flag.StringVar(&cfg.Addr, "a", cfg.Addr, "Server grpc address")
-	flag.StringVar(&cfg.DatabaseURI, "d", cfg.DatabaseURI, "Database connect uri")
-	flag.Parse()
+	if err := cfg.flagSet(flag.ContinueOnError).Parse(args); err != nil {
+		return nil, err
+	}
+
+	cfg.ConfigFile = path
 
 	if err := cfg.Validate(); err != nil {
 		return nil, err
@@ -43,10 +266,271 @@ func NewServerConfig() (*ServerConfig, error) {
 	return cfg, nil
 }
 
+func (c *ServerConfig) flagSet(errorHandling flag.ErrorHandling) *flag.FlagSet {
+	fs := flag.NewFlagSet(os.Args[0], errorHandling)
+
+	fs.StringVar(&c.ConfigFile, "config", c.ConfigFile, "YAML config file, env and flags override its values")
+	fs.BoolVar(&c.PrintConfig, "print-config", c.PrintConfig, "Print effective config with secrets redacted and exit")
+	fs.StringVar(&c.Addr, "a", c.Addr, "Server grpc address")
+	fs.StringVar(&c.DatabaseURI, "d", c.DatabaseURI, "Database connect uri")
+	fs.BoolVar(&c.DevMode, "dev", c.DevMode, "Dev mode, allows insecure defaults such as the default jwt secret")
+	fs.BoolVar(&c.Auth.Require2FA, "require-2fa", c.Auth.Require2FA, "Require two-factor authentication for all users")
+	fs.StringVar(&c.TLS.CertFile, "tls-cert", c.TLS.CertFile, "TLS certificate file")
+	fs.StringVar(&c.TLS.KeyFile, "tls-key", c.TLS.KeyFile, "TLS private key file")
+	fs.StringVar(&c.TLS.ClientCAFile, "tls-client-ca", c.TLS.ClientCAFile, "CA bundle to verify client certificates (mTLS)")
+	fs.StringVar(&c.Sends.HTTPAddr, "sends-addr", c.Sends.HTTPAddr, "HTTP address for one-time send links")
+	fs.StringVar(&c.Telemetry.TracingExporter, "tracing", c.Telemetry.TracingExporter,
+		"Tracing exporter: otlp (OTEL_EXPORTER_OTLP_* env) or stdout, disabled if empty")
+	fs.StringVar(&c.Telemetry.MetricsAddr, "metrics-addr", c.Telemetry.MetricsAddr,
+		"HTTP address for Prometheus metrics, disabled if empty")
+	fs.BoolVar(&c.Reflection, "reflection", c.Reflection, "Enable grpc server reflection")
+	fs.DurationVar(&c.ShutdownTimeout, "shutdown-timeout", c.ShutdownTimeout,
+		"Time to drain in-flight requests on SIGTERM before forcing the server to stop")
+	fs.DurationVar(&c.ShutdownDelay, "shutdown-delay", c.ShutdownDelay,
+		"Time to keep serving after health turns NOT_SERVING so load balancers stop routing")
+	fs.StringVar(&c.Log.Level, "log-level", c.Log.Level, "Log level: trace, debug, info, warn, error")
+
+	return fs
+}
+
+// readFile накладывает значения из файла на текущие, незнакомые ключи считаются
+// ошибкой, чтобы опечатка не превращалась молча в значение по умолчанию.
+func (c *ServerConfig) readFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading config file: %w", err)
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	if err = decoder.Decode(c); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("parsing config file %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// parseEnv разбирает переменные по секциям: env не заходит во вложенные структуры.
+func (c *ServerConfig) parseEnv() error {
+	sections := []interface{}{
+		c, &c.TLS, &c.JWT, &c.Tokens, &c.Auth, &c.RateLimit,
+		&c.Quotas, &c.Retention, &c.Sends, &c.Log, &c.Telemetry,
+	}
+
+	for _, section := range sections {
+		if err := env.Parse(section); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *ServerConfig) TLSEnabled() bool {
+	return c.TLS.CertFile != "" && c.TLS.KeyFile != ""
+}
+
+// LogLevel возвращает разобранный уровень, Validate гарантирует, что он корректен.
+func (c *ServerConfig) LogLevel() zerolog.Level {
+	level, err := zerolog.ParseLevel(c.Log.Level)
+	if err != nil {
+		return zerolog.InfoLevel
+	}
+
+	return level
+}
+
+// Validate проверяет все настройки сразу и возвращает все найденные ошибки,
+// каждая с ключом файла конфигурации и текущим значением.
 func (c *ServerConfig) Validate() error {
-	if c.DatabaseURI == "" {
-		return ErrEmptyDatabaseURI
+	var errs []error
+
+	check := func(ok bool, key string, value any, err error) {
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s=%v: %w", key, value, err))
+		}
+	}
+
+	check(c.DatabaseURI != "", "database_uri", `""`, ErrEmptyDatabaseURI)
+	check(c.ShutdownTimeout > 0, "shutdown_timeout", c.ShutdownTimeout, ErrInvalidLifecycle)
+	check(c.ShutdownDelay >= 0, "shutdown_delay", c.ShutdownDelay, ErrInvalidLifecycle)
+	check(c.HealthCheckInterval > 0, "health_check_interval", c.HealthCheckInterval, ErrInvalidLifecycle)
+
+	if (c.TLS.CertFile == "") != (c.TLS.KeyFile == "") {
+		errs = append(errs, fmt.Errorf("tls.cert_file=%s, tls.key_file=%s: %w",
+			c.TLS.CertFile, c.TLS.KeyFile, ErrIncompleteTLSPair))
+	}
+
+	check(c.TLS.ClientCAFile == "" || c.TLSEnabled(), "tls.client_ca_file", c.TLS.ClientCAFile, ErrClientCAWithoutTLS)
+
+	check(c.Tokens.AccessTTL > 0, "tokens.access_ttl", c.Tokens.AccessTTL, ErrInvalidTokenTTL)
+	check(c.Tokens.RefreshTTL > 0, "tokens.refresh_ttl", c.Tokens.RefreshTTL, ErrInvalidTokenTTL)
+
+	errs = append(errs, c.validateJWT()...)
+
+	check(c.Auth.BcryptCost >= bcrypt.MinCost && c.Auth.BcryptCost <= bcrypt.MaxCost,
+		"auth.bcrypt_cost", c.Auth.BcryptCost, ErrInvalidBcryptCost)
+	check(c.Auth.Registration == RegistrationOpen || c.Auth.Registration == RegistrationClosed,
+		"auth.registration", c.Auth.Registration, ErrInvalidRegistration)
+
+	limits := c.RateLimit
+	check(limits.MaxFailures > 0, "rate_limit.max_failures", limits.MaxFailures, ErrInvalidAuthLimits)
+	check(limits.BaseDelay > 0, "rate_limit.base_delay", limits.BaseDelay, ErrInvalidAuthLimits)
+	check(limits.MaxDelay >= limits.BaseDelay, "rate_limit.max_delay", limits.MaxDelay, ErrInvalidAuthLimits)
+	check(limits.LockoutDuration > 0, "rate_limit.lockout_duration", limits.LockoutDuration, ErrInvalidAuthLimits)
+	check(limits.FailureWindow > 0, "rate_limit.failure_window", limits.FailureWindow, ErrInvalidAuthLimits)
+
+	check(c.Quotas.MaxEntrySize >= 0, "quotas.max_entry_size", c.Quotas.MaxEntrySize, ErrInvalidQuota)
+	check(c.Quotas.MaxEntries >= 0, "quotas.max_entries", c.Quotas.MaxEntries, ErrInvalidQuota)
+	check(c.Retention.RefreshTokens >= 0, "retention.refresh_tokens", c.Retention.RefreshTokens, ErrInvalidRetention)
+
+	errs = append(errs, c.validateSends()...)
+
+	level, err := zerolog.ParseLevel(c.Log.Level)
+	check(err == nil && level != zerolog.NoLevel, "log.level", c.Log.Level, ErrInvalidLogLevel)
+
+	if err = tracing.ValidateExporter(c.Telemetry.TracingExporter); err != nil {
+		errs = append(errs, fmt.Errorf("telemetry.tracing_exporter=%s: %w", c.Telemetry.TracingExporter, err))
+	}
+
+	return errors.Join(errs...)
+}
+
+func (c *ServerConfig) validateJWT() []error {
+	switch c.JWT.Algorithm {
+	case auth.AlgorithmHS256:
+		if !c.DevMode && (c.JWT.Secret == "" || c.JWT.Secret == _defaultJWTSecret) {
+			return []error{fmt.Errorf("jwt.secret: %w, set a random secret or use EdDSA", ErrDefaultJWTSecret)}
+		}
+	case auth.AlgorithmEdDSA, auth.AlgorithmES256:
+		if c.JWT.KeyRotation <= 0 || c.JWT.KeyGracePeriod < c.Tokens.AccessTTL {
+			return []error{fmt.Errorf("jwt.key_rotation_period=%s, jwt.key_grace_period=%s: %w",
+				c.JWT.KeyRotation, c.JWT.KeyGracePeriod, ErrInvalidKeyRotation)}
+		}
+	default:
+		return []error{fmt.Errorf("jwt.algorithm=%s: %w", c.JWT.Algorithm, ErrUnsupportedJWTAlg)}
 	}
 
 	return nil
 }
+
+func (c *ServerConfig) validateSends() []error {
+	var errs []error
+
+	if c.Sends.MaxTTL <= 0 {
+		errs = append(errs, fmt.Errorf("sends.max_ttl=%s: %w", c.Sends.MaxTTL, ErrInvalidSendsTTL))
+	}
+
+	// Ссылки несут ключ передачи во фрагменте, страницу нельзя отдавать по открытому HTTP.
+	if c.Sends.HTTPAddr != "" && !c.TLSEnabled() && !c.DevMode {
+		errs = append(errs, fmt.Errorf("sends.http_addr=%s: %w", c.Sends.HTTPAddr, ErrSendsWithoutTLS))
+	}
+
+	if c.Sends.PublicURL == "" {
+		return errs
+	}
+
+	publicURL, err := url.Parse(c.Sends.PublicURL)
+	if err != nil || publicURL.Host == "" || (publicURL.Scheme != "http" && publicURL.Scheme != "https") {
+		return append(errs, fmt.Errorf("sends.public_url=%s: %w", c.Sends.PublicURL, ErrInvalidSendsURL))
+	}
+
+	if publicURL.Scheme == "http" && !c.DevMode {
+		errs = append(errs, fmt.Errorf("sends.public_url=%s: %w", c.Sends.PublicURL, ErrInsecureSendsURL))
+	}
+
+	return errs
+}
+
+// Redacted возвращает копию конфигурации без секретов, ее можно печатать и логировать.
+func (c *ServerConfig) Redacted() *ServerConfig {
+	redacted := *c
+
+	if redacted.JWT.Secret != "" {
+		redacted.JWT.Secret = redactedValue
+	}
+
+	if dbURL, err := url.Parse(redacted.DatabaseURI); err == nil && dbURL.User != nil {
+		redacted.DatabaseURI = dbURL.Redacted()
+	} else {
+		redacted.DatabaseURI = dsnPasswordRe.ReplaceAllString(redacted.DatabaseURI, "password="+redactedValue)
+	}
+
+	return &redacted
+}
+
+// Print выводит итоговую конфигурацию в YAML без секретов.
+func (c *ServerConfig) Print(w io.Writer) error {
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(c.Redacted()); err != nil {
+		return err
+	}
+
+	return encoder.Close()
+}
+
+// Changes сравнивает конфигурации и делит ключи изменившихся настроек на те,
+// что применяются на лету, и те, что вступят в силу только после перезапуска.
+func (c *ServerConfig) Changes(next *ServerConfig) (reloadable []string, restartRequired []string, err error) {
+	current, err := flatten(c)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updated, err := flatten(next)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for key, value := range updated {
+		if current[key] == value {
+			continue
+		}
+
+		section, _, _ := strings.Cut(key, ".")
+		if reloadableKeys[key] || reloadableKeys[section] {
+			reloadable = append(reloadable, key)
+		} else {
+			restartRequired = append(restartRequired, key)
+		}
+	}
+
+	sort.Strings(reloadable)
+	sort.Strings(restartRequired)
+
+	return reloadable, restartRequired, nil
+}
+
+// flatten превращает конфигурацию в плоский набор "секция.ключ" -> значение
+// через YAML, чтобы имена ключей совпадали с файлом.
+func flatten(c *ServerConfig) (map[string]string, error) {
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+
+	var tree map[string]interface{}
+	if err = yaml.Unmarshal(data, &tree); err != nil {
+		return nil, err
+	}
+
+	flat := make(map[string]string)
+
+	for key, value := range tree {
+		section, ok := value.(map[string]interface{})
+		if !ok {
+			flat[key] = fmt.Sprint(value)
+
+			continue
+		}
+
+		for field, fieldValue := range section {
+			flat[key+"."+field] = fmt.Sprint(fieldValue)
+		}
+	}
+
+	return flat, nil
+}
@@ -0,0 +1,175 @@
+package config_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/llravell/go-pass/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeServerConfig(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "server.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	return path
+}
+
+func TestLoadServerConfig(t *testing.T) {
+	t.Run("file is overridden by env and flags", func(t *testing.T) {
+		path := writeServerConfig(t, `
+addr: ":4000"
+database_uri: postgres://gopass:secret@db/gopass
+tokens:
+  access_ttl: 5m
+quotas:
+  max_entries: 100
+log:
+  level: debug
+`)
+		t.Setenv("ACCESS_TOKEN_TTL", "10m")
+		t.Setenv("QUOTA_MAX_ENTRIES", "200")
+
+		cfg, err := config.LoadServerConfig([]string{"-config", path, "-a", ":5000"})
+		require.NoError(t, err)
+
+		assert.Equal(t, ":5000", cfg.Addr)
+		assert.Equal(t, "postgres://gopass:secret@db/gopass", cfg.DatabaseURI)
+		assert.Equal(t, 10*time.Minute, cfg.Tokens.AccessTTL)
+		assert.Equal(t, 200, cfg.Quotas.MaxEntries)
+		assert.Equal(t, "debug", cfg.Log.Level)
+		assert.Equal(t, 30*24*time.Hour, cfg.Tokens.RefreshTTL)
+		assert.Equal(t, 5*time.Second, cfg.ShutdownDelay)
+	})
+
+	t.Run("config file path from env", func(t *testing.T) {
+		t.Setenv("CONFIG_FILE", writeServerConfig(t, "database_uri: postgres://db/gopass\n"))
+
+		cfg, err := config.LoadServerConfig(nil)
+		require.NoError(t, err)
+		assert.Equal(t, "postgres://db/gopass", cfg.DatabaseURI)
+	})
+
+	t.Run("rejects unknown keys", func(t *testing.T) {
+		path := writeServerConfig(t, "database_uri: postgres://db/gopass\ntokens:\n  acess_ttl: 5m\n")
+
+		_, err := config.LoadServerConfig([]string{"-config", path})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "acess_ttl")
+	})
+
+	t.Run("reports all invalid settings", func(t *testing.T) {
+		path := writeServerConfig(t, `
+database_uri: postgres://db/gopass
+shutdown_delay: -1s
+auth:
+  bcrypt_cost: 50
+  registration: invite
+retention:
+  refresh_tokens: -1h
+`)
+
+		_, err := config.LoadServerConfig([]string{"-config", path})
+		require.Error(t, err)
+
+		assert.ErrorIs(t, err, config.ErrInvalidBcryptCost)
+		assert.ErrorIs(t, err, config.ErrInvalidRegistration)
+		assert.ErrorIs(t, err, config.ErrInvalidRetention)
+		assert.ErrorIs(t, err, config.ErrInvalidLifecycle)
+		assert.Contains(t, err.Error(), "auth.bcrypt_cost=50")
+		assert.Contains(t, err.Error(), "shutdown_delay=-1s")
+	})
+}
+
+func TestServerConfigValidateSends(t *testing.T) {
+	valid := func() *config.ServerConfig {
+		cfg, err := config.LoadServerConfig([]string{"-d", "postgres://db/gopass"})
+		require.NoError(t, err)
+
+		return cfg
+	}
+
+	t.Run("plain http requires dev mode", func(t *testing.T) {
+		cfg := valid()
+		cfg.Sends.HTTPAddr = ":8443"
+		cfg.Sends.PublicURL = "http://send.example.com"
+
+		err := cfg.Validate()
+		assert.ErrorIs(t, err, config.ErrSendsWithoutTLS)
+		assert.ErrorIs(t, err, config.ErrInsecureSendsURL)
+
+		cfg.DevMode = true
+		assert.NoError(t, cfg.Validate())
+	})
+
+	t.Run("tls with https url", func(t *testing.T) {
+		cfg := valid()
+		cfg.Sends.HTTPAddr = ":8443"
+		cfg.Sends.PublicURL = "https://send.example.com"
+		cfg.TLS.CertFile = "server.crt"
+		cfg.TLS.KeyFile = "server.key"
+
+		assert.NoError(t, cfg.Validate())
+	})
+
+	t.Run("invalid url is reported once", func(t *testing.T) {
+		cfg := valid()
+		cfg.Sends.PublicURL = "send.example.com"
+
+		err := cfg.Validate()
+		assert.ErrorIs(t, err, config.ErrInvalidSendsURL)
+		assert.NotErrorIs(t, err, config.ErrInsecureSendsURL)
+	})
+}
+
+func TestServerConfigPrint(t *testing.T) {
+	t.Run("redacts secrets", func(t *testing.T) {
+		path := writeServerConfig(t, `
+database_uri: postgres://gopass:db-password@db/gopass
+dev_mode: true
+jwt:
+  algorithm: HS256
+  secret: jwt-secret
+`)
+
+		cfg, err := config.LoadServerConfig([]string{"-config", path})
+		require.NoError(t, err)
+
+		var out bytes.Buffer
+		require.NoError(t, cfg.Print(&out))
+
+		assert.NotContains(t, out.String(), "db-password")
+		assert.NotContains(t, out.String(), "jwt-secret")
+		assert.Contains(t, out.String(), "access_ttl: 15m0s")
+		assert.Equal(t, "jwt-secret", cfg.JWT.Secret)
+	})
+
+	t.Run("redacts password in key-value dsn", func(t *testing.T) {
+		cfg, err := config.LoadServerConfig([]string{"-d", "host=db user=gopass password=db-password"})
+		require.NoError(t, err)
+
+		assert.Equal(t, "host=db user=gopass password=xxxxx", cfg.Redacted().DatabaseURI)
+	})
+}
+
+func TestServerConfigChanges(t *testing.T) {
+	current, err := config.LoadServerConfig([]string{"-d", "postgres://db/gopass"})
+	require.NoError(t, err)
+
+	next, err := config.LoadServerConfig([]string{"-d", "postgres://db/gopass", "-a", ":5000", "-log-level", "debug"})
+	require.NoError(t, err)
+
+	next.RateLimit.MaxFailures = 10
+
+	reloadable, restartRequired, err := current.Changes(next)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"log.level", "rate_limit.max_failures"}, reloadable)
+	assert.Equal(t, []string{"addr"}, restartRequired)
+}
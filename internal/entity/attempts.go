@@ -0,0 +1,11 @@
+package entity
+
+import "time"
+
+// AuthAttempts - счетчик неудачных попыток входа по ключу (адрес клиента или логин).
+type AuthAttempts struct {
+	Key           string
+	Failures      int
+	LastFailureAt time.Time
+	LockedUntil   time.Time
+}
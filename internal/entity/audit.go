@@ -0,0 +1,58 @@
+package entity
+
+import (
+	"time"
+
+	pb "github.com/llravell/go-pass/pkg/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AuditEvent — запись журнала аудита. UserID равен нулю, если действие
+// не удалось связать с аккаунтом, например при входе с неизвестным логином,
+// тогда в Login остается логин из запроса. Entry — имя записи или объекта,
+// над которым выполнено действие.
+type AuditEvent struct {
+	ID        int64     `json:"id"`
+	UserID    int       `json:"user_id,omitempty"`
+	Login     string    `json:"login,omitempty"`
+	DeviceID  int       `json:"device_id,omitempty"`
+	TokenID   int       `json:"token_id,omitempty"`
+	Action    string    `json:"action"`
+	Entry     string    `json:"entry,omitempty"`
+	Outcome   string    `json:"outcome"`
+	IP        string    `json:"ip,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// AuditFilter ограничивает выгрузку журнала, нулевые поля не фильтруют.
+type AuditFilter struct {
+	UserID int
+	Since  time.Time
+	Until  time.Time
+}
+
+func (e *AuditEvent) ToPB() *pb.AuditEvent {
+	return &pb.AuditEvent{
+		Id:        e.ID,
+		Action:    e.Action,
+		Entry:     e.Entry,
+		Outcome:   e.Outcome,
+		DeviceId:  int32(e.DeviceID), //nolint:gosec
+		TokenId:   int32(e.TokenID),  //nolint:gosec
+		Ip:        e.IP,
+		CreatedAt: timestamppb.New(e.CreatedAt),
+	}
+}
+
+func NewAuditEventFromPB(event *pb.AuditEvent) *AuditEvent {
+	return &AuditEvent{
+		ID:        event.GetId(),
+		Action:    event.GetAction(),
+		Entry:     event.GetEntry(),
+		Outcome:   event.GetOutcome(),
+		DeviceID:  int(event.GetDeviceId()),
+		TokenID:   int(event.GetTokenId()),
+		IP:        event.GetIp(),
+		CreatedAt: event.GetCreatedAt().AsTime(),
+	}
+}
@@ -0,0 +1,48 @@
+package entity
+
+import (
+	"time"
+
+	pb "github.com/llravell/go-pass/pkg/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Device - клиент, на котором выполнен вход. Каждый логин регистрирует новое устройство,
+// отзыв устройства завершает его сессию на всех инстансах сервера.
+type Device struct {
+	ID            int
+	UserID        int
+	Name          string
+	ClientVersion string
+	IP            string
+	LastSeenAt    time.Time
+	CreatedAt     time.Time
+	Revoked       bool
+	Current       bool
+}
+
+func (d *Device) ToPB() *pb.Device {
+	return &pb.Device{
+		Id:            int32(d.ID), //nolint:gosec
+		Name:          d.Name,
+		ClientVersion: d.ClientVersion,
+		Ip:            d.IP,
+		LastSeenAt:    timestamppb.New(d.LastSeenAt),
+		CreatedAt:     timestamppb.New(d.CreatedAt),
+		Revoked:       d.Revoked,
+		Current:       d.Current,
+	}
+}
+
+func NewDeviceFromPB(device *pb.Device) *Device {
+	return &Device{
+		ID:            int(device.GetId()),
+		Name:          device.GetName(),
+		ClientVersion: device.GetClientVersion(),
+		IP:            device.GetIp(),
+		LastSeenAt:    device.GetLastSeenAt().AsTime(),
+		CreatedAt:     device.GetCreatedAt().AsTime(),
+		Revoked:       device.GetRevoked(),
+		Current:       device.GetCurrent(),
+	}
+}
@@ -0,0 +1,91 @@
+package entity
+
+import (
+	"time"
+
+	pb "github.com/llravell/go-pass/pkg/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type EmergencyStatus int
+
+const (
+	EmergencyStatusIdle EmergencyStatus = iota
+	EmergencyStatusRequested
+	EmergencyStatusApproved
+)
+
+func (s EmergencyStatus) String() string {
+	switch s {
+	case EmergencyStatusRequested:
+		return "requested"
+	case EmergencyStatusApproved:
+		return "approved"
+	default:
+		return "idle"
+	}
+}
+
+// EmergencyAccess — доверенный контакт, который может запросить доступ
+// к хранилищу владельца. Если владелец не отклонит запрос за WaitPeriod,
+// доступ одобряется автоматически. Key — ключ хранилища владельца,
+// зашифрованный открытым ключом контакта, серверу он недоступен.
+type EmergencyAccess struct {
+	ID          int
+	GrantorID   int
+	Grantor     string
+	GranteeID   int
+	Grantee     string
+	WaitPeriod  time.Duration
+	Status      EmergencyStatus
+	RequestedAt time.Time
+	Key         string
+}
+
+// AvailableAt — момент автоматического одобрения запроса.
+func (a *EmergencyAccess) AvailableAt() time.Time {
+	return a.RequestedAt.Add(a.WaitPeriod)
+}
+
+func (a *EmergencyAccess) Approved() bool {
+	return a.Status == EmergencyStatusApproved
+}
+
+// ToPB отдает ключ только контакту и только после одобрения.
+func (a *EmergencyAccess) ToPB(userID int) *pb.EmergencyAccess {
+	access := &pb.EmergencyAccess{
+		Id:         int32(a.ID), //nolint:gosec
+		Grantor:    a.Grantor,
+		Grantee:    a.Grantee,
+		WaitPeriod: durationpb.New(a.WaitPeriod),
+		Status:     pb.EmergencyStatus(a.Status), //nolint:gosec
+	}
+
+	if !a.RequestedAt.IsZero() {
+		access.RequestedAt = timestamppb.New(a.RequestedAt)
+	}
+
+	if userID == a.GranteeID && a.Approved() {
+		access.Key = a.Key
+	}
+
+	return access
+}
+
+func NewEmergencyAccessFromPB(access *pb.EmergencyAccess) *EmergencyAccess {
+	emergencyAccess := &EmergencyAccess{
+		ID:         int(access.GetId()),
+		Grantor:    access.GetGrantor(),
+		Grantee:    access.GetGrantee(),
+		WaitPeriod: access.GetWaitPeriod().AsDuration(),
+		Status:     EmergencyStatus(access.GetStatus()),
+		Key:        access.GetKey(),
+	}
+
+	if access.GetRequestedAt() != nil {
+		emergencyAccess.RequestedAt = access.GetRequestedAt().AsTime()
+	}
+
+	return emergencyAccess
+}
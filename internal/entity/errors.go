@@ -9,6 +9,8 @@ import (
 
 var ErrUserConflict = errors.New("user with same login already exists")
 
+var ErrInvalidCredentials = errors.New("invalid login or password")
+
 var ErrPasswordDoesNotExist = errors.New("password does not exist")
 
 var ErrPasswordAlreadyExist = errors.New("password with same name already exists")
@@ -17,6 +19,90 @@ var ErrNoSession = errors.New("user does not have active session")
 
 var ErrUnknownConflict = errors.New("unknown conflict")
 
+var ErrServerLogoutFailed = errors.New("session was not revoked on server")
+
+var ErrSessionExpired = errors.New("session has expired, please log in again")
+
+var ErrRefreshTokenNotFound = errors.New("refresh token not found")
+
+var ErrRefreshTokenExpired = errors.New("refresh token has expired")
+
+var ErrRefreshTokenReused = errors.New("refresh token has already been used")
+
+var ErrRefreshTokenRevoked = errors.New("refresh token has been revoked")
+
+var ErrDeviceNotFound = errors.New("device not found")
+
+var ErrInvalidSecondFactorCode = errors.New("invalid second factor code")
+
+var ErrTwoFactorNotEnrolled = errors.New("two-factor authentication is not enrolled")
+
+var ErrTwoFactorAlreadyEnabled = errors.New("two-factor authentication is already enabled")
+
+var ErrTwoFactorRequired = errors.New("two-factor authentication is required by server")
+
+var ErrInvalidChallenge = errors.New("invalid or expired login challenge")
+
+var ErrKeyPairNotFound = errors.New("sharing key pair is not set up")
+
+var ErrKeyPairAlreadyExists = errors.New("sharing key pair already exists")
+
+var ErrRecipientNotFound = errors.New("recipient not found or has not set up sharing yet")
+
+var ErrRecipientKeyNotPinned = errors.New("recipient key is not pinned yet")
+
+var ErrShareWithSelf = errors.New("cannot share with yourself")
+
+var ErrShareNotFound = errors.New("share not found")
+
+var ErrShareAlreadyExists = errors.New("entry is already shared with this user")
+
+var ErrShareReadOnly = errors.New("share is read-only")
+
+var ErrOrgNotFound = errors.New("organization not found")
+
+var ErrInvalidOrgName = errors.New("organization and collection names must be non-empty and must not contain '/'")
+
+var ErrOrgAlreadyExists = errors.New("organization with same name already exists")
+
+var ErrOrgMemberNotFound = errors.New("user is not a member of the organization")
+
+var ErrInvalidOrgRole = errors.New("role must be one of owner, admin, editor, viewer")
+
+var ErrLastOrgOwner = errors.New("organization must keep at least one owner")
+
+var ErrCollectionNotFound = errors.New("collection not found")
+
+var ErrCollectionAlreadyExists = errors.New("collection with same name already exists")
+
+var ErrPermissionDenied = errors.New("not enough permissions in the organization")
+
+var ErrEmergencyAccessNotFound = errors.New("emergency access not found")
+
+var ErrEmergencyAccessWithSelf = errors.New("cannot grant emergency access to yourself")
+
+var ErrEmergencyAccessNotRequested = errors.New("emergency access has not been requested")
+
+var ErrEmergencyAccessNotApproved = errors.New("emergency access has not been approved yet")
+
+var ErrInvalidWaitPeriod = errors.New("emergency access waiting period must be positive")
+
+var ErrSendNotFound = errors.New("send not found, it may have expired or been viewed")
+
+var ErrInvalidSend = errors.New("send must have positive ttl within server limit, at least one view and non-empty payload")
+
+var ErrSendTooLarge = errors.New("send payload is too large")
+
+var ErrInvalidSendLink = errors.New("invalid send link")
+
+var ErrMachineTokenNotFound = errors.New("machine token not found")
+
+var ErrInvalidMachineToken = errors.New("machine token must have a name and positive ttl")
+
+var ErrMachineTokenScope = errors.New("machine token has no access to this operation")
+
+var ErrShareVersionConflict = errors.New("share has been changed by someone else, run sync and retry")
+
 type PasswordConflictType string
 
 const (
@@ -89,3 +175,9 @@ func (e *PasswordConflictError) TypePB() pb.ConflictType {
 func (e *PasswordConflictError) Error() string {
 	return fmt.Sprintf("%s conflict: actual (v%d) != incoming (v%d)", e.Type(), e.Actual().Version, e.Incoming().Version)
 }
+
+var ErrRegistrationClosed = errors.New("registration of new accounts is closed on this server")
+
+var ErrEntryTooLarge = errors.New("entry exceeds the server size limit")
+
+var ErrEntriesQuotaExceeded = errors.New("entries quota of the vault or collection is exceeded")
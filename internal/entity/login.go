@@ -0,0 +1,98 @@
+package entity
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	EntryTypeKey   = "type"
+	EntryTypeLogin = "login"
+
+	loginURLKey      = "url"
+	loginUsernameKey = "username"
+	loginStaleKey    = "stale"
+)
+
+// Login типизированная запись с учетными данными для некоторого URL.
+// Хранится как обычный Password: секрет шифруется в Value,
+// а атрибуты записываются в Meta строками key=value.
+type Login struct {
+	Name     string
+	URL      string
+	Username string
+	Password string
+	Stale    bool
+	Version  int
+}
+
+func ParseMeta(meta string) map[string]string {
+	attrs := make(map[string]string)
+
+	for _, line := range strings.Split(meta, "\n") {
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+
+		attrs[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+
+	return attrs
+}
+
+func FormatMeta(attrs map[string]string) string {
+	keys := make([]string, 0, len(attrs))
+
+	for key := range attrs {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	lines := make([]string, 0, len(keys))
+
+	for _, key := range keys {
+		lines = append(lines, key+"="+attrs[key])
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func IsLoginPassword(password *Password) bool {
+	return ParseMeta(password.Meta)[EntryTypeKey] == EntryTypeLogin
+}
+
+func NewLoginFromPassword(password *Password) *Login {
+	attrs := ParseMeta(password.Meta)
+	stale, _ := strconv.ParseBool(attrs[loginStaleKey])
+
+	return &Login{
+		Name:     password.Name,
+		URL:      attrs[loginURLKey],
+		Username: attrs[loginUsernameKey],
+		Password: password.Value,
+		Stale:    stale,
+		Version:  password.Version,
+	}
+}
+
+func (login *Login) ToPassword() *Password {
+	attrs := map[string]string{
+		EntryTypeKey:     EntryTypeLogin,
+		loginURLKey:      login.URL,
+		loginUsernameKey: login.Username,
+	}
+
+	if login.Stale {
+		attrs[loginStaleKey] = strconv.FormatBool(login.Stale)
+	}
+
+	return &Password{
+		Name:    login.Name,
+		Value:   login.Password,
+		Meta:    FormatMeta(attrs),
+		Version: login.Version,
+	}
+}
@@ -0,0 +1,87 @@
+package entity
+
+import (
+	"time"
+
+	pb "github.com/llravell/go-pass/pkg/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MachineToken — долгоживущий токен сервисного аккаунта с доступом к одной
+// коллекции. Токен действует от имени создателя, поэтому теряет доступ вместе
+// с ним. WrappedKey — ключ коллекции, зашифрованный ключом из секрета токена.
+type MachineToken struct {
+	ID           int
+	UserID       int
+	Name         string
+	CollectionID int
+	Collection   string
+	Writable     bool
+	SecretHash   string
+	WrappedKey   string
+	ExpiresAt    time.Time
+	RevokedAt    time.Time
+	LastUsedAt   time.Time
+	LastUsedIP   string
+	CreatedAt    time.Time
+}
+
+func (t *MachineToken) Revoked() bool {
+	return !t.RevokedAt.IsZero()
+}
+
+func (t *MachineToken) Expired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
+
+// Permits проверяет, что запрос к коллекции укладывается в права токена.
+func (t *MachineToken) Permits(collectionID int, write bool) bool {
+	return collectionID == t.CollectionID && (!write || t.Writable)
+}
+
+func (t *MachineToken) ToPB() *pb.MachineToken {
+	token := &pb.MachineToken{
+		Id:           int32(t.ID), //nolint:gosec
+		Name:         t.Name,
+		CollectionId: int32(t.CollectionID), //nolint:gosec
+		Collection:   t.Collection,
+		Writable:     t.Writable,
+		ExpiresAt:    timestamppb.New(t.ExpiresAt),
+		LastUsedIp:   t.LastUsedIP,
+		CreatedAt:    timestamppb.New(t.CreatedAt),
+	}
+
+	if !t.LastUsedAt.IsZero() {
+		token.LastUsedAt = timestamppb.New(t.LastUsedAt)
+	}
+
+	if t.Revoked() {
+		token.RevokedAt = timestamppb.New(t.RevokedAt)
+	}
+
+	return token
+}
+
+func NewMachineTokenFromPB(token *pb.MachineToken) *MachineToken {
+	machineToken := &MachineToken{
+		ID:           int(token.GetId()),
+		Name:         token.GetName(),
+		CollectionID: int(token.GetCollectionId()),
+		Collection:   token.GetCollection(),
+		Writable:     token.GetWritable(),
+		WrappedKey:   token.GetWrappedKey(),
+		ExpiresAt:    token.GetExpiresAt().AsTime(),
+		LastUsedIP:   token.GetLastUsedIp(),
+		CreatedAt:    token.GetCreatedAt().AsTime(),
+	}
+
+	if token.GetLastUsedAt() != nil {
+		machineToken.LastUsedAt = token.GetLastUsedAt().AsTime()
+	}
+
+	if token.GetRevokedAt() != nil {
+		machineToken.RevokedAt = token.GetRevokedAt().AsTime()
+	}
+
+	return machineToken
+}
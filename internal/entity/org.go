@@ -0,0 +1,150 @@
+package entity
+
+import (
+	"strings"
+
+	pb "github.com/llravell/go-pass/pkg/grpc"
+)
+
+// OrgRole — роль участника организации, роли упорядочены по возрастанию прав.
+type OrgRole int
+
+const (
+	OrgRoleViewer OrgRole = iota + 1
+	OrgRoleEditor
+	OrgRoleAdmin
+	OrgRoleOwner
+)
+
+var orgRoleNames = map[OrgRole]string{
+	OrgRoleViewer: "viewer",
+	OrgRoleEditor: "editor",
+	OrgRoleAdmin:  "admin",
+	OrgRoleOwner:  "owner",
+}
+
+func ParseOrgRole(name string) (OrgRole, error) {
+	for role, roleName := range orgRoleNames {
+		if strings.EqualFold(name, roleName) {
+			return role, nil
+		}
+	}
+
+	return 0, ErrInvalidOrgRole
+}
+
+func (r OrgRole) String() string {
+	return orgRoleNames[r]
+}
+
+func (r OrgRole) Valid() bool {
+	_, ok := orgRoleNames[r]
+
+	return ok
+}
+
+// CanWrite — право изменять записи коллекций.
+func (r OrgRole) CanWrite() bool {
+	return r >= OrgRoleEditor
+}
+
+// CanManage — право управлять участниками и коллекциями.
+func (r OrgRole) CanManage() bool {
+	return r >= OrgRoleAdmin
+}
+
+// Organization — общее хранилище команды. Role — роль текущего пользователя.
+type Organization struct {
+	ID   int
+	Name string
+	Role OrgRole
+}
+
+type OrgMember struct {
+	UserID int
+	Login  string
+	Role   OrgRole
+}
+
+// Collection — набор записей организации со своим ключом шифрования.
+// Ключ хранится отдельно для каждого участника, зашифрованный его открытым
+// ключом X25519; Key — копия для текущего пользователя.
+type Collection struct {
+	ID      int
+	OrgID   int
+	OrgName string
+	Name    string
+	Role    OrgRole
+	Key     string
+}
+
+// Path — имя коллекции в командах клиента: org/collection.
+func (c *Collection) Path() string {
+	return c.OrgName + "/" + c.Name
+}
+
+func (o *Organization) ToPB() *pb.Organization {
+	return &pb.Organization{
+		Id:   int32(o.ID), //nolint:gosec
+		Name: o.Name,
+		Role: o.Role.String(),
+	}
+}
+
+func NewOrganizationFromPB(org *pb.Organization) *Organization {
+	role, _ := ParseOrgRole(org.GetRole())
+
+	return &Organization{
+		ID:   int(org.GetId()),
+		Name: org.GetName(),
+		Role: role,
+	}
+}
+
+func (m *OrgMember) ToPB() *pb.OrgMember {
+	return &pb.OrgMember{
+		Login: m.Login,
+		Role:  m.Role.String(),
+	}
+}
+
+func NewOrgMemberFromPB(member *pb.OrgMember) *OrgMember {
+	role, _ := ParseOrgRole(member.GetRole())
+
+	return &OrgMember{
+		Login: member.GetLogin(),
+		Role:  role,
+	}
+}
+
+func (c *Collection) ToPB() *pb.Collection {
+	return &pb.Collection{
+		Id:   int32(c.ID), //nolint:gosec
+		Org:  c.OrgName,
+		Name: c.Name,
+		Role: c.Role.String(),
+		Key:  c.Key,
+	}
+}
+
+func NewCollectionFromPB(collection *pb.Collection) *Collection {
+	role, _ := ParseOrgRole(collection.GetRole())
+
+	return &Collection{
+		ID:      int(collection.GetId()),
+		OrgName: collection.GetOrg(),
+		Name:    collection.GetName(),
+		Role:    role,
+		Key:     collection.GetKey(),
+	}
+}
+
+// ParseCollectionPath разбирает путь вида org/collection.
+func ParseCollectionPath(path string) (string, string, bool) {
+	org, name, ok := strings.Cut(path, "/")
+	if !ok || org == "" || name == "" {
+		return "", "", false
+	}
+
+	return org, name, true
+}
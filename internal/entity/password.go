@@ -1,16 +1,21 @@
 package entity
 
 import (
+	"strings"
+
 	"github.com/llravell/go-pass/pkg/encryption"
 	pb "github.com/llravell/go-pass/pkg/grpc"
 )
 
+// Password — запись хранилища. CollectionID равен нулю у личных записей,
+// записи коллекций зашифрованы ключом коллекции, а не ключом хранилища.
 type Password struct {
-	Name    string
-	Value   string
-	Meta    string
-	Version int
-	Deleted bool
+	Name         string
+	Value        string
+	Meta         string
+	Version      int
+	Deleted      bool
+	CollectionID int
 }
 
 func (pass *Password) BumpVersion() {
@@ -39,6 +44,20 @@ func (pass *Password) Close(key *encryption.Key) error {
 	return nil
 }
 
+// EditText — текст для редактора: пароль в первой строке, дальше метаданные.
+func (pass *Password) EditText() string {
+	return pass.Value + "\n" + pass.Meta
+}
+
+// ApplyEditText разбирает текст из EditText обратно. Метаданными считаются все
+// строки после первой: у логинов они многострочные (key=value на строку).
+func (pass *Password) ApplyEditText(text string) {
+	value, meta, _ := strings.Cut(text, "\n")
+
+	pass.Value = strings.TrimSpace(value)
+	pass.Meta = strings.TrimSpace(meta)
+}
+
 func (pass *Password) Equal(target *Password) bool {
 	return (pass.Name == target.Name &&
 		pass.Meta == target.Meta &&
@@ -47,18 +66,20 @@ func (pass *Password) Equal(target *Password) bool {
 
 func (pass *Password) ToPB() *pb.Password {
 	return &pb.Password{
-		Name:    pass.Name,
-		Value:   pass.Value,
-		Meta:    pass.Meta,
-		Version: int32(pass.Version), //nolint:gosec
+		Name:         pass.Name,
+		Value:        pass.Value,
+		Meta:         pass.Meta,
+		Version:      int32(pass.Version),      //nolint:gosec
+		CollectionId: int32(pass.CollectionID), //nolint:gosec
 	}
 }
 
 func NewPasswordFromPB(password *pb.Password) *Password {
 	return &Password{
-		Name:    password.GetName(),
-		Value:   password.GetValue(),
-		Meta:    password.GetMeta(),
-		Version: int(password.GetVersion()),
+		Name:         password.GetName(),
+		Value:        password.GetValue(),
+		Meta:         password.GetMeta(),
+		Version:      int(password.GetVersion()),
+		CollectionID: int(password.GetCollectionId()),
 	}
 }
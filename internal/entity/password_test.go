@@ -0,0 +1,35 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/llravell/go-pass/internal/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPasswordEditText(t *testing.T) {
+	t.Run("keeps multi-line login meta", func(t *testing.T) {
+		meta := entity.FormatMeta(map[string]string{
+			entity.EntryTypeKey: entity.EntryTypeLogin,
+			"url":               "https://github.com",
+			"username":          "octocat",
+		})
+		pass := &entity.Password{Name: "github", Value: "s3cret", Meta: meta}
+
+		edited := &entity.Password{Name: "github"}
+		edited.ApplyEditText(pass.EditText())
+
+		assert.Equal(t, "s3cret", edited.Value)
+		assert.Equal(t, meta, edited.Meta)
+		assert.True(t, entity.IsLoginPassword(edited))
+		assert.Equal(t, "octocat", entity.NewLoginFromPassword(edited).Username)
+	})
+
+	t.Run("password without meta", func(t *testing.T) {
+		pass := &entity.Password{}
+		pass.ApplyEditText("s3cret\n")
+
+		assert.Equal(t, "s3cret", pass.Value)
+		assert.Empty(t, pass.Meta)
+	})
+}
@@ -0,0 +1,41 @@
+package entity
+
+import (
+	"time"
+
+	pb "github.com/llravell/go-pass/pkg/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Send — одноразовая передача секрета по ссылке. Сервер хранит только шифротекст,
+// ключ остается во фрагменте ссылки и на сервер не попадает. Запись удаляется
+// после последнего просмотра или по истечении срока.
+type Send struct {
+	ID         string
+	UserID     int
+	Ciphertext string
+	Views      int
+	MaxViews   int
+	ExpiresAt  time.Time
+	CreatedAt  time.Time
+}
+
+func (s *Send) ToPB() *pb.SendInfo {
+	return &pb.SendInfo{
+		Id:        s.ID,
+		Views:     int32(s.Views),    //nolint:gosec
+		MaxViews:  int32(s.MaxViews), //nolint:gosec
+		ExpiresAt: timestamppb.New(s.ExpiresAt),
+		CreatedAt: timestamppb.New(s.CreatedAt),
+	}
+}
+
+func NewSendFromPB(send *pb.SendInfo) *Send {
+	return &Send{
+		ID:        send.GetId(),
+		Views:     int(send.GetViews()),
+		MaxViews:  int(send.GetMaxViews()),
+		ExpiresAt: send.GetExpiresAt().AsTime(),
+		CreatedAt: send.GetCreatedAt().AsTime(),
+	}
+}
@@ -4,4 +4,6 @@ type ClientSession struct {
 	Login          string
 	MasterPassHash string
 	AuthToken      string
+	RefreshToken   string
+	ServerPin      string
 }
@@ -0,0 +1,144 @@
+package entity
+
+import (
+	"strings"
+
+	"github.com/llravell/go-pass/pkg/encryption"
+	pb "github.com/llravell/go-pass/pkg/grpc"
+)
+
+type SharePermission int
+
+const (
+	SharePermissionRead SharePermission = iota
+	SharePermissionReadWrite
+)
+
+func (p SharePermission) String() string {
+	if p == SharePermissionReadWrite {
+		return "read-write"
+	}
+
+	return "read-only"
+}
+
+// sharedPrefix отличает имена чужих записей в командах клиента: @owner/name.
+const sharedPrefix = "@"
+
+// UserKeyPair — ключевая пара пользователя для обмена записями.
+// Закрытый ключ зашифрован ключом хранилища и серверу недоступен.
+type UserKeyPair struct {
+	PublicKey           string
+	EncryptedPrivateKey string
+}
+
+// Share — копия записи, которой владелец поделился с другим пользователем.
+// Значение зашифровано собственным ключом данных, а он, в свою очередь,
+// открытыми ключами владельца (OwnerKey) и получателя (RecipientKey).
+// SourceVersion — версия записи владельца, из которой получена копия,
+// RecipientModified — копию изменил получатель и владелец ее еще не забрал.
+type Share struct {
+	ID                int
+	Name              string
+	OwnerID           int
+	Owner             string
+	RecipientID       int
+	Recipient         string
+	Value             string
+	Meta              string
+	Version           int
+	SourceVersion     int
+	RecipientModified bool
+	Permission        SharePermission
+	OwnerKey          string
+	RecipientKey      string
+	// Key — ключ данных, зашифрованный для текущего пользователя.
+	Key string
+}
+
+// Address — имя, под которым получатель видит запись.
+func (s *Share) Address() string {
+	return sharedPrefix + s.Owner + "/" + s.Name
+}
+
+func (s *Share) Writable() bool {
+	return s.Permission == SharePermissionReadWrite
+}
+
+// KeyFor возвращает ключ данных, зашифрованный для пользователя.
+func (s *Share) KeyFor(userID int) string {
+	if userID == s.OwnerID {
+		return s.OwnerKey
+	}
+
+	return s.RecipientKey
+}
+
+func (s *Share) Open(key *encryption.Key) error {
+	decryptedValue, err := key.Decrypt(s.Value)
+	if err != nil {
+		return err
+	}
+
+	s.Value = decryptedValue
+
+	return nil
+}
+
+func (s *Share) Close(key *encryption.Key) error {
+	encryptedValue, err := key.Encrypt(s.Value)
+	if err != nil {
+		return err
+	}
+
+	s.Value = encryptedValue
+
+	return nil
+}
+
+func (s *Share) ToPB(userID int) *pb.Share {
+	return &pb.Share{
+		Id:                int32(s.ID), //nolint:gosec
+		Name:              s.Name,
+		Owner:             s.Owner,
+		Recipient:         s.Recipient,
+		Value:             s.Value,
+		Meta:              s.Meta,
+		Version:           int32(s.Version),       //nolint:gosec
+		SourceVersion:     int32(s.SourceVersion), //nolint:gosec
+		RecipientModified: s.RecipientModified,
+		Permission:        pb.SharePermission(s.Permission), //nolint:gosec
+		Key:               s.KeyFor(userID),
+	}
+}
+
+func NewShareFromPB(share *pb.Share) *Share {
+	return &Share{
+		ID:                int(share.GetId()),
+		Name:              share.GetName(),
+		Owner:             share.GetOwner(),
+		Recipient:         share.GetRecipient(),
+		Value:             share.GetValue(),
+		Meta:              share.GetMeta(),
+		Version:           int(share.GetVersion()),
+		SourceVersion:     int(share.GetSourceVersion()),
+		RecipientModified: share.GetRecipientModified(),
+		Permission:        SharePermission(share.GetPermission()),
+		Key:               share.GetKey(),
+	}
+}
+
+// ParseShareAddress разбирает имя вида @owner/name.
+func ParseShareAddress(address string) (string, string, bool) {
+	rest, ok := strings.CutPrefix(address, sharedPrefix)
+	if !ok {
+		return "", "", false
+	}
+
+	owner, name, ok := strings.Cut(rest, "/")
+	if !ok || owner == "" || name == "" {
+		return "", "", false
+	}
+
+	return owner, name, true
+}
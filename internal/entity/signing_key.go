@@ -0,0 +1,12 @@
+package entity
+
+import "time"
+
+// SigningKey — ключ подписи JWT в хранилище, закрытая часть в PEM.
+// Ключи общие для всех инстансов сервера, поэтому лежат в базе.
+type SigningKey struct {
+	ID         string
+	Algorithm  string
+	PrivateKey string
+	CreatedAt  time.Time
+}
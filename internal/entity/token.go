@@ -0,0 +1,25 @@
+package entity
+
+import "time"
+
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string
+}
+
+// RefreshToken хранится на сервере только в виде хеша.
+// Все токены, полученные ротацией из одного логина, образуют семейство:
+// повторное использование любого из них отзывает все семейство.
+type RefreshToken struct {
+	Hash      string
+	FamilyID  string
+	UserID    int
+	DeviceID  int
+	ExpiresAt time.Time
+	Used      bool
+	Revoked   bool
+}
+
+func (t *RefreshToken) Expired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
@@ -1,7 +1,33 @@
 package entity
 
+import pb "github.com/llravell/go-pass/pkg/grpc"
+
 type User struct {
-	ID       int
-	Login    string
-	Password string
+	ID           int
+	Login        string
+	Password     string
+	TOTPSecret   string
+	TOTPEnabled  bool
+	TOTPLastStep int64
+}
+
+type RecoveryCode struct {
+	ID   int
+	Hash string
+}
+
+// TwoFactorEnrollment - данные для подключения приложения-аутентификатора.
+// Коды восстановления показываются пользователю один раз.
+type TwoFactorEnrollment struct {
+	URI           string
+	Secret        string
+	RecoveryCodes []string
+}
+
+func NewTwoFactorEnrollmentFromPB(enrollment *pb.TwoFactorEnrollment) *TwoFactorEnrollment {
+	return &TwoFactorEnrollment{
+		URI:           enrollment.GetUri(),
+		Secret:        enrollment.GetSecret(),
+		RecoveryCodes: enrollment.GetRecoveryCodes(),
+	}
 }
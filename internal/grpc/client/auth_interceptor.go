@@ -2,23 +2,88 @@ package client
 
 import (
 	"context"
+	"sync"
 
 	"github.com/llravell/go-pass/internal/entity"
+	pb "github.com/llravell/go-pass/pkg/grpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 const (
 	headerAuthorize = "authorization"
 )
 
+var publicMethods = map[string]bool{
+	pb.Auth_Register_FullMethodName:           true,
+	pb.Auth_Login_FullMethodName:              true,
+	pb.Auth_Refresh_FullMethodName:            true,
+	pb.Auth_Logout_FullMethodName:             true,
+	pb.Auth_BeginEnrollment_FullMethodName:    true,
+	pb.Auth_VerifySecondFactor_FullMethodName: true,
+	pb.SendsAccess_Receive_FullMethodName:     true,
+}
+
 type SessionRepository interface {
 	GetSession(ctx context.Context) (*entity.ClientSession, error)
+	UpdateTokens(ctx context.Context, updateFn func(authToken, refreshToken string) (string, string, error)) error
+}
+
+type tokenRefresher struct {
+	mu          sync.Mutex
+	sessionRepo SessionRepository
+}
+
+// refresh обменивает refresh-токен на новую пару. Если токен уже обновил
+// параллельный вызов этого или другого процесса, повторного обмена не происходит:
+// старый refresh-токен уже использован, и его предъявление отозвало бы всю сессию.
+// Между процессами обмен сериализует блокировка в базе сессии.
+func (r *tokenRefresher) refresh(ctx context.Context, cc *grpc.ClientConn, staleToken string) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var token string
+
+	err := r.sessionRepo.UpdateTokens(ctx, func(authToken, refreshToken string) (string, string, error) {
+		if len(authToken) > 0 && authToken != staleToken {
+			token = authToken
+
+			return authToken, refreshToken, nil
+		}
+
+		if len(refreshToken) == 0 {
+			return "", "", entity.ErrSessionExpired
+		}
+
+		resp, err := pb.NewAuthClient(cc).Refresh(ctx, &pb.RefreshRequest{RefreshToken: refreshToken})
+		if err != nil {
+			if status.Code(err) == codes.Unauthenticated {
+				return "", "", entity.ErrSessionExpired
+			}
+
+			return "", "", err
+		}
+
+		token = resp.GetToken()
+
+		return resp.GetToken(), resp.GetRefreshToken(), nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return token, nil
 }
 
+// AuthInterceptor подставляет токен сессии и прозрачно обновляет его,
+// если сервер ответил Unauthenticated. Без сессии запрос не отправляется.
 func AuthInterceptor(
 	sessionRepo SessionRepository,
 ) grpc.UnaryClientInterceptor {
+	refresher := &tokenRefresher{sessionRepo: sessionRepo}
+
 	return func(
 		ctx context.Context,
 		method string,
@@ -27,18 +92,63 @@ func AuthInterceptor(
 		invoker grpc.UnaryInvoker,
 		opts ...grpc.CallOption,
 	) error {
+		if publicMethods[method] {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
 		session, err := sessionRepo.GetSession(ctx)
 		if err != nil {
-			return invoker(ctx, method, req, reply, cc, opts...)
+			return err
 		}
 
 		if len(session.AuthToken) == 0 {
-			return invoker(ctx, method, req, reply, cc, opts...)
+			return entity.ErrNoSession
+		}
+
+		err = invokeWithToken(ctx, session.AuthToken, method, req, reply, cc, invoker, opts...)
+		if status.Code(err) != codes.Unauthenticated {
+			return err
 		}
 
-		authorize := "bearer " + session.AuthToken
-		ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(headerAuthorize, authorize))
+		token, err := refresher.refresh(ctx, cc, session.AuthToken)
+		if err != nil {
+			return err
+		}
 
-		return invoker(ctx, method, req, reply, cc, opts...)
+		return invokeWithToken(ctx, token, method, req, reply, cc, invoker, opts...)
 	}
 }
+
+// MachineTokenInterceptor подставляет серверную часть токена сервисного
+// аккаунта. Такой токен не обновляется, сессия в этом режиме не нужна.
+func MachineTokenInterceptor(bearer string) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply any,
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		if publicMethods[method] {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		return invokeWithToken(ctx, bearer, method, req, reply, cc, invoker, opts...)
+	}
+}
+
+func invokeWithToken(
+	ctx context.Context,
+	token string,
+	method string,
+	req, reply any,
+	cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	authorize := "bearer " + token
+	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(headerAuthorize, authorize))
+
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
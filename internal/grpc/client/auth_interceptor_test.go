@@ -0,0 +1,174 @@
+package client_test
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/llravell/go-pass/internal/entity"
+	"github.com/llravell/go-pass/internal/grpc/client"
+	pb "github.com/llravell/go-pass/pkg/grpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const bufSize = 1024 * 1024
+
+type tokensSessionRepo struct {
+	mu      sync.Mutex
+	session entity.ClientSession
+}
+
+func (r *tokensSessionRepo) GetSession(context.Context) (*entity.ClientSession, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	session := r.session
+
+	return &session, nil
+}
+
+func (r *tokensSessionRepo) UpdateTokens(
+	_ context.Context,
+	updateFn func(authToken, refreshToken string) (string, string, error),
+) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	authToken, refreshToken, err := updateFn(r.session.AuthToken, r.session.RefreshToken)
+	if err != nil {
+		return err
+	}
+
+	r.session.AuthToken = authToken
+	r.session.RefreshToken = refreshToken
+
+	return nil
+}
+
+type tokensServer struct {
+	pb.UnimplementedAuthServer
+	pb.UnimplementedEchoServer
+
+	mu           sync.Mutex
+	accessToken  string
+	refreshToken string
+	refreshes    int
+}
+
+func (s *tokensServer) Refresh(_ context.Context, in *pb.RefreshRequest) (*pb.AuthResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if in.GetRefreshToken() != s.refreshToken {
+		return nil, status.Error(codes.Unauthenticated, "invalid refresh token")
+	}
+
+	s.refreshes++
+	s.accessToken = "access-" + s.refreshToken
+	s.refreshToken = "refresh-" + s.refreshToken
+
+	return &pb.AuthResponse{Token: s.accessToken, RefreshToken: s.refreshToken}, nil
+}
+
+func (s *tokensServer) Send(ctx context.Context, in *pb.Message) (*pb.Message, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get("authorization"); len(values) == 0 || values[0] != "bearer "+s.accessToken {
+		return nil, status.Error(codes.Unauthenticated, "invalid auth token")
+	}
+
+	return in, nil
+}
+
+func startTokensServer(t *testing.T, srv *tokensServer, sessionRepo client.SessionRepository) pb.EchoClient {
+	t.Helper()
+
+	lis := bufconn.Listen(bufSize)
+	server := grpc.NewServer()
+	pb.RegisterAuthServer(server, srv)
+	pb.RegisterEchoServer(server, srv)
+
+	go func() {
+		_ = server.Serve(lis)
+	}()
+
+	conn, err := grpc.NewClient(
+		"passthrough://bufnet",
+		grpc.WithContextDialer(func(_ context.Context, _ string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(client.AuthInterceptor(sessionRepo)),
+	)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		conn.Close()
+		server.Stop()
+	})
+
+	return pb.NewEchoClient(conn)
+}
+
+func TestAuthInterceptor(t *testing.T) {
+	t.Run("rejects call without session", func(t *testing.T) {
+		echo := startTokensServer(t, &tokensServer{}, &tokensSessionRepo{})
+
+		_, err := echo.Send(t.Context(), &pb.Message{})
+		require.ErrorIs(t, err, entity.ErrNoSession)
+	})
+
+	t.Run("refreshes expired token", func(t *testing.T) {
+		srv := &tokensServer{accessToken: "fresh", refreshToken: "r1"}
+		repo := &tokensSessionRepo{session: entity.ClientSession{AuthToken: "expired", RefreshToken: "r1"}}
+		echo := startTokensServer(t, srv, repo)
+
+		_, err := echo.Send(t.Context(), &pb.Message{Text: "hi"})
+		require.NoError(t, err)
+
+		session, _ := repo.GetSession(t.Context())
+		assert.Equal(t, "access-r1", session.AuthToken)
+		assert.Equal(t, "refresh-r1", session.RefreshToken)
+	})
+
+	t.Run("refreshes once for concurrent calls", func(t *testing.T) {
+		srv := &tokensServer{accessToken: "fresh", refreshToken: "r1"}
+		repo := &tokensSessionRepo{session: entity.ClientSession{AuthToken: "expired", RefreshToken: "r1"}}
+		echo := startTokensServer(t, srv, repo)
+
+		var wg sync.WaitGroup
+
+		for range 5 {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				_, err := echo.Send(t.Context(), &pb.Message{})
+				assert.NoError(t, err)
+			}()
+		}
+
+		wg.Wait()
+		assert.Equal(t, 1, srv.refreshes)
+	})
+
+	t.Run("asks to log in again when refresh fails", func(t *testing.T) {
+		srv := &tokensServer{accessToken: "fresh", refreshToken: "r2"}
+		repo := &tokensSessionRepo{session: entity.ClientSession{AuthToken: "expired", RefreshToken: "r1"}}
+		echo := startTokensServer(t, srv, repo)
+
+		_, err := echo.Send(t.Context(), &pb.Message{})
+		require.ErrorIs(t, err, entity.ErrSessionExpired)
+	})
+}
@@ -0,0 +1,148 @@
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/llravell/go-pass/internal/entity"
+)
+
+var (
+	ErrServerKeyMismatch = errors.New(
+		"SERVER KEY MISMATCH: server public key differs from the pinned one, " +
+			"it may be an attack; if the key was rotated legitimately run `gopass server trust --reset`",
+	)
+	ErrServerNotTrusted = errors.New("server certificate is not trusted and not pinned, run login to pin it")
+	ErrNoPeerCerts      = errors.New("server did not present a certificate")
+)
+
+type PinSessionRepository interface {
+	GetSession(ctx context.Context) (*entity.ClientSession, error)
+}
+
+// ServerPinner проверяет сертификат сервера по закрепленному отпечатку публичного ключа (TOFU).
+// Если отпечатка еще нет, подключение разрешается, только когда цепочка валидна
+// или явно разрешено первое использование (login/register).
+type ServerPinner struct {
+	sessionRepo PinSessionRepository
+
+	mu            sync.Mutex
+	allowFirstUse bool
+	observed      string
+}
+
+func NewServerPinner(sessionRepo PinSessionRepository) *ServerPinner {
+	return &ServerPinner{
+		sessionRepo: sessionRepo,
+	}
+}
+
+func Fingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+
+	return "SHA256:" + base64.RawStdEncoding.EncodeToString(sum[:])
+}
+
+func (p *ServerPinner) AllowFirstUse() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.allowFirstUse = true
+}
+
+// Observed возвращает отпечаток сервера из последнего рукопожатия.
+func (p *ServerPinner) Observed() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.observed
+}
+
+func (p *ServerPinner) verify(state tls.ConnectionState, roots *x509.CertPool, serverName string) error {
+	if len(state.PeerCertificates) == 0 {
+		return ErrNoPeerCerts
+	}
+
+	leaf := state.PeerCertificates[0]
+	fingerprint := Fingerprint(leaf)
+
+	session, err := p.sessionRepo.GetSession(context.Background())
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(session.ServerPin) > 0 {
+		if session.ServerPin != fingerprint {
+			return fmt.Errorf("%w (pinned %s, got %s)", ErrServerKeyMismatch, session.ServerPin, fingerprint)
+		}
+
+		p.observed = fingerprint
+
+		return nil
+	}
+
+	if chainErr := verifyChain(state, roots, serverName); chainErr != nil && !p.allowFirstUse {
+		return fmt.Errorf("%w: %w", ErrServerNotTrusted, chainErr)
+	}
+
+	p.observed = fingerprint
+
+	return nil
+}
+
+func verifyChain(state tls.ConnectionState, roots *x509.CertPool, serverName string) error {
+	intermediates := x509.NewCertPool()
+
+	for _, cert := range state.PeerCertificates[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	if len(serverName) == 0 {
+		serverName = state.ServerName
+	}
+
+	_, err := state.PeerCertificates[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		DNSName:       serverName,
+	})
+
+	return err
+}
+
+// FetchServerFingerprint подключается к серверу без проверки сертификата
+// и возвращает отпечаток его публичного ключа, чтобы пользователь мог его сверить.
+func FetchServerFingerprint(ctx context.Context, addr, serverName string) (string, error) {
+	dialer := tls.Dialer{
+		Config: &tls.Config{
+			MinVersion:         tls.VersionTLS12,
+			ServerName:         serverName,
+			InsecureSkipVerify: true, //nolint:gosec
+		},
+	}
+
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return "", err
+	}
+
+	defer conn.Close()
+
+	tlsConn, _ := conn.(*tls.Conn)
+
+	certs := tlsConn.ConnectionState().PeerCertificates
+	if len(certs) == 0 {
+		return "", ErrNoPeerCerts
+	}
+
+	return Fingerprint(certs[0]), nil
+}
@@ -0,0 +1,141 @@
+package client_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/llravell/go-pass/config"
+	"github.com/llravell/go-pass/internal/entity"
+	"github.com/llravell/go-pass/internal/grpc/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type sessionRepoStub struct {
+	session entity.ClientSession
+}
+
+func (s *sessionRepoStub) GetSession(context.Context) (*entity.ClientSession, error) {
+	session := s.session
+
+	return &session, nil
+}
+
+func selfSignedServer(t *testing.T) (string, *x509.Certificate) {
+	t.Helper()
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "server"},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		NextProtos: []string{"h2"},
+		Certificates: []tls.Certificate{{
+			Certificate: [][]byte{der},
+			PrivateKey:  privateKey,
+		}},
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			tlsConn, _ := conn.(*tls.Conn)
+			_ = tlsConn.Handshake()
+			conn.Close()
+		}
+	}()
+
+	return listener.Addr().String(), cert
+}
+
+func clientHandshake(t *testing.T, addr string, pinner *client.ServerPinner) error {
+	t.Helper()
+
+	creds, err := client.TransportCredentials(config.ClientTLSConfig{ServerName: "localhost"}, pinner)
+	require.NoError(t, err)
+
+	conn, err := net.Dial("tcp", addr)
+	require.NoError(t, err)
+
+	defer conn.Close()
+
+	secureConn, _, err := creds.ClientHandshake(context.Background(), "localhost", conn)
+	if err == nil {
+		secureConn.Close()
+	}
+
+	return err
+}
+
+func TestServerPinner(t *testing.T) {
+	addr, cert := selfSignedServer(t)
+
+	t.Run("refuses untrusted server without pin", func(t *testing.T) {
+		pinner := client.NewServerPinner(&sessionRepoStub{})
+
+		err := clientHandshake(t, addr, pinner)
+		require.ErrorIs(t, err, client.ErrServerNotTrusted)
+		assert.Empty(t, pinner.Observed())
+	})
+
+	t.Run("pins server on first use", func(t *testing.T) {
+		pinner := client.NewServerPinner(&sessionRepoStub{})
+		pinner.AllowFirstUse()
+
+		require.NoError(t, clientHandshake(t, addr, pinner))
+		assert.Equal(t, client.Fingerprint(cert), pinner.Observed())
+	})
+
+	t.Run("accepts pinned server", func(t *testing.T) {
+		repo := &sessionRepoStub{session: entity.ClientSession{ServerPin: client.Fingerprint(cert)}}
+
+		require.NoError(t, clientHandshake(t, addr, client.NewServerPinner(repo)))
+	})
+
+	t.Run("refuses server with changed key", func(t *testing.T) {
+		repo := &sessionRepoStub{session: entity.ClientSession{ServerPin: "SHA256:other"}}
+		pinner := client.NewServerPinner(repo)
+		pinner.AllowFirstUse()
+
+		err := clientHandshake(t, addr, pinner)
+		require.ErrorIs(t, err, client.ErrServerKeyMismatch)
+	})
+
+	t.Run("fetches server fingerprint", func(t *testing.T) {
+		fingerprint, err := client.FetchServerFingerprint(context.Background(), addr, "localhost")
+		require.NoError(t, err)
+		assert.Equal(t, client.Fingerprint(cert), fingerprint)
+	})
+}
@@ -0,0 +1,53 @@
+package client
+
+import (
+	"crypto/tls"
+
+	"github.com/llravell/go-pass/config"
+	"github.com/llravell/go-pass/pkg/certs"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// TransportCredentials строит TLS-креды клиента. Подключение без TLS
+// возможно только при явно разрешенном insecure. Стандартная проверка цепочки
+// заменена на проверку pinner'а, который сам проверяет цепочку до закрепления ключа.
+func TransportCredentials(
+	cfg config.ClientTLSConfig,
+	pinner *ServerPinner,
+) (credentials.TransportCredentials, error) {
+	if cfg.Insecure {
+		return insecure.NewCredentials(), nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: cfg.ServerName,
+	}
+
+	if len(cfg.CAFile) > 0 {
+		pool, err := certs.LoadCertPool(cfg.CAFile)
+		if err != nil {
+			return nil, err
+		}
+
+		tlsConfig.RootCAs = pool
+	}
+
+	if len(cfg.CertFile) > 0 || len(cfg.KeyFile) > 0 {
+		cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	roots := tlsConfig.RootCAs
+	tlsConfig.InsecureSkipVerify = true //nolint:gosec
+	tlsConfig.VerifyConnection = func(state tls.ConnectionState) error {
+		return pinner.verify(state, roots, cfg.ServerName)
+	}
+
+	return credentials.NewTLS(tlsConfig), nil
+}
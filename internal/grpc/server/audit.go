@@ -0,0 +1,54 @@
+package server
+
+import (
+	"context"
+
+	usecase "github.com/llravell/go-pass/internal/usecase/server"
+	pb "github.com/llravell/go-pass/pkg/grpc"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type AuditServer struct {
+	pb.UnimplementedAuditServer
+
+	auditUC *usecase.AuditUseCase
+	log     *zerolog.Logger
+}
+
+func NewAuditServer(
+	auditUC *usecase.AuditUseCase,
+	log *zerolog.Logger,
+) *AuditServer {
+	return &AuditServer{
+		auditUC: auditUC,
+		log:     log,
+	}
+}
+
+func (s *AuditServer) List(ctx context.Context, in *pb.AuditListRequest) (*pb.AuditListResponse, error) {
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		s.log.Error().Msg("getting userID from ctx failed")
+
+		return nil, status.Error(codes.Unauthenticated, "failed to resolve user id")
+	}
+
+	events, err := s.auditUC.GetList(ctx, userID, in.GetBeforeId(), int(in.GetLimit()))
+	if err != nil {
+		s.log.Error().Err(err).Msg("audit events fetching failed")
+
+		return nil, status.Error(codes.Unknown, "audit events fetching failed")
+	}
+
+	response := &pb.AuditListResponse{
+		Events: make([]*pb.AuditEvent, 0, len(events)),
+	}
+
+	for _, event := range events {
+		response.Events = append(response.Events, event.ToPB())
+	}
+
+	return response, nil
+}
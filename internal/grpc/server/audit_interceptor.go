@@ -0,0 +1,221 @@
+package server
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/llravell/go-pass/internal/entity"
+	pb "github.com/llravell/go-pass/pkg/grpc"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// auditedActions — методы, которые попадают в журнал аудита, и названия их действий.
+// Чтение списков без секретов и обновление токенов не записываются, чтобы
+// журнал состоял из значимых событий.
+var auditedActions = map[string]string{
+	pb.Auth_Register_FullMethodName:           "auth.register",
+	pb.Auth_Login_FullMethodName:              "auth.login",
+	pb.Auth_VerifySecondFactor_FullMethodName: "auth.second_factor",
+	pb.TwoFactor_Confirm_FullMethodName:       "twofactor.enable",
+	pb.TwoFactor_Disable_FullMethodName:       "twofactor.disable",
+	pb.Devices_Revoke_FullMethodName:          "device.revoke",
+
+	pb.Passwords_Sync_FullMethodName:              "entry.sync",
+	pb.Passwords_Delete_FullMethodName:            "entry.delete",
+	pb.Passwords_GetList_FullMethodName:           "vault.export",
+	pb.Passwords_GetCollectionList_FullMethodName: "collection.export",
+	pb.Passwords_GetEmergencyList_FullMethodName:  "emergency.export",
+
+	pb.Shares_Create_FullMethodName: "share.create",
+	pb.Shares_Update_FullMethodName: "share.update",
+	pb.Shares_Revoke_FullMethodName: "share.revoke",
+
+	pb.Orgs_Create_FullMethodName:           "org.create",
+	pb.Orgs_SetMember_FullMethodName:        "org.member.set",
+	pb.Orgs_RemoveMember_FullMethodName:     "org.member.remove",
+	pb.Orgs_CreateCollection_FullMethodName: "collection.create",
+	pb.Orgs_GrantCollection_FullMethodName:  "collection.grant",
+	pb.Orgs_RevokeCollection_FullMethodName: "collection.revoke",
+
+	pb.Emergency_Grant_FullMethodName:   "emergency.grant",
+	pb.Emergency_Revoke_FullMethodName:  "emergency.revoke",
+	pb.Emergency_Request_FullMethodName: "emergency.request",
+	pb.Emergency_Approve_FullMethodName: "emergency.approve",
+	pb.Emergency_Reject_FullMethodName:  "emergency.reject",
+
+	pb.Sends_Create_FullMethodName: "send.create",
+	pb.Sends_Delete_FullMethodName: "send.delete",
+
+	pb.Tokens_Create_FullMethodName: "token.create",
+	pb.Tokens_Revoke_FullMethodName: "token.revoke",
+}
+
+// authRejectedAction — действие для вызовов вне auditedActions, которые
+// отклонили лимит попыток или проверка токена.
+const authRejectedAction = "auth.rejected"
+
+// в этих методах логин из запроса — тот, кто действует, а не объект действия
+var actorLoginMethods = map[string]bool{
+	pb.Auth_Register_FullMethodName: true,
+	pb.Auth_Login_FullMethodName:    true,
+}
+
+var auditSubjectContextKey contextKey = "auditSubject"
+
+// auditSubject заполняют интерсепторы и обработчики, стоящие после
+// AuditInterceptor: контекст, который они создают, до аудита не доходит.
+type auditSubject struct {
+	userID   int
+	deviceID int
+	tokenID  int
+	rejected bool
+}
+
+// auditIdentify сообщает аудиту, от чьего имени выполняется вызов, даже если
+// затем вызов будет отклонен, например с отозванного устройства.
+func auditIdentify(ctx context.Context, userID, deviceID, tokenID int) {
+	if subject, ok := ctx.Value(auditSubjectContextKey).(*auditSubject); ok {
+		subject.userID, subject.deviceID, subject.tokenID = userID, deviceID, tokenID
+	}
+}
+
+// auditReject отмечает, что вызов отклонен до обработчика лимитом попыток
+// или проверкой токена.
+func auditReject(ctx context.Context) {
+	if subject, ok := ctx.Value(auditSubjectContextKey).(*auditSubject); ok {
+		subject.rejected = true
+	}
+}
+
+type AuditRecorder interface {
+	Record(ctx context.Context, event *entity.AuditEvent) error
+}
+
+type (
+	orgRequest interface {
+		GetOrg() string
+	}
+
+	collectionRequest interface {
+		GetCollectionId() int32
+	}
+
+	nameRequest interface {
+		GetName() string
+	}
+
+	recipientRequest interface {
+		GetRecipient() string
+	}
+
+	idRequest interface {
+		GetId() int32
+	}
+
+	stringIDRequest interface {
+		GetId() string
+	}
+)
+
+// auditEntry описывает объект действия по полям запроса. Секреты и ключи
+// из запроса в журнал не попадают.
+func auditEntry(method string, req any) string {
+	parts := make([]string, 0, 3)
+
+	if r, ok := req.(orgRequest); ok && len(r.GetOrg()) > 0 {
+		parts = append(parts, "org:"+r.GetOrg())
+	}
+
+	if r, ok := req.(collectionRequest); ok && r.GetCollectionId() > 0 {
+		parts = append(parts, "collection:"+strconv.Itoa(int(r.GetCollectionId())))
+	}
+
+	if r, ok := req.(nameRequest); ok && len(r.GetName()) > 0 {
+		parts = append(parts, r.GetName())
+	}
+
+	if r, ok := req.(loginRequest); ok && len(r.GetLogin()) > 0 && !actorLoginMethods[method] {
+		parts = append(parts, "user:"+r.GetLogin())
+	}
+
+	if r, ok := req.(recipientRequest); ok && len(r.GetRecipient()) > 0 {
+		parts = append(parts, "user:"+r.GetRecipient())
+	}
+
+	switch r := req.(type) {
+	case idRequest:
+		if r.GetId() > 0 {
+			parts = append(parts, "#"+strconv.Itoa(int(r.GetId())))
+		}
+	case stringIDRequest:
+		if len(r.GetId()) > 0 {
+			parts = append(parts, "#"+r.GetId())
+		}
+	}
+
+	return strings.Join(parts, " ")
+}
+
+func newAuditEvent(ctx context.Context, method string, req any, err error) *entity.AuditEvent {
+	event := &entity.AuditEvent{
+		Action:  auditedActions[method],
+		Entry:   auditEntry(method, req),
+		Outcome: status.Code(err).String(),
+		IP:      peerIP(ctx),
+	}
+
+	if len(event.Action) == 0 {
+		event.Action = authRejectedAction
+		event.Entry = method
+	}
+
+	event.UserID, _ = GetUserIDFromContext(ctx)
+	event.DeviceID, _ = GetDeviceIDFromContext(ctx)
+
+	if token, ok := GetMachineTokenFromContext(ctx); ok {
+		event.TokenID = token.ID
+	}
+
+	if subject, ok := ctx.Value(auditSubjectContextKey).(*auditSubject); ok && subject.userID > 0 {
+		event.UserID, event.DeviceID, event.TokenID = subject.userID, subject.deviceID, subject.tokenID
+	}
+
+	if r, ok := req.(loginRequest); ok && actorLoginMethods[method] {
+		event.Login = r.GetLogin()
+	}
+
+	return event
+}
+
+// AuditInterceptor записывает в журнал аудита вызовы методов из auditedActions
+// вместе с результатом, а также любые вызовы, отклоненные лимитом попыток или
+// проверкой токена. Стоит перед AuthInterceptor и RateLimitInterceptor, те
+// сообщают ему пользователя через auditIdentify.
+// Ошибка записи не отменяет уже выполненный вызов и только логируется.
+func AuditInterceptor(recorder AuditRecorder, log *zerolog.Logger) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		subject := &auditSubject{}
+		ctx = context.WithValue(ctx, auditSubjectContextKey, subject)
+
+		resp, err := handler(ctx, req)
+
+		if _, ok := auditedActions[info.FullMethod]; !ok && !subject.rejected {
+			return resp, err
+		}
+
+		event := newAuditEvent(ctx, info.FullMethod, req, err)
+		if recordErr := recorder.Record(context.WithoutCancel(ctx), event); recordErr != nil {
+			log.Error().Err(recordErr).Str("action", event.Action).Msg("audit event recording failed")
+		}
+
+		return resp, err
+	}
+}
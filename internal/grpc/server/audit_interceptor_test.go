@@ -0,0 +1,254 @@
+package server_test
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/llravell/go-pass/internal/entity"
+	"github.com/llravell/go-pass/internal/grpc/server"
+	usecase "github.com/llravell/go-pass/internal/usecase/server"
+	"github.com/llravell/go-pass/pkg/auth"
+	pb "github.com/llravell/go-pass/pkg/grpc"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+type auditRecorderStub struct {
+	mu     sync.Mutex
+	events []*entity.AuditEvent
+}
+
+func (r *auditRecorderStub) Record(_ context.Context, event *entity.AuditEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.events = append(r.events, event)
+
+	return nil
+}
+
+func (r *auditRecorderStub) last() *entity.AuditEvent {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.events) == 0 {
+		return nil
+	}
+
+	return r.events[len(r.events)-1]
+}
+
+func TestAuditInterceptor(t *testing.T) {
+	log := zerolog.Nop()
+	recorder := &auditRecorderStub{}
+	interceptor := server.AuditInterceptor(recorder, &log)
+
+	ctx := peer.NewContext(t.Context(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 5000},
+	})
+	userCtx := context.WithValue(ctx, server.UserIDContextKey, 7)
+	userCtx = context.WithValue(userCtx, server.DeviceIDContextKey, 3)
+
+	okHandler := func(context.Context, any) (any, error) {
+		return &emptypb.Empty{}, nil
+	}
+
+	call := func(ctx context.Context, method string, req any, handler grpc.UnaryHandler) error {
+		_, err := interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+
+		return err
+	}
+
+	t.Run("records entry deletion with actor and device", func(t *testing.T) {
+		err := call(userCtx, pb.Passwords_Delete_FullMethodName, &pb.PasswordDeleteRequest{
+			Name:         "db",
+			CollectionId: 5,
+		}, okHandler)
+		require.NoError(t, err)
+
+		event := recorder.last()
+		require.NotNil(t, event)
+
+		assert.Equal(t, "entry.delete", event.Action)
+		assert.Equal(t, "collection:5 db", event.Entry)
+		assert.Equal(t, codes.OK.String(), event.Outcome)
+		assert.Equal(t, 7, event.UserID)
+		assert.Equal(t, 3, event.DeviceID)
+		assert.Equal(t, "10.0.0.1", event.IP)
+	})
+
+	t.Run("records failed login by login from request", func(t *testing.T) {
+		err := call(ctx, pb.Auth_Login_FullMethodName, &pb.AuthRequest{Login: "alice"},
+			func(context.Context, any) (any, error) {
+				return nil, status.Error(codes.Unauthenticated, "invalid login or password")
+			})
+		require.Error(t, err)
+
+		event := recorder.last()
+		require.NotNil(t, event)
+
+		assert.Equal(t, "auth.login", event.Action)
+		assert.Equal(t, "alice", event.Login)
+		assert.Empty(t, event.Entry)
+		assert.Equal(t, codes.Unauthenticated.String(), event.Outcome)
+	})
+
+	t.Run("skips methods outside audit list", func(t *testing.T) {
+		count := len(recorder.events)
+
+		require.NoError(t, call(userCtx, pb.Devices_List_FullMethodName, &emptypb.Empty{}, okHandler))
+
+		assert.Len(t, recorder.events, count)
+	})
+}
+
+type lockedOutLimiter struct{}
+
+func (lockedOutLimiter) RetryAfter(context.Context, []string) (time.Duration, error) {
+	return time.Hour, nil
+}
+
+func (lockedOutLimiter) RecordFailure(context.Context, []string) error { return nil }
+
+func (lockedOutLimiter) Reset(context.Context, string) error { return nil }
+
+type twoFactorRepoStub struct {
+	usecase.TwoFactorRepository
+
+	user *entity.User
+}
+
+func (r *twoFactorRepoStub) FindUserByID(context.Context, int) (*entity.User, error) {
+	return r.user, nil
+}
+
+func (r *twoFactorRepoStub) GetRecoveryCodes(context.Context, int) ([]*entity.RecoveryCode, error) {
+	return nil, nil
+}
+
+func TestAuditInterceptorAuthFailures(t *testing.T) {
+	log := zerolog.Nop()
+	jwtManager := auth.NewJWTManager("secret")
+	machineToken := &auth.MachineToken{ID: 4, AuthSecret: "secret"}
+
+	withToken := func(token string) context.Context {
+		return metadata.NewOutgoingContext(t.Context(), metadata.Pairs("authorization", "bearer "+token))
+	}
+
+	recorder := &auditRecorderStub{}
+	client, closeFn := startGRPCEchoServer(t,
+		server.AuditInterceptor(recorder, &log),
+		server.AuthInterceptor(jwtManager, revokedDevices{2: true}, machineTokens{
+			machineToken.Bearer(): {ID: 4, UserID: 9, CollectionID: 1},
+		}),
+	)
+	defer closeFn()
+
+	t.Run("records invalid token", func(t *testing.T) {
+		_, err := client.Send(withToken("garbage"), &pb.Message{})
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+		event := recorder.last()
+		require.NotNil(t, event)
+
+		assert.Equal(t, "auth.rejected", event.Action)
+		assert.Equal(t, pb.Echo_Send_FullMethodName, event.Entry)
+		assert.Equal(t, codes.Unauthenticated.String(), event.Outcome)
+		assert.Zero(t, event.UserID)
+	})
+
+	t.Run("records revoked device with its user", func(t *testing.T) {
+		token, err := jwtManager.IssueForDevice(1, 2, time.Hour)
+		require.NoError(t, err)
+
+		_, err = client.Send(withToken(token), &pb.Message{})
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+		event := recorder.last()
+		require.NotNil(t, event)
+
+		assert.Equal(t, "auth.rejected", event.Action)
+		assert.Equal(t, 1, event.UserID)
+		assert.Equal(t, 2, event.DeviceID)
+	})
+
+	t.Run("records machine token outside allowed methods", func(t *testing.T) {
+		_, err := client.Send(withToken(machineToken.Bearer()), &pb.Message{})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		event := recorder.last()
+		require.NotNil(t, event)
+
+		assert.Equal(t, codes.PermissionDenied.String(), event.Outcome)
+		assert.Equal(t, 9, event.UserID)
+		assert.Equal(t, 4, event.TokenID)
+	})
+
+	t.Run("skips allowed calls outside audit list", func(t *testing.T) {
+		count := len(recorder.events)
+
+		token, err := jwtManager.IssueForDevice(1, 3, time.Hour)
+		require.NoError(t, err)
+
+		_, err = client.Send(withToken(token), &pb.Message{})
+		require.NoError(t, err)
+
+		assert.Len(t, recorder.events, count)
+	})
+
+	t.Run("records rate limited login", func(t *testing.T) {
+		interceptor := server.AuditInterceptor(recorder, &log)
+		rateLimit := server.RateLimitInterceptor(lockedOutLimiter{}, jwtManager)
+		info := &grpc.UnaryServerInfo{FullMethod: pb.Auth_Login_FullMethodName}
+
+		_, err := interceptor(t.Context(), &pb.AuthRequest{Login: "alice"}, info,
+			func(ctx context.Context, req any) (any, error) {
+				return rateLimit(ctx, req, info, func(context.Context, any) (any, error) {
+					return &pb.AuthResponse{}, nil
+				})
+			})
+		require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+		event := recorder.last()
+		require.NotNil(t, event)
+
+		assert.Equal(t, "auth.login", event.Action)
+		assert.Equal(t, "alice", event.Login)
+		assert.Equal(t, codes.ResourceExhausted.String(), event.Outcome)
+	})
+
+	t.Run("attributes failed second factor to challenge subject", func(t *testing.T) {
+		user := &entity.User{ID: 5, Login: "bob", TOTPEnabled: true, TOTPSecret: "JBSWY3DPEHPK3PXP"}
+		twoFactorUC := usecase.NewTwoFactorUseCase(&twoFactorRepoStub{user: user}, jwtManager, false)
+		authServer := server.NewAuthServer(nil, twoFactorUC, &log)
+
+		challenge, err := twoFactorUC.IssueChallenge(user)
+		require.NoError(t, err)
+
+		interceptor := server.AuditInterceptor(recorder, &log)
+		info := &grpc.UnaryServerInfo{FullMethod: pb.Auth_VerifySecondFactor_FullMethodName}
+
+		_, err = interceptor(t.Context(), &pb.SecondFactorRequest{ChallengeToken: challenge, Code: "000000"}, info,
+			func(ctx context.Context, req any) (any, error) {
+				return authServer.VerifySecondFactor(ctx, req.(*pb.SecondFactorRequest))
+			})
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+		event := recorder.last()
+		require.NotNil(t, event)
+
+		assert.Equal(t, "auth.second_factor", event.Action)
+		assert.Equal(t, 5, event.UserID)
+		assert.Equal(t, codes.Unauthenticated.String(), event.Outcome)
+	})
+}
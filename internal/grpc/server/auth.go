@@ -3,33 +3,74 @@ package server
 import (
 	"context"
 	"errors"
-	"time"
+	"net"
+	"strings"
 
 	"github.com/llravell/go-pass/internal/entity"
 	usecase "github.com/llravell/go-pass/internal/usecase/server"
 	pb "github.com/llravell/go-pass/pkg/grpc"
 	"github.com/rs/zerolog"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 type AuthServer struct {
 	pb.UnimplementedAuthServer
 
-	authUC *usecase.AuthUseCase
-	log    *zerolog.Logger
+	authUC      *usecase.AuthUseCase
+	twoFactorUC *usecase.TwoFactorUseCase
+	log         *zerolog.Logger
 }
 
 func NewAuthServer(
 	authUC *usecase.AuthUseCase,
+	twoFactorUC *usecase.TwoFactorUseCase,
 	log *zerolog.Logger,
 ) *AuthServer {
 	return &AuthServer{
-		authUC: authUC,
-		log:    log,
+		authUC:      authUC,
+		twoFactorUC: twoFactorUC,
+		log:         log,
 	}
 }
 
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	ip := p.Addr.String()
+	if host, _, err := net.SplitHostPort(ip); err == nil {
+		return host
+	}
+
+	return ip
+}
+
+// seenDevice собирает сведения о клиенте из метаданных вызова.
+func seenDevice(ctx context.Context, name string) *entity.Device {
+	device := &entity.Device{Name: name, IP: peerIP(ctx)}
+
+	// grpc-go дописывает свою версию в user-agent, версия клиента идет первой
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if userAgent := md.Get("user-agent"); len(userAgent) > 0 {
+			if fields := strings.Fields(userAgent[0]); len(fields) > 0 {
+				device.ClientVersion = fields[0]
+			}
+		}
+	}
+
+	if len(device.Name) == 0 {
+		device.Name = "unknown"
+	}
+
+	return device
+}
+
 func (s *AuthServer) Register(ctx context.Context, in *pb.AuthRequest) (*pb.AuthResponse, error) {
 	user, err := s.authUC.RegisterUser(ctx, in.GetLogin(), in.GetPassword())
 
@@ -37,38 +78,173 @@ func (s *AuthServer) Register(ctx context.Context, in *pb.AuthRequest) (*pb.Auth
 		return nil, status.Error(codes.AlreadyExists, "user already exists")
 	}
 
-	if err != nil {
-		s.log.Error().Err(err).Msg("user saving failed")
-
-		return nil, status.Error(codes.Unknown, "user saving failed")
+	if err != nil && errors.Is(err, entity.ErrRegistrationClosed) {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
 	}
 
-	token, err := s.authUC.BuildUserToken(user, 24*time.Hour)
 	if err != nil {
-		s.log.Error().Err(err).Msg("token issuing failed")
+		s.log.Error().Err(err).Msg("user saving failed")
 
-		return nil, status.Error(codes.Unknown, "token issuing failed")
+		return nil, status.Error(codes.Unknown, "user saving failed")
 	}
 
-	return &pb.AuthResponse{Token: token}, nil
+	return s.completeLogin(ctx, user, in.GetDeviceName())
 }
 
 func (s *AuthServer) Login(ctx context.Context, in *pb.AuthRequest) (*pb.AuthResponse, error) {
 	user, err := s.authUC.VerifyUser(ctx, in.GetLogin(), in.GetPassword())
+	if errors.Is(err, entity.ErrInvalidCredentials) {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
 	if err != nil {
 		s.log.Error().Err(err).Msg("login failed")
 
 		return nil, status.Error(codes.Unknown, "login failed")
 	}
 
-	token, err := s.authUC.BuildUserToken(user, 24*time.Hour)
+	return s.completeLogin(ctx, user, in.GetDeviceName())
+}
+
+func (s *AuthServer) BeginEnrollment(
+	ctx context.Context,
+	in *pb.ChallengeRequest,
+) (*pb.TwoFactorEnrollment, error) {
+	user, err := s.twoFactorUC.UserFromChallenge(ctx, in.GetChallengeToken())
+	if err != nil {
+		return nil, s.twoFactorError(err, "two-factor enrollment failed")
+	}
+
+	enrollment, err := s.twoFactorUC.BeginEnrollment(ctx, user)
+	if err != nil {
+		return nil, s.twoFactorError(err, "two-factor enrollment failed")
+	}
+
+	return enrollmentToPB(enrollment), nil
+}
+
+func (s *AuthServer) VerifySecondFactor(
+	ctx context.Context,
+	in *pb.SecondFactorRequest,
+) (*pb.AuthResponse, error) {
+	user, err := s.twoFactorUC.UserFromChallenge(ctx, in.GetChallengeToken())
+	if err != nil {
+		return nil, s.twoFactorError(err, "second factor verification failed")
+	}
+
+	// вызов идет без токена доступа, пользователя для аудита дает challenge
+	auditIdentify(ctx, user.ID, 0, 0)
+
+	err = s.twoFactorUC.Verify(ctx, user, in.GetCode())
+	if err != nil {
+		return nil, s.twoFactorError(err, "second factor verification failed")
+	}
+
+	return s.issueTokens(ctx, user, seenDevice(ctx, in.GetDeviceName()))
+}
+
+func (s *AuthServer) Refresh(ctx context.Context, in *pb.RefreshRequest) (*pb.AuthResponse, error) {
+	pair, err := s.authUC.RefreshTokens(ctx, in.GetRefreshToken(), seenDevice(ctx, ""))
+	if err != nil {
+		if errors.Is(err, entity.ErrRefreshTokenReused) {
+			s.log.Warn().Err(err).Msg("refresh token reuse detected, token family revoked")
+		}
+
+		if errors.Is(err, entity.ErrRefreshTokenNotFound) ||
+			errors.Is(err, entity.ErrRefreshTokenExpired) ||
+			errors.Is(err, entity.ErrRefreshTokenReused) ||
+			errors.Is(err, entity.ErrRefreshTokenRevoked) {
+			return nil, status.Error(codes.Unauthenticated, "invalid refresh token")
+		}
+
+		s.log.Error().Err(err).Msg("token refreshing failed")
+
+		return nil, status.Error(codes.Unknown, "token refreshing failed")
+	}
+
+	return &pb.AuthResponse{Token: pair.AccessToken, RefreshToken: pair.RefreshToken}, nil
+}
+
+func (s *AuthServer) Logout(ctx context.Context, in *pb.LogoutRequest) (*emptypb.Empty, error) {
+	err := s.authUC.Logout(ctx, in.GetRefreshToken())
+	if err != nil {
+		if errors.Is(err, entity.ErrRefreshTokenNotFound) {
+			return nil, status.Error(codes.Unauthenticated, "invalid refresh token")
+		}
+
+		s.log.Error().Err(err).Msg("logout failed")
+
+		return nil, status.Error(codes.Unknown, "logout failed")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// completeLogin выдает токены, если второй фактор не нужен,
+// иначе - токен вызова, с которым клиент предъявит код.
+func (s *AuthServer) completeLogin(
+	ctx context.Context,
+	user *entity.User,
+	deviceName string,
+) (*pb.AuthResponse, error) {
+	needed, enrollment := s.twoFactorUC.SecondFactorState(user)
+	if !needed {
+		return s.issueTokens(ctx, user, seenDevice(ctx, deviceName))
+	}
+
+	challenge, err := s.twoFactorUC.IssueChallenge(user)
+	if err != nil {
+		s.log.Error().Err(err).Msg("challenge issuing failed")
+
+		return nil, status.Error(codes.Unknown, "challenge issuing failed")
+	}
+
+	secondFactor := pb.SecondFactor_REQUIRED
+	if enrollment {
+		secondFactor = pb.SecondFactor_ENROLLMENT_REQUIRED
+	}
+
+	return &pb.AuthResponse{ChallengeToken: challenge, SecondFactor: secondFactor}, nil
+}
+
+func (s *AuthServer) twoFactorError(err error, msg string) error {
+	switch {
+	case errors.Is(err, entity.ErrInvalidChallenge):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, entity.ErrInvalidSecondFactorCode):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, entity.ErrTwoFactorAlreadyEnabled),
+		errors.Is(err, entity.ErrTwoFactorNotEnrolled),
+		errors.Is(err, entity.ErrTwoFactorRequired):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	s.log.Error().Err(err).Msg(msg)
+
+	return status.Error(codes.Unknown, msg)
+}
+
+func enrollmentToPB(enrollment *entity.TwoFactorEnrollment) *pb.TwoFactorEnrollment {
+	return &pb.TwoFactorEnrollment{
+		Uri:           enrollment.URI,
+		Secret:        enrollment.Secret,
+		RecoveryCodes: enrollment.RecoveryCodes,
+	}
+}
+
+func (s *AuthServer) issueTokens(
+	ctx context.Context,
+	user *entity.User,
+	device *entity.Device,
+) (*pb.AuthResponse, error) {
+	pair, err := s.authUC.IssueTokens(ctx, user, device)
 	if err != nil {
 		s.log.Error().Err(err).Msg("token issuing failed")
 
 		return nil, status.Error(codes.Unknown, "token issuing failed")
 	}
 
-	return &pb.AuthResponse{Token: token}, nil
+	return &pb.AuthResponse{Token: pair.AccessToken, RefreshToken: pair.RefreshToken}, nil
 }
 
 // AuthFuncOverride отключает проверку авторизации в интерсепторе AuthServerInterceptor.
@@ -2,11 +2,15 @@ package server
 
 import (
 	"context"
+	"errors"
 	"strconv"
 
 	"github.com/golang-jwt/jwt/v4"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/auth"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
+	"github.com/llravell/go-pass/internal/entity"
+	tokens "github.com/llravell/go-pass/pkg/auth"
+	pb "github.com/llravell/go-pass/pkg/grpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -14,12 +18,33 @@ import (
 
 type contextKey string
 
-var UserIDContextKey contextKey = "userID"
+var (
+	UserIDContextKey       contextKey = "userID"
+	DeviceIDContextKey     contextKey = "deviceID"
+	MachineTokenContextKey contextKey = "machineToken"
+)
+
+// machineTokenMethods — методы, доступные по машинным токенам. Все остальное,
+// включая управление аккаунтом и самими токенами, требует входа пользователя.
+var machineTokenMethods = map[string]bool{
+	pb.Passwords_GetCollectionList_FullMethodName: true,
+	pb.Passwords_Sync_FullMethodName:              true,
+	pb.Passwords_Delete_FullMethodName:            true,
+	pb.Tokens_Self_FullMethodName:                 true,
+}
 
 type JWTParser interface {
 	Parse(tokenString string) (*jwt.Token, error)
 }
 
+type DeviceRevocationChecker interface {
+	IsDeviceRevoked(ctx context.Context, deviceID int) (bool, error)
+}
+
+type MachineTokenVerifier interface {
+	Verify(ctx context.Context, bearer string, ip string) (*entity.MachineToken, error)
+}
+
 func GetUserIDFromContext(ctx context.Context) (int, bool) {
 	value := ctx.Value(UserIDContextKey)
 	id, ok := value.(int)
@@ -27,6 +52,34 @@ func GetUserIDFromContext(ctx context.Context) (int, bool) {
 	return id, ok
 }
 
+func GetDeviceIDFromContext(ctx context.Context) (int, bool) {
+	value := ctx.Value(DeviceIDContextKey)
+	id, ok := value.(int)
+
+	return id, ok
+}
+
+// GetMachineTokenFromContext возвращает машинный токен, если запрос выполнен по нему.
+func GetMachineTokenFromContext(ctx context.Context) (*entity.MachineToken, bool) {
+	token, ok := ctx.Value(MachineTokenContextKey).(*entity.MachineToken)
+
+	return token, ok
+}
+
+func getDeviceIDFromToken(token *jwt.Token) int {
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return 0
+	}
+
+	id, ok := claims["device_id"].(float64)
+	if !ok {
+		return 0
+	}
+
+	return int(id)
+}
+
 func getUserIDFromToken(token *jwt.Token) int {
 	claims, ok := token.Claims.(jwt.MapClaims)
 	if !ok {
@@ -46,23 +99,82 @@ func getUserIDFromToken(token *jwt.Token) int {
 	return id
 }
 
+func authenticateMachineToken(
+	ctx context.Context,
+	verifier MachineTokenVerifier,
+	tokenString string,
+) (context.Context, error) {
+	token, err := verifier.Verify(ctx, tokenString, peerIP(ctx))
+	if err != nil {
+		if errors.Is(err, entity.ErrMachineTokenNotFound) {
+			return nil, status.Error(codes.Unauthenticated, "invalid machine token")
+		}
+
+		return nil, status.Error(codes.Unavailable, "machine token check failed")
+	}
+
+	auditIdentify(ctx, token.UserID, 0, token.ID)
+
+	method, _ := grpc.Method(ctx)
+	if !machineTokenMethods[method] {
+		return nil, status.Error(codes.PermissionDenied, "method is not available for machine tokens")
+	}
+
+	ctx = logging.InjectFields(ctx, logging.Fields{"auth.sub", token.UserID, "auth.token", token.ID})
+	ctx = context.WithValue(ctx, MachineTokenContextKey, token)
+
+	return context.WithValue(ctx, UserIDContextKey, token.UserID), nil
+}
+
+// AuthInterceptor проверяет токен и то, что устройство, которому он выдан, не отозвано.
+// Машинные токены проверяются отдельно и пускают только к методам из machineTokenMethods.
 func AuthInterceptor(
 	jwtParser JWTParser,
+	revocationChecker DeviceRevocationChecker,
+	machineTokenVerifier MachineTokenVerifier,
 ) grpc.UnaryServerInterceptor {
-	return auth.UnaryServerInterceptor(func(ctx context.Context) (context.Context, error) {
+	authenticate := func(ctx context.Context) (context.Context, error) {
 		tokenString, err := auth.AuthFromMD(ctx, "bearer")
 		if err != nil {
 			return nil, err
 		}
 
+		if tokens.IsMachineToken(tokenString) {
+			return authenticateMachineToken(ctx, machineTokenVerifier, tokenString)
+		}
+
 		token, err := jwtParser.Parse(tokenString)
 		if err != nil || !token.Valid {
 			return nil, status.Error(codes.Unauthenticated, "invalid auth token")
 		}
 
 		userID := getUserIDFromToken(token)
-		ctx = logging.InjectFields(ctx, logging.Fields{"auth.sub", userID})
+		deviceID := getDeviceIDFromToken(token)
+		auditIdentify(ctx, userID, deviceID, 0)
+
+		if deviceID > 0 {
+			revoked, err := revocationChecker.IsDeviceRevoked(ctx, deviceID)
+			if err != nil {
+				return nil, status.Error(codes.Unavailable, "device revocation check failed")
+			}
+
+			if revoked {
+				return nil, status.Error(codes.Unauthenticated, "device has been revoked")
+			}
+		}
+
+		ctx = logging.InjectFields(ctx, logging.Fields{"auth.sub", userID, "auth.device", deviceID})
+		ctx = context.WithValue(ctx, DeviceIDContextKey, deviceID)
 
 		return context.WithValue(ctx, UserIDContextKey, userID), nil
+	}
+
+	return auth.UnaryServerInterceptor(func(ctx context.Context) (context.Context, error) {
+		authCtx, err := authenticate(ctx)
+		if err != nil {
+			auditReject(ctx)
+		}
+
+		return authCtx, err
 	})
 }
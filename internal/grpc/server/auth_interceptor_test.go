@@ -1,9 +1,11 @@
 package server_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
+	"github.com/llravell/go-pass/internal/entity"
 	"github.com/llravell/go-pass/internal/grpc/server"
 	"github.com/llravell/go-pass/pkg/auth"
 	pb "github.com/llravell/go-pass/pkg/grpc"
@@ -14,12 +16,32 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+type revokedDevices map[int]bool
+
+func (r revokedDevices) IsDeviceRevoked(_ context.Context, deviceID int) (bool, error) {
+	return r[deviceID], nil
+}
+
+type machineTokens map[string]*entity.MachineToken
+
+func (m machineTokens) Verify(_ context.Context, bearer string, _ string) (*entity.MachineToken, error) {
+	token, ok := m[bearer]
+	if !ok {
+		return nil, entity.ErrMachineTokenNotFound
+	}
+
+	return token, nil
+}
+
 func TestAuthInterceptor(t *testing.T) {
 	jwtManager := auth.NewJWTManager("secret")
+	machineToken := &auth.MachineToken{ID: 1, AuthSecret: "secret"}
 
 	client, closeFn := startGRPCEchoServer(
 		t,
-		server.AuthInterceptor(jwtManager),
+		server.AuthInterceptor(jwtManager, revokedDevices{2: true}, machineTokens{
+			machineToken.Bearer(): {ID: 1, UserID: 1, CollectionID: 1},
+		}),
 	)
 	defer closeFn()
 
@@ -62,4 +84,51 @@ func TestAuthInterceptor(t *testing.T) {
 
 		assert.Equal(t, codes.OK, st.Code())
 	})
+
+	t.Run("interceptor reject request from revoked device", func(t *testing.T) {
+		token, err := jwtManager.IssueForDevice(1, 2, time.Hour)
+		require.NoError(t, err)
+
+		md := metadata.Pairs("authorization", "bearer "+token)
+
+		_, err = client.Send(metadata.NewOutgoingContext(t.Context(), md), &pb.Message{})
+
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+
+		assert.Equal(t, codes.Unauthenticated, st.Code())
+	})
+
+	t.Run("interceptor allow request from active device", func(t *testing.T) {
+		token, err := jwtManager.IssueForDevice(1, 3, time.Hour)
+		require.NoError(t, err)
+
+		md := metadata.Pairs("authorization", "bearer "+token)
+
+		_, err = client.Send(metadata.NewOutgoingContext(t.Context(), md), &pb.Message{})
+		require.NoError(t, err)
+	})
+
+	t.Run("interceptor reject unknown machine token", func(t *testing.T) {
+		unknown := &auth.MachineToken{ID: 1, AuthSecret: "wrong"}
+		md := metadata.Pairs("authorization", "bearer "+unknown.Bearer())
+
+		_, err := client.Send(metadata.NewOutgoingContext(t.Context(), md), &pb.Message{})
+
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+
+		assert.Equal(t, codes.Unauthenticated, st.Code())
+	})
+
+	t.Run("interceptor reject machine token outside allowed methods", func(t *testing.T) {
+		md := metadata.Pairs("authorization", "bearer "+machineToken.Bearer())
+
+		_, err := client.Send(metadata.NewOutgoingContext(t.Context(), md), &pb.Message{})
+
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+
+		assert.Equal(t, codes.PermissionDenied, st.Code())
+	})
 }
@@ -0,0 +1,81 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/llravell/go-pass/internal/entity"
+	usecase "github.com/llravell/go-pass/internal/usecase/server"
+	pb "github.com/llravell/go-pass/pkg/grpc"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+type DevicesServer struct {
+	pb.UnimplementedDevicesServer
+
+	devicesUC *usecase.DevicesUseCase
+	log       *zerolog.Logger
+}
+
+func NewDevicesServer(
+	devicesUC *usecase.DevicesUseCase,
+	log *zerolog.Logger,
+) *DevicesServer {
+	return &DevicesServer{
+		devicesUC: devicesUC,
+		log:       log,
+	}
+}
+
+func (s *DevicesServer) List(ctx context.Context, _ *emptypb.Empty) (*pb.DeviceListResponse, error) {
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		s.log.Error().Msg("getting userID from ctx failed")
+
+		return nil, status.Error(codes.Unauthenticated, "failed to resolve user id")
+	}
+
+	deviceID, _ := GetDeviceIDFromContext(ctx)
+
+	devices, err := s.devicesUC.GetList(ctx, userID, deviceID)
+	if err != nil {
+		s.log.Error().Err(err).Msg("devices list fetching failed")
+
+		return nil, status.Error(codes.Unknown, "devices list fetching failed")
+	}
+
+	response := &pb.DeviceListResponse{
+		Devices: make([]*pb.Device, 0, len(devices)),
+	}
+
+	for _, device := range devices {
+		response.Devices = append(response.Devices, device.ToPB())
+	}
+
+	return response, nil
+}
+
+func (s *DevicesServer) Revoke(ctx context.Context, in *pb.DeviceRevokeRequest) (*emptypb.Empty, error) {
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		s.log.Error().Msg("getting userID from ctx failed")
+
+		return nil, status.Error(codes.Unauthenticated, "failed to resolve user id")
+	}
+
+	err := s.devicesUC.RevokeDevice(ctx, userID, int(in.GetId()))
+	if err != nil {
+		if errors.Is(err, entity.ErrDeviceNotFound) {
+			return nil, status.Error(codes.NotFound, "device not found")
+		}
+
+		s.log.Error().Err(err).Msg("device revoking failed")
+
+		return nil, status.Error(codes.Unknown, "device revoking failed")
+	}
+
+	return &emptypb.Empty{}, nil
+}
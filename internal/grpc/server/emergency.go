@@ -0,0 +1,163 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/llravell/go-pass/internal/entity"
+	usecase "github.com/llravell/go-pass/internal/usecase/server"
+	pb "github.com/llravell/go-pass/pkg/grpc"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+type EmergencyServer struct {
+	pb.UnimplementedEmergencyServer
+
+	emergencyUC *usecase.EmergencyUseCase
+	log         *zerolog.Logger
+}
+
+func NewEmergencyServer(
+	emergencyUC *usecase.EmergencyUseCase,
+	log *zerolog.Logger,
+) *EmergencyServer {
+	return &EmergencyServer{
+		emergencyUC: emergencyUC,
+		log:         log,
+	}
+}
+
+func (s *EmergencyServer) Grant(ctx context.Context, in *pb.EmergencyGrantRequest) (*emptypb.Empty, error) {
+	userID, err := s.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(in.GetKey()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty key")
+	}
+
+	err = s.emergencyUC.Grant(ctx, userID, in.GetLogin(), in.GetWaitPeriod().AsDuration(), in.GetKey())
+	if err != nil {
+		return nil, s.handleError(err, "emergency access granting failed")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *EmergencyServer) Revoke(ctx context.Context, in *pb.EmergencyContactRequest) (*emptypb.Empty, error) {
+	userID, err := s.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.emergencyUC.Revoke(ctx, userID, in.GetLogin()); err != nil {
+		return nil, s.handleError(err, "emergency access revoking failed")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *EmergencyServer) List(ctx context.Context, in *pb.EmergencyListRequest) (*pb.EmergencyListResponse, error) {
+	userID, err := s.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accesses, err := s.emergencyUC.GetList(ctx, userID, in.GetIncoming())
+	if err != nil {
+		return nil, s.handleError(err, "emergency access list fetching failed")
+	}
+
+	response := &pb.EmergencyListResponse{
+		Accesses: make([]*pb.EmergencyAccess, 0, len(accesses)),
+	}
+
+	for _, access := range accesses {
+		response.Accesses = append(response.Accesses, access.ToPB(userID))
+	}
+
+	return response, nil
+}
+
+func (s *EmergencyServer) Request(ctx context.Context, in *pb.EmergencyContactRequest) (*pb.EmergencyAccess, error) {
+	userID, err := s.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	access, err := s.emergencyUC.Request(ctx, userID, in.GetLogin())
+	if err != nil {
+		return nil, s.handleError(err, "emergency access requesting failed")
+	}
+
+	return access.ToPB(userID), nil
+}
+
+func (s *EmergencyServer) Approve(ctx context.Context, in *pb.EmergencyContactRequest) (*emptypb.Empty, error) {
+	userID, err := s.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.emergencyUC.Approve(ctx, userID, in.GetLogin()); err != nil {
+		return nil, s.handleError(err, "emergency access approving failed")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *EmergencyServer) Reject(ctx context.Context, in *pb.EmergencyContactRequest) (*emptypb.Empty, error) {
+	userID, err := s.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.emergencyUC.Reject(ctx, userID, in.GetLogin()); err != nil {
+		return nil, s.handleError(err, "emergency access rejecting failed")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *EmergencyServer) currentUserID(ctx context.Context) (int, error) {
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		s.log.Error().Msg("getting userID from ctx failed")
+
+		return 0, status.Error(codes.Unauthenticated, "failed to resolve user id")
+	}
+
+	return userID, nil
+}
+
+func (s *EmergencyServer) handleError(err error, msg string) error {
+	if emergencyStatus := emergencyErrorStatus(err); emergencyStatus != nil {
+		return emergencyStatus
+	}
+
+	s.log.Error().Err(err).Msg(msg)
+
+	return status.Error(codes.Unknown, msg)
+}
+
+// emergencyErrorStatus переводит ошибки экстренного доступа в статусы gRPC,
+// для остальных ошибок возвращает nil.
+func emergencyErrorStatus(err error) error {
+	switch {
+	case errors.Is(err, entity.ErrEmergencyAccessNotFound),
+		errors.Is(err, entity.ErrRecipientNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrEmergencyAccessWithSelf),
+		errors.Is(err, entity.ErrInvalidWaitPeriod):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, entity.ErrEmergencyAccessNotRequested),
+		errors.Is(err, entity.ErrEmergencyAccessNotApproved):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const healthPingTimeout = 2 * time.Second
+
+type Pinger interface {
+	PingContext(ctx context.Context) error
+}
+
+// HealthServer — стандартный grpc.health.v1, статус зависит от доступности базы.
+// Без базы сервер не обработает ни одного запроса, поэтому все сервисы
+// переводятся в NOT_SERVING вместе.
+type HealthServer struct {
+	*health.Server
+
+	pinger Pinger
+	log    *zerolog.Logger
+}
+
+func NewHealthServer(pinger Pinger, log *zerolog.Logger) *HealthServer {
+	srv := health.NewServer()
+	srv.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+
+	return &HealthServer{
+		Server: srv,
+		pinger: pinger,
+		log:    log,
+	}
+}
+
+// CheckDB проверяет базу и выставляет статус общему ("") и перечисленным сервисам.
+// После Shutdown статусы больше не меняются.
+func (s *HealthServer) CheckDB(ctx context.Context, services []string) {
+	ctx, cancel := context.WithTimeout(ctx, healthPingTimeout)
+	defer cancel()
+
+	servingStatus := healthpb.HealthCheckResponse_SERVING
+
+	if err := s.pinger.PingContext(ctx); err != nil {
+		s.log.Error().Err(err).Msg("health check: database is unavailable")
+
+		servingStatus = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+
+	s.SetServingStatus("", servingStatus)
+
+	for _, service := range services {
+		s.SetServingStatus(service, servingStatus)
+	}
+}
+
+// Run проверяет базу сразу и затем с интервалом interval до отмены контекста.
+func (s *HealthServer) Run(ctx context.Context, services []string, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	s.CheckDB(ctx, services)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.CheckDB(ctx, services)
+		}
+	}
+}
+
+// AuthFuncOverride отключает проверку авторизации: балансировщики ходят без токенов.
+func (s *HealthServer) AuthFuncOverride(ctx context.Context, _ string) (context.Context, error) {
+	return ctx, nil
+}
@@ -0,0 +1,68 @@
+package server_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/llravell/go-pass/internal/grpc/server"
+	pb "github.com/llravell/go-pass/pkg/grpc"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type pingerStub struct {
+	err error
+}
+
+func (p *pingerStub) PingContext(_ context.Context) error {
+	return p.err
+}
+
+func TestHealthServer(t *testing.T) {
+	log := zerolog.Nop()
+	services := []string{pb.Passwords_ServiceDesc.ServiceName}
+
+	checkStatus := func(t *testing.T, srv *server.HealthServer, service string) healthpb.HealthCheckResponse_ServingStatus {
+		t.Helper()
+
+		resp, err := srv.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+		require.NoError(t, err)
+
+		return resp.GetStatus()
+	}
+
+	t.Run("not serving until database is checked", func(t *testing.T) {
+		srv := server.NewHealthServer(&pingerStub{}, &log)
+
+		assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, checkStatus(t, srv, ""))
+	})
+
+	t.Run("follows database availability", func(t *testing.T) {
+		pinger := &pingerStub{}
+		srv := server.NewHealthServer(pinger, &log)
+
+		srv.CheckDB(context.Background(), services)
+		assert.Equal(t, healthpb.HealthCheckResponse_SERVING, checkStatus(t, srv, ""))
+		assert.Equal(t, healthpb.HealthCheckResponse_SERVING, checkStatus(t, srv, services[0]))
+
+		pinger.err = errors.New("connection refused")
+
+		srv.CheckDB(context.Background(), services)
+		assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, checkStatus(t, srv, ""))
+		assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, checkStatus(t, srv, services[0]))
+	})
+
+	t.Run("stays not serving after shutdown", func(t *testing.T) {
+		srv := server.NewHealthServer(&pingerStub{}, &log)
+
+		srv.CheckDB(context.Background(), services)
+		srv.Shutdown()
+		srv.CheckDB(context.Background(), services)
+
+		assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, checkStatus(t, srv, ""))
+		assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, checkStatus(t, srv, services[0]))
+	})
+}
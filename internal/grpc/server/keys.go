@@ -0,0 +1,63 @@
+package server
+
+import (
+	"context"
+
+	usecase "github.com/llravell/go-pass/internal/usecase/server"
+	pb "github.com/llravell/go-pass/pkg/grpc"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// KeysServer публикует открытые ключи подписи JWT,
+// по ним другие сервисы могут проверять токены go-pass.
+type KeysServer struct {
+	pb.UnimplementedKeysServer
+
+	signingKeysUC *usecase.SigningKeysUseCase
+	log           *zerolog.Logger
+}
+
+func NewKeysServer(
+	signingKeysUC *usecase.SigningKeysUseCase,
+	log *zerolog.Logger,
+) *KeysServer {
+	return &KeysServer{
+		signingKeysUC: signingKeysUC,
+		log:           log,
+	}
+}
+
+func (s *KeysServer) List(_ context.Context, _ *emptypb.Empty) (*pb.PublicKeysResponse, error) {
+	keys := s.signingKeysUC.PublicKeys()
+
+	response := &pb.PublicKeysResponse{
+		Keys: make([]*pb.PublicKey, 0, len(keys)),
+	}
+
+	for _, key := range keys {
+		publicKey, err := key.PublicKeyPEM()
+		if err != nil {
+			s.log.Error().Err(err).Str("kid", key.ID).Msg("public key encoding failed")
+
+			return nil, status.Error(codes.Unknown, "public key encoding failed")
+		}
+
+		response.Keys = append(response.Keys, &pb.PublicKey{
+			Kid:       key.ID,
+			Alg:       key.Algorithm,
+			PublicKey: string(publicKey),
+			CreatedAt: timestamppb.New(key.CreatedAt),
+		})
+	}
+
+	return response, nil
+}
+
+// AuthFuncOverride отключает проверку авторизации: открытые ключи не секретны.
+func (s *KeysServer) AuthFuncOverride(ctx context.Context, _ string) (context.Context, error) {
+	return ctx, nil
+}
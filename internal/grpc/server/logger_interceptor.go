@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
+	"github.com/llravell/go-pass/pkg/tracing"
 	"github.com/rs/zerolog"
 )
 
@@ -26,3 +27,13 @@ func Logger(log *zerolog.Logger) logging.Logger {
 		}
 	})
 }
+
+// TraceFields добавляет к строкам логов вызовов trace_id, по которому
+// в хранилище трасс находится сам вызов.
+func TraceFields(ctx context.Context) logging.Fields {
+	if traceID := tracing.TraceID(ctx); traceID != "" {
+		return logging.Fields{"trace_id", traceID}
+	}
+
+	return nil
+}
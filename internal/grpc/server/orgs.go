@@ -0,0 +1,238 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/llravell/go-pass/internal/entity"
+	usecase "github.com/llravell/go-pass/internal/usecase/server"
+	pb "github.com/llravell/go-pass/pkg/grpc"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+type OrgsServer struct {
+	pb.UnimplementedOrgsServer
+
+	orgsUC *usecase.OrgsUseCase
+	log    *zerolog.Logger
+}
+
+func NewOrgsServer(
+	orgsUC *usecase.OrgsUseCase,
+	log *zerolog.Logger,
+) *OrgsServer {
+	return &OrgsServer{
+		orgsUC: orgsUC,
+		log:    log,
+	}
+}
+
+func (s *OrgsServer) Create(ctx context.Context, in *pb.OrgCreateRequest) (*pb.Organization, error) {
+	userID, err := s.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	org, err := s.orgsUC.CreateOrganization(ctx, userID, in.GetName())
+	if err != nil {
+		return nil, s.handleError(err, "organization creating failed")
+	}
+
+	return org.ToPB(), nil
+}
+
+func (s *OrgsServer) List(ctx context.Context, _ *emptypb.Empty) (*pb.OrgListResponse, error) {
+	userID, err := s.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	orgs, err := s.orgsUC.GetList(ctx, userID)
+	if err != nil {
+		return nil, s.handleError(err, "organizations list fetching failed")
+	}
+
+	response := &pb.OrgListResponse{
+		Orgs: make([]*pb.Organization, 0, len(orgs)),
+	}
+
+	for _, org := range orgs {
+		response.Orgs = append(response.Orgs, org.ToPB())
+	}
+
+	return response, nil
+}
+
+func (s *OrgsServer) ListMembers(ctx context.Context, in *pb.OrgRequest) (*pb.OrgMemberListResponse, error) {
+	userID, err := s.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := s.orgsUC.GetMembers(ctx, userID, in.GetOrg())
+	if err != nil {
+		return nil, s.handleError(err, "members list fetching failed")
+	}
+
+	response := &pb.OrgMemberListResponse{
+		Members: make([]*pb.OrgMember, 0, len(members)),
+	}
+
+	for _, member := range members {
+		response.Members = append(response.Members, member.ToPB())
+	}
+
+	return response, nil
+}
+
+func (s *OrgsServer) SetMember(ctx context.Context, in *pb.OrgMemberRequest) (*emptypb.Empty, error) {
+	userID, err := s.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := entity.ParseOrgRole(in.GetRole())
+	if err != nil {
+		return nil, s.handleError(err, "member saving failed")
+	}
+
+	err = s.orgsUC.SetMember(ctx, userID, in.GetOrg(), in.GetLogin(), role)
+	if err != nil {
+		return nil, s.handleError(err, "member saving failed")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *OrgsServer) RemoveMember(ctx context.Context, in *pb.OrgMemberRequest) (*emptypb.Empty, error) {
+	userID, err := s.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.orgsUC.RemoveMember(ctx, userID, in.GetOrg(), in.GetLogin())
+	if err != nil {
+		return nil, s.handleError(err, "member removing failed")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *OrgsServer) CreateCollection(ctx context.Context, in *pb.CollectionCreateRequest) (*pb.Collection, error) {
+	userID, err := s.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if in.GetKey() == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty collection key")
+	}
+
+	collection, err := s.orgsUC.CreateCollection(ctx, userID, in.GetOrg(), in.GetName(), in.GetKey())
+	if err != nil {
+		return nil, s.handleError(err, "collection creating failed")
+	}
+
+	return collection.ToPB(), nil
+}
+
+func (s *OrgsServer) ListCollections(ctx context.Context, _ *emptypb.Empty) (*pb.CollectionListResponse, error) {
+	userID, err := s.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	collections, err := s.orgsUC.GetCollections(ctx, userID)
+	if err != nil {
+		return nil, s.handleError(err, "collections list fetching failed")
+	}
+
+	response := &pb.CollectionListResponse{
+		Collections: make([]*pb.Collection, 0, len(collections)),
+	}
+
+	for _, collection := range collections {
+		response.Collections = append(response.Collections, collection.ToPB())
+	}
+
+	return response, nil
+}
+
+func (s *OrgsServer) GrantCollection(ctx context.Context, in *pb.CollectionGrantRequest) (*emptypb.Empty, error) {
+	userID, err := s.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if in.GetKey() == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty collection key")
+	}
+
+	err = s.orgsUC.GrantCollection(ctx, userID, int(in.GetCollectionId()), in.GetLogin(), in.GetKey())
+	if err != nil {
+		return nil, s.handleError(err, "collection granting failed")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *OrgsServer) RevokeCollection(ctx context.Context, in *pb.CollectionGrantRequest) (*emptypb.Empty, error) {
+	userID, err := s.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.orgsUC.RevokeCollection(ctx, userID, int(in.GetCollectionId()), in.GetLogin())
+	if err != nil {
+		return nil, s.handleError(err, "collection revoking failed")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *OrgsServer) currentUserID(ctx context.Context) (int, error) {
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		s.log.Error().Msg("getting userID from ctx failed")
+
+		return 0, status.Error(codes.Unauthenticated, "failed to resolve user id")
+	}
+
+	return userID, nil
+}
+
+func (s *OrgsServer) handleError(err error, msg string) error {
+	if orgStatus := orgErrorStatus(err); orgStatus != nil {
+		return orgStatus
+	}
+
+	s.log.Error().Err(err).Msg(msg)
+
+	return status.Error(codes.Unknown, msg)
+}
+
+// orgErrorStatus переводит ошибки доступа к организациям в статусы gRPC,
+// для остальных ошибок возвращает nil.
+func orgErrorStatus(err error) error {
+	switch {
+	case errors.Is(err, entity.ErrOrgNotFound),
+		errors.Is(err, entity.ErrOrgMemberNotFound),
+		errors.Is(err, entity.ErrCollectionNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrOrgAlreadyExists),
+		errors.Is(err, entity.ErrCollectionAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, entity.ErrInvalidOrgName),
+		errors.Is(err, entity.ErrInvalidOrgRole):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, entity.ErrLastOrgOwner):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, entity.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	return nil
+}
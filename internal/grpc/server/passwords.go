@@ -38,6 +38,10 @@ func (s *PasswordsServer) Sync(ctx context.Context, in *pb.Password) (*pb.Passwo
 		return nil, status.Error(codes.Unauthenticated, "failed to resolve user id")
 	}
 
+	if err := checkMachineTokenScope(ctx, int(in.GetCollectionId()), true); err != nil {
+		return nil, err
+	}
+
 	err := s.passwordsUC.SyncPassword(ctx, userID, entity.NewPasswordFromPB(in))
 
 	if err == nil {
@@ -60,6 +64,14 @@ func (s *PasswordsServer) Sync(ctx context.Context, in *pb.Password) (*pb.Passwo
 		}, nil
 	}
 
+	if orgStatus := orgErrorStatus(err); orgStatus != nil {
+		return nil, orgStatus
+	}
+
+	if quotaStatus := quotaErrorStatus(err); quotaStatus != nil {
+		return nil, quotaStatus
+	}
+
 	s.log.Error().Err(err).Msg("sync failed")
 
 	return nil, status.Error(codes.Unknown, "sync failed")
@@ -73,8 +85,16 @@ func (s *PasswordsServer) Delete(ctx context.Context, in *pb.PasswordDeleteReque
 		return nil, status.Error(codes.Unauthenticated, "failed to resolve user id")
 	}
 
-	err := s.passwordsUC.DeletePasswordByName(ctx, userID, in.GetName())
+	if err := checkMachineTokenScope(ctx, int(in.GetCollectionId()), true); err != nil {
+		return nil, err
+	}
+
+	err := s.passwordsUC.DeletePasswordByName(ctx, userID, int(in.GetCollectionId()), in.GetName())
 	if err != nil {
+		if orgStatus := orgErrorStatus(err); orgStatus != nil {
+			return nil, orgStatus
+		}
+
 		s.log.Error().Err(err).Msg("password deleting failed")
 
 		return nil, status.Error(codes.Unknown, "deleting failed")
@@ -84,6 +104,46 @@ func (s *PasswordsServer) Delete(ctx context.Context, in *pb.PasswordDeleteReque
 }
 
 func (s *PasswordsServer) GetList(ctx context.Context, _ *emptypb.Empty) (*pb.PasswordGetListResponse, error) {
+	return s.getList(ctx, 0)
+}
+
+func (s *PasswordsServer) GetCollectionList(
+	ctx context.Context,
+	in *pb.PasswordCollectionRequest,
+) (*pb.PasswordGetListResponse, error) {
+	if in.GetCollectionId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty collection id")
+	}
+
+	return s.getList(ctx, int(in.GetCollectionId()))
+}
+
+func (s *PasswordsServer) GetEmergencyList(
+	ctx context.Context,
+	in *pb.PasswordEmergencyRequest,
+) (*pb.PasswordGetListResponse, error) {
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		s.log.Error().Msg("getting userID from ctx failed")
+
+		return nil, status.Error(codes.Unauthenticated, "failed to resolve user id")
+	}
+
+	passwords, err := s.passwordsUC.GetEmergencyList(ctx, userID, in.GetGrantor())
+	if err != nil {
+		if emergencyStatus := emergencyErrorStatus(err); emergencyStatus != nil {
+			return nil, emergencyStatus
+		}
+
+		s.log.Error().Err(err).Msg("emergency list fetching failed")
+
+		return nil, status.Error(codes.Unknown, "emergency list fetching failed")
+	}
+
+	return passwordsListResponse(passwords), nil
+}
+
+func (s *PasswordsServer) getList(ctx context.Context, collectionID int) (*pb.PasswordGetListResponse, error) {
 	userID, ok := GetUserIDFromContext(ctx)
 	if !ok {
 		s.log.Error().Msg("getting userID from ctx failed")
@@ -91,13 +151,25 @@ func (s *PasswordsServer) GetList(ctx context.Context, _ *emptypb.Empty) (*pb.Pa
 		return nil, status.Error(codes.Unauthenticated, "failed to resolve user id")
 	}
 
-	passwords, err := s.passwordsUC.GetList(ctx, userID)
+	if err := checkMachineTokenScope(ctx, collectionID, false); err != nil {
+		return nil, err
+	}
+
+	passwords, err := s.passwordsUC.GetList(ctx, userID, collectionID)
 	if err != nil {
+		if orgStatus := orgErrorStatus(err); orgStatus != nil {
+			return nil, orgStatus
+		}
+
 		s.log.Error().Err(err).Msg("password deleting failed")
 
 		return nil, status.Error(codes.Unknown, "deleting failed")
 	}
 
+	return passwordsListResponse(passwords), nil
+}
+
+func passwordsListResponse(passwords []*entity.Password) *pb.PasswordGetListResponse {
 	response := &pb.PasswordGetListResponse{
 		Passwords: make([]*pb.Password, 0, len(passwords)),
 	}
@@ -106,5 +178,16 @@ func (s *PasswordsServer) GetList(ctx context.Context, _ *emptypb.Empty) (*pb.Pa
 		response.Passwords = append(response.Passwords, password.ToPB())
 	}
 
-	return response, nil
+	return response
+}
+
+func quotaErrorStatus(err error) error {
+	switch {
+	case errors.Is(err, entity.ErrEntryTooLarge):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, entity.ErrEntriesQuotaExceeded):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+
+	return nil
 }
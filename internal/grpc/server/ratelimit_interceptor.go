@@ -0,0 +1,146 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/llravell/go-pass/pkg/grpc"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// методы, в которых перебирают пароль или код второго фактора
+var rateLimitedMethods = map[string]bool{
+	pb.Auth_Login_FullMethodName:              true,
+	pb.Auth_VerifySecondFactor_FullMethodName: true,
+	pb.TwoFactor_Confirm_FullMethodName:       true,
+	pb.TwoFactor_Disable_FullMethodName:       true,
+}
+
+// ChallengeParser достает пользователя из challenge-токена второго фактора.
+type ChallengeParser interface {
+	ParseChallenge(tokenString string) (int, error)
+}
+
+type challengeRequest interface {
+	GetChallengeToken() string
+}
+
+type AttemptsLimiter interface {
+	RetryAfter(ctx context.Context, keys []string) (time.Duration, error)
+	RecordFailure(ctx context.Context, keys []string) error
+	Reset(ctx context.Context, key string) error
+}
+
+type loginRequest interface {
+	GetLogin() string
+}
+
+// rateLimitKeys возвращает ключи попыток и ключ, который сбрасывается при успехе.
+// Код второго фактора ограничивается по пользователю: адрес атакующий может менять,
+// а вход по паролю выдает новый challenge, но счетчик пользователя не сбрасывает.
+func rateLimitKeys(ctx context.Context, req any, challengeParser ChallengeParser) (string, []string) {
+	keys := make([]string, 0, 2)
+
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		addr := p.Addr.String()
+		if host, _, err := net.SplitHostPort(addr); err == nil {
+			addr = host
+		}
+
+		keys = append(keys, "addr:"+addr)
+	}
+
+	if r, ok := req.(loginRequest); ok && len(r.GetLogin()) > 0 {
+		loginKey := "login:" + strings.ToLower(r.GetLogin())
+
+		return loginKey, append(keys, loginKey)
+	}
+
+	userID, ok := GetUserIDFromContext(ctx)
+
+	if r, isChallenge := req.(challengeRequest); isChallenge {
+		var err error
+
+		userID, err = challengeParser.ParseChallenge(r.GetChallengeToken())
+		ok = err == nil
+	}
+
+	if !ok || userID <= 0 {
+		return "", keys
+	}
+
+	userKey := "user:" + strconv.Itoa(userID)
+
+	return userKey, append(keys, userKey)
+}
+
+func isAuthFailure(err error) bool {
+	code := status.Code(err)
+
+	return code == codes.Unauthenticated || code == codes.InvalidArgument
+}
+
+func resourceExhausted(wait time.Duration) error {
+	wait = wait.Round(time.Second) + time.Second
+
+	st := status.New(codes.ResourceExhausted, fmt.Sprintf("too many failed attempts, retry in %s", wait))
+
+	detailed, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(wait)})
+	if err != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
+
+// RateLimitInterceptor ограничивает перебор по адресу клиента и логину, а код
+// второго фактора — по адресу и пользователю: пока действует пауза или блокировка,
+// запрос отклоняется с ResourceExhausted и RetryInfo. Стоит после AuthInterceptor,
+// чтобы знать пользователя в методах TwoFactor.
+func RateLimitInterceptor(limiter AttemptsLimiter, challengeParser ChallengeParser) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if !rateLimitedMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		resetKey, keys := rateLimitKeys(ctx, req, challengeParser)
+
+		wait, err := limiter.RetryAfter(ctx, keys)
+		if err != nil {
+			return nil, status.Error(codes.Unavailable, "rate limit check failed")
+		}
+
+		if wait > 0 {
+			auditReject(ctx)
+
+			return nil, resourceExhausted(wait)
+		}
+
+		resp, err := handler(ctx, req)
+
+		switch {
+		case isAuthFailure(err):
+			if recordErr := limiter.RecordFailure(ctx, keys); recordErr != nil {
+				return nil, status.Error(codes.Unavailable, "rate limit update failed")
+			}
+		case err == nil && len(resetKey) > 0:
+			_ = limiter.Reset(ctx, resetKey)
+		}
+
+		return resp, err
+	}
+}
@@ -0,0 +1,223 @@
+package server_test
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/llravell/go-pass/internal/entity"
+	"github.com/llravell/go-pass/internal/grpc/server"
+	usecase "github.com/llravell/go-pass/internal/usecase/server"
+	"github.com/llravell/go-pass/pkg/auth"
+	pb "github.com/llravell/go-pass/pkg/grpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+type attemptsRepoStub struct {
+	mu       sync.Mutex
+	attempts map[string]entity.AuthAttempts
+}
+
+func (r *attemptsRepoStub) GetAttempts(_ context.Context, keys []string) ([]*entity.AuthAttempts, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	result := make([]*entity.AuthAttempts, 0, len(keys))
+
+	for _, key := range keys {
+		if attempts, ok := r.attempts[key]; ok {
+			result = append(result, &attempts)
+		}
+	}
+
+	return result, nil
+}
+
+func (r *attemptsRepoStub) UpdateAttempts(
+	_ context.Context,
+	key string,
+	updateFn func(attempts *entity.AuthAttempts),
+) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	attempts := r.attempts[key]
+	attempts.Key = key
+	updateFn(&attempts)
+	r.attempts[key] = attempts
+
+	return nil
+}
+
+func (r *attemptsRepoStub) ResetAttempts(_ context.Context, key string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.attempts, key)
+
+	return nil
+}
+
+func TestRateLimitInterceptor(t *testing.T) {
+	repo := &attemptsRepoStub{attempts: make(map[string]entity.AuthAttempts)}
+	limiter := usecase.NewRateLimitUseCase(repo, usecase.RateLimits{
+		MaxFailures:     3,
+		BaseDelay:       2 * time.Second,
+		MaxDelay:        8 * time.Second,
+		LockoutDuration: time.Hour,
+		FailureWindow:   24 * time.Hour,
+	})
+	jwtManager := auth.NewJWTManager("secret")
+	interceptor := server.RateLimitInterceptor(limiter, jwtManager)
+
+	now := time.Date(2025, 4, 1, 12, 0, 0, 0, time.UTC)
+	limiter.SetClock(func() time.Time { return now })
+
+	advance := func(d time.Duration) {
+		now = now.Add(d)
+	}
+
+	peerContext := func(ip string) context.Context {
+		return peer.NewContext(t.Context(), &peer.Peer{
+			Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 5000},
+		})
+	}
+	ctx := peerContext("10.0.0.1")
+	info := &grpc.UnaryServerInfo{FullMethod: pb.Auth_Login_FullMethodName}
+
+	failingHandler := func(context.Context, any) (any, error) {
+		return nil, status.Error(codes.Unauthenticated, "invalid login or password")
+	}
+	successHandler := func(context.Context, any) (any, error) {
+		return &pb.AuthResponse{}, nil
+	}
+
+	login := func(handler grpc.UnaryHandler) error {
+		_, err := interceptor(ctx, &pb.AuthRequest{Login: "alice"}, info, handler)
+
+		return err
+	}
+	loginFrom := func(ip string, handler grpc.UnaryHandler) error {
+		_, err := interceptor(peerContext(ip), &pb.AuthRequest{Login: "bob"}, info, handler)
+
+		return err
+	}
+	retryDelay := func(t *testing.T, err error) time.Duration {
+		t.Helper()
+
+		require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+		st, _ := status.FromError(err)
+		require.Len(t, st.Details(), 1)
+
+		retryInfo, ok := st.Details()[0].(*errdetails.RetryInfo)
+		require.True(t, ok)
+
+		return retryInfo.GetRetryDelay().AsDuration()
+	}
+	retryAfter := func(t *testing.T, keys ...string) time.Duration {
+		t.Helper()
+
+		wait, err := limiter.RetryAfter(context.Background(), keys)
+		require.NoError(t, err)
+
+		return wait
+	}
+
+	t.Run("throttles retries after failure", func(t *testing.T) {
+		assert.Equal(t, codes.Unauthenticated, status.Code(login(failingHandler)))
+
+		assert.Equal(t, 2*time.Second, retryAfter(t, "login:alice"))
+		// клиенту сообщаем округленную вверх паузу
+		assert.Equal(t, 3*time.Second, retryDelay(t, login(successHandler)))
+
+		advance(time.Second)
+
+		assert.Equal(t, time.Second, retryAfter(t, "login:alice"))
+		assert.Equal(t, 2*time.Second, retryDelay(t, login(successHandler)))
+	})
+
+	t.Run("allows retry after delay and resets login on success", func(t *testing.T) {
+		advance(time.Second)
+
+		require.NoError(t, login(successHandler))
+
+		_, ok := repo.attempts["login:alice"]
+		assert.False(t, ok)
+	})
+
+	t.Run("doubles delay after each failure", func(t *testing.T) {
+		assert.Equal(t, codes.Unauthenticated, status.Code(loginFrom("10.0.0.2", failingHandler)))
+		assert.Equal(t, 2*time.Second, retryAfter(t, "addr:10.0.0.2", "login:bob"))
+
+		advance(2 * time.Second)
+		assert.Equal(t, codes.Unauthenticated, status.Code(loginFrom("10.0.0.2", failingHandler)))
+		assert.Equal(t, 4*time.Second, retryAfter(t, "addr:10.0.0.2", "login:bob"))
+
+		advance(4 * time.Second)
+	})
+
+	t.Run("locks out after max failures", func(t *testing.T) {
+		assert.Equal(t, codes.Unauthenticated, status.Code(loginFrom("10.0.0.2", failingHandler)))
+		assert.Equal(t, time.Hour, retryAfter(t, "addr:10.0.0.2", "login:bob"))
+		assert.Equal(t, time.Hour+time.Second, retryDelay(t, loginFrom("10.0.0.2", successHandler)))
+
+		// логин заблокирован и с другого адреса
+		assert.Equal(t, time.Hour+time.Second, retryDelay(t, loginFrom("10.0.0.3", successHandler)))
+
+		advance(time.Hour)
+		require.NoError(t, loginFrom("10.0.0.3", successHandler))
+	})
+
+	t.Run("limits second factor by user across addresses", func(t *testing.T) {
+		challenge, err := jwtManager.IssueChallenge(5, time.Hour)
+		require.NoError(t, err)
+
+		verifyInfo := &grpc.UnaryServerInfo{FullMethod: pb.Auth_VerifySecondFactor_FullMethodName}
+		verifyFrom := func(ip string, handler grpc.UnaryHandler) error {
+			_, err := interceptor(peerContext(ip), &pb.SecondFactorRequest{ChallengeToken: challenge}, verifyInfo, handler)
+
+			return err
+		}
+
+		assert.Equal(t, codes.Unauthenticated, status.Code(verifyFrom("10.0.1.1", failingHandler)))
+		advance(2 * time.Second)
+		assert.Equal(t, codes.Unauthenticated, status.Code(verifyFrom("10.0.1.2", failingHandler)))
+		advance(4 * time.Second)
+		assert.Equal(t, codes.Unauthenticated, status.Code(verifyFrom("10.0.1.3", failingHandler)))
+
+		// вход по паролю выдает новый challenge, но счетчик пользователя не сбрасывает
+		_, err = interceptor(peerContext("10.0.1.4"), &pb.AuthRequest{Login: "carol"}, info, successHandler)
+		require.NoError(t, err)
+
+		assert.Equal(t, time.Hour+time.Second, retryDelay(t, verifyFrom("10.0.1.5", successHandler)))
+
+		// тот же счетчик и для подтверждения кода в TwoFactor
+		userCtx := context.WithValue(peerContext("10.0.1.6"), server.UserIDContextKey, 5)
+		_, err = interceptor(userCtx, &pb.TwoFactorCodeRequest{}, &grpc.UnaryServerInfo{
+			FullMethod: pb.TwoFactor_Confirm_FullMethodName,
+		}, successHandler)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+		advance(time.Hour)
+		require.NoError(t, verifyFrom("10.0.1.5", successHandler))
+
+		_, ok := repo.attempts["user:5"]
+		assert.False(t, ok)
+	})
+
+	t.Run("skips other methods", func(t *testing.T) {
+		_, err := interceptor(ctx, &pb.AuthRequest{Login: "alice"}, &grpc.UnaryServerInfo{
+			FullMethod: pb.Auth_Register_FullMethodName,
+		}, successHandler)
+		require.NoError(t, err)
+	})
+}
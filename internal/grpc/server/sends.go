@@ -0,0 +1,145 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/llravell/go-pass/internal/entity"
+	usecase "github.com/llravell/go-pass/internal/usecase/server"
+	pb "github.com/llravell/go-pass/pkg/grpc"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type SendsServer struct {
+	pb.UnimplementedSendsServer
+
+	sendsUC *usecase.SendsUseCase
+	log     *zerolog.Logger
+}
+
+func NewSendsServer(
+	sendsUC *usecase.SendsUseCase,
+	log *zerolog.Logger,
+) *SendsServer {
+	return &SendsServer{
+		sendsUC: sendsUC,
+		log:     log,
+	}
+}
+
+func (s *SendsServer) Create(ctx context.Context, in *pb.SendCreateRequest) (*pb.SendCreateResponse, error) {
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		s.log.Error().Msg("getting userID from ctx failed")
+
+		return nil, status.Error(codes.Unauthenticated, "failed to resolve user id")
+	}
+
+	send, err := s.sendsUC.Create(
+		ctx,
+		userID,
+		in.GetCiphertext(),
+		in.GetTtl().AsDuration(),
+		int(in.GetMaxViews()),
+	)
+	if err != nil {
+		return nil, sendErrorStatus(err, s.log, "send creating failed")
+	}
+
+	return &pb.SendCreateResponse{
+		Id:        send.ID,
+		Url:       s.sendsUC.URL(send.ID),
+		ExpiresAt: timestamppb.New(send.ExpiresAt),
+	}, nil
+}
+
+func (s *SendsServer) List(ctx context.Context, _ *emptypb.Empty) (*pb.SendListResponse, error) {
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		s.log.Error().Msg("getting userID from ctx failed")
+
+		return nil, status.Error(codes.Unauthenticated, "failed to resolve user id")
+	}
+
+	sends, err := s.sendsUC.GetList(ctx, userID)
+	if err != nil {
+		return nil, sendErrorStatus(err, s.log, "sends list fetching failed")
+	}
+
+	response := &pb.SendListResponse{
+		Sends: make([]*pb.SendInfo, 0, len(sends)),
+	}
+
+	for _, send := range sends {
+		response.Sends = append(response.Sends, send.ToPB())
+	}
+
+	return response, nil
+}
+
+func (s *SendsServer) Delete(ctx context.Context, in *pb.SendRequest) (*emptypb.Empty, error) {
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		s.log.Error().Msg("getting userID from ctx failed")
+
+		return nil, status.Error(codes.Unauthenticated, "failed to resolve user id")
+	}
+
+	if err := s.sendsUC.Delete(ctx, userID, in.GetId()); err != nil {
+		return nil, sendErrorStatus(err, s.log, "send deleting failed")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// SendsAccessServer отдает передачи по ссылке без авторизации.
+type SendsAccessServer struct {
+	pb.UnimplementedSendsAccessServer
+
+	sendsUC *usecase.SendsUseCase
+	log     *zerolog.Logger
+}
+
+func NewSendsAccessServer(
+	sendsUC *usecase.SendsUseCase,
+	log *zerolog.Logger,
+) *SendsAccessServer {
+	return &SendsAccessServer{
+		sendsUC: sendsUC,
+		log:     log,
+	}
+}
+
+func (s *SendsAccessServer) Receive(ctx context.Context, in *pb.SendRequest) (*pb.SendPayload, error) {
+	send, err := s.sendsUC.Receive(ctx, in.GetId())
+	if err != nil {
+		return nil, sendErrorStatus(err, s.log, "send receiving failed")
+	}
+
+	return &pb.SendPayload{Ciphertext: send.Ciphertext}, nil
+}
+
+// AuthFuncOverride отключает проверку авторизации: у получателя ссылки нет аккаунта,
+// а без ключа из ссылки шифротекст бесполезен.
+func (s *SendsAccessServer) AuthFuncOverride(ctx context.Context, _ string) (context.Context, error) {
+	return ctx, nil
+}
+
+func sendErrorStatus(err error, log *zerolog.Logger, msg string) error {
+	switch {
+	case errors.Is(err, entity.ErrSendNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrInvalidSend):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, entity.ErrSendTooLarge):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+
+	log.Error().Err(err).Msg(msg)
+
+	return status.Error(codes.Unknown, msg)
+}
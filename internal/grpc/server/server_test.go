@@ -25,14 +25,14 @@ func (s *echoServer) Send(_ context.Context, in *pb.Message) (*pb.Message, error
 
 func startGRPCEchoServer(
 	t *testing.T,
-	unaryInterceptor grpc.UnaryServerInterceptor,
+	unaryInterceptors ...grpc.UnaryServerInterceptor,
 ) (pb.EchoClient, func()) {
 	t.Helper()
 
 	echo := &echoServer{}
 
 	lis := bufconn.Listen(bufSize)
-	server := grpc.NewServer(grpc.UnaryInterceptor(unaryInterceptor))
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(unaryInterceptors...))
 	pb.RegisterEchoServer(server, echo)
 
 	go func() {
@@ -0,0 +1,198 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/llravell/go-pass/internal/entity"
+	usecase "github.com/llravell/go-pass/internal/usecase/server"
+	pb "github.com/llravell/go-pass/pkg/grpc"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+type SharesServer struct {
+	pb.UnimplementedSharesServer
+
+	sharesUC *usecase.SharesUseCase
+	log      *zerolog.Logger
+}
+
+func NewSharesServer(
+	sharesUC *usecase.SharesUseCase,
+	log *zerolog.Logger,
+) *SharesServer {
+	return &SharesServer{
+		sharesUC: sharesUC,
+		log:      log,
+	}
+}
+
+func (s *SharesServer) SetKeyPair(ctx context.Context, in *pb.UserKeyPair) (*emptypb.Empty, error) {
+	userID, err := s.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if in.GetPublicKey() == "" || in.GetEncryptedPrivateKey() == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty key pair")
+	}
+
+	err = s.sharesUC.SetKeyPair(ctx, userID, &entity.UserKeyPair{
+		PublicKey:           in.GetPublicKey(),
+		EncryptedPrivateKey: in.GetEncryptedPrivateKey(),
+	})
+	if err != nil {
+		return nil, s.handleError(err, "key pair saving failed")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *SharesServer) GetKeyPair(ctx context.Context, _ *emptypb.Empty) (*pb.UserKeyPair, error) {
+	userID, err := s.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	keyPair, err := s.sharesUC.GetKeyPair(ctx, userID)
+	if err != nil {
+		return nil, s.handleError(err, "key pair fetching failed")
+	}
+
+	return &pb.UserKeyPair{
+		PublicKey:           keyPair.PublicKey,
+		EncryptedPrivateKey: keyPair.EncryptedPrivateKey,
+	}, nil
+}
+
+func (s *SharesServer) GetPublicKey(ctx context.Context, in *pb.PublicKeyRequest) (*pb.UserPublicKey, error) {
+	publicKey, err := s.sharesUC.GetPublicKey(ctx, in.GetLogin())
+	if err != nil {
+		return nil, s.handleError(err, "public key fetching failed")
+	}
+
+	return &pb.UserPublicKey{
+		Login:     in.GetLogin(),
+		PublicKey: publicKey,
+	}, nil
+}
+
+func (s *SharesServer) Create(ctx context.Context, in *pb.ShareCreateRequest) (*pb.Share, error) {
+	userID, err := s.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if in.GetName() == "" || in.GetOwnerKey() == "" || in.GetRecipientKey() == "" {
+		return nil, status.Error(codes.InvalidArgument, "incomplete share")
+	}
+
+	share := &entity.Share{
+		Name:          in.GetName(),
+		Value:         in.GetValue(),
+		Meta:          in.GetMeta(),
+		SourceVersion: int(in.GetSourceVersion()),
+		Permission:    entity.SharePermission(in.GetPermission()),
+		OwnerKey:      in.GetOwnerKey(),
+		RecipientKey:  in.GetRecipientKey(),
+	}
+
+	err = s.sharesUC.CreateShare(ctx, userID, in.GetRecipient(), share)
+	if err != nil {
+		return nil, s.handleError(err, "share creating failed")
+	}
+
+	return share.ToPB(userID), nil
+}
+
+func (s *SharesServer) List(ctx context.Context, in *pb.ShareListRequest) (*pb.ShareListResponse, error) {
+	userID, err := s.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	shares, err := s.sharesUC.GetList(ctx, userID, in.GetOutgoing())
+	if err != nil {
+		return nil, s.handleError(err, "shares list fetching failed")
+	}
+
+	response := &pb.ShareListResponse{
+		Shares: make([]*pb.Share, 0, len(shares)),
+	}
+
+	for _, share := range shares {
+		response.Shares = append(response.Shares, share.ToPB(userID))
+	}
+
+	return response, nil
+}
+
+func (s *SharesServer) Update(ctx context.Context, in *pb.ShareUpdateRequest) (*pb.Share, error) {
+	userID, err := s.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	share, err := s.sharesUC.UpdateShare(ctx, userID, &entity.Share{
+		ID:            int(in.GetId()),
+		Value:         in.GetValue(),
+		Meta:          in.GetMeta(),
+		Version:       int(in.GetVersion()),
+		SourceVersion: int(in.GetSourceVersion()),
+	})
+	if err != nil {
+		return nil, s.handleError(err, "share updating failed")
+	}
+
+	return share.ToPB(userID), nil
+}
+
+func (s *SharesServer) Revoke(ctx context.Context, in *pb.ShareRevokeRequest) (*emptypb.Empty, error) {
+	userID, err := s.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.sharesUC.RevokeShare(ctx, userID, int(in.GetId()))
+	if err != nil {
+		return nil, s.handleError(err, "share revoking failed")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *SharesServer) currentUserID(ctx context.Context) (int, error) {
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		s.log.Error().Msg("getting userID from ctx failed")
+
+		return 0, status.Error(codes.Unauthenticated, "failed to resolve user id")
+	}
+
+	return userID, nil
+}
+
+func (s *SharesServer) handleError(err error, msg string) error {
+	switch {
+	case errors.Is(err, entity.ErrKeyPairNotFound),
+		errors.Is(err, entity.ErrRecipientNotFound),
+		errors.Is(err, entity.ErrShareNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrKeyPairAlreadyExists),
+		errors.Is(err, entity.ErrShareAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, entity.ErrShareWithSelf):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, entity.ErrShareReadOnly):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, entity.ErrShareVersionConflict):
+		return status.Error(codes.Aborted, err.Error())
+	}
+
+	s.log.Error().Err(err).Msg(msg)
+
+	return status.Error(codes.Unknown, msg)
+}
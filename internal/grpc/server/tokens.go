@@ -0,0 +1,158 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/llravell/go-pass/internal/entity"
+	usecase "github.com/llravell/go-pass/internal/usecase/server"
+	pb "github.com/llravell/go-pass/pkg/grpc"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+type TokensServer struct {
+	pb.UnimplementedTokensServer
+
+	tokensUC *usecase.MachineTokensUseCase
+	log      *zerolog.Logger
+}
+
+func NewTokensServer(
+	tokensUC *usecase.MachineTokensUseCase,
+	log *zerolog.Logger,
+) *TokensServer {
+	return &TokensServer{
+		tokensUC: tokensUC,
+		log:      log,
+	}
+}
+
+func (s *TokensServer) Create(ctx context.Context, in *pb.TokenCreateRequest) (*pb.TokenCreateResponse, error) {
+	userID, err := s.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(in.GetWrappedKey()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty wrapped key")
+	}
+
+	token := &entity.MachineToken{
+		Name:         in.GetName(),
+		CollectionID: int(in.GetCollectionId()),
+		Writable:     in.GetWritable(),
+		WrappedKey:   in.GetWrappedKey(),
+	}
+
+	secret, err := s.tokensUC.Create(ctx, userID, token, in.GetTtl().AsDuration())
+	if err != nil {
+		return nil, s.handleError(err, "machine token creating failed")
+	}
+
+	s.log.Info().
+		Int("user_id", userID).
+		Int("token_id", token.ID).
+		Int("collection_id", token.CollectionID).
+		Bool("writable", token.Writable).
+		Msg("machine token created")
+
+	return &pb.TokenCreateResponse{
+		Id:         int32(token.ID), //nolint:gosec
+		AuthSecret: secret,
+	}, nil
+}
+
+func (s *TokensServer) List(ctx context.Context, _ *emptypb.Empty) (*pb.TokenListResponse, error) {
+	userID, err := s.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := s.tokensUC.GetList(ctx, userID)
+	if err != nil {
+		return nil, s.handleError(err, "machine tokens fetching failed")
+	}
+
+	response := &pb.TokenListResponse{
+		Tokens: make([]*pb.MachineToken, 0, len(tokens)),
+	}
+
+	for _, token := range tokens {
+		pbToken := token.ToPB()
+		pbToken.WrappedKey = ""
+
+		response.Tokens = append(response.Tokens, pbToken)
+	}
+
+	return response, nil
+}
+
+func (s *TokensServer) Revoke(ctx context.Context, in *pb.TokenRevokeRequest) (*emptypb.Empty, error) {
+	userID, err := s.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.tokensUC.Revoke(ctx, userID, int(in.GetId())); err != nil {
+		return nil, s.handleError(err, "machine token revoking failed")
+	}
+
+	s.log.Info().
+		Int("user_id", userID).
+		Int32("token_id", in.GetId()).
+		Msg("machine token revoked")
+
+	return &emptypb.Empty{}, nil
+}
+
+// Self отдает владельцу машинного токена его область и обернутый ключ коллекции.
+func (s *TokensServer) Self(ctx context.Context, _ *emptypb.Empty) (*pb.MachineToken, error) {
+	token, ok := GetMachineTokenFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "request is not authorized by machine token")
+	}
+
+	return token.ToPB(), nil
+}
+
+func (s *TokensServer) currentUserID(ctx context.Context) (int, error) {
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		s.log.Error().Msg("getting userID from ctx failed")
+
+		return 0, status.Error(codes.Unauthenticated, "failed to resolve user id")
+	}
+
+	return userID, nil
+}
+
+func (s *TokensServer) handleError(err error, msg string) error {
+	switch {
+	case errors.Is(err, entity.ErrMachineTokenNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrInvalidMachineToken):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if orgStatus := orgErrorStatus(err); orgStatus != nil {
+		return orgStatus
+	}
+
+	s.log.Error().Err(err).Msg(msg)
+
+	return status.Error(codes.Unknown, msg)
+}
+
+// checkMachineTokenScope не пускает запрос по машинному токену за пределы его
+// коллекции, а токен только для чтения — к изменению записей.
+func checkMachineTokenScope(ctx context.Context, collectionID int, write bool) error {
+	token, ok := GetMachineTokenFromContext(ctx)
+	if !ok || token.Permits(collectionID, write) {
+		return nil
+	}
+
+	return status.Error(codes.PermissionDenied, entity.ErrMachineTokenScope.Error())
+}
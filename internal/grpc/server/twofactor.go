@@ -0,0 +1,106 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/llravell/go-pass/internal/entity"
+	usecase "github.com/llravell/go-pass/internal/usecase/server"
+	pb "github.com/llravell/go-pass/pkg/grpc"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+type TwoFactorServer struct {
+	pb.UnimplementedTwoFactorServer
+
+	twoFactorUC *usecase.TwoFactorUseCase
+	log         *zerolog.Logger
+}
+
+func NewTwoFactorServer(
+	twoFactorUC *usecase.TwoFactorUseCase,
+	log *zerolog.Logger,
+) *TwoFactorServer {
+	return &TwoFactorServer{
+		twoFactorUC: twoFactorUC,
+		log:         log,
+	}
+}
+
+func (s *TwoFactorServer) Enroll(ctx context.Context, _ *emptypb.Empty) (*pb.TwoFactorEnrollment, error) {
+	user, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	enrollment, err := s.twoFactorUC.BeginEnrollment(ctx, user)
+	if err != nil {
+		return nil, s.handleError(err, "two-factor enrollment failed")
+	}
+
+	return enrollmentToPB(enrollment), nil
+}
+
+func (s *TwoFactorServer) Confirm(ctx context.Context, in *pb.TwoFactorCodeRequest) (*emptypb.Empty, error) {
+	user, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.twoFactorUC.ConfirmEnrollment(ctx, user, in.GetCode())
+	if err != nil {
+		return nil, s.handleError(err, "two-factor confirmation failed")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *TwoFactorServer) Disable(ctx context.Context, in *pb.TwoFactorCodeRequest) (*emptypb.Empty, error) {
+	user, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.twoFactorUC.Disable(ctx, user, in.GetCode())
+	if err != nil {
+		return nil, s.handleError(err, "two-factor disabling failed")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *TwoFactorServer) currentUser(ctx context.Context) (*entity.User, error) {
+	userID, ok := GetUserIDFromContext(ctx)
+	if !ok {
+		s.log.Error().Msg("getting userID from ctx failed")
+
+		return nil, status.Error(codes.Unauthenticated, "failed to resolve user id")
+	}
+
+	user, err := s.twoFactorUC.UserByID(ctx, userID)
+	if err != nil {
+		s.log.Error().Err(err).Msg("user fetching failed")
+
+		return nil, status.Error(codes.Unknown, "user fetching failed")
+	}
+
+	return user, nil
+}
+
+func (s *TwoFactorServer) handleError(err error, msg string) error {
+	switch {
+	case errors.Is(err, entity.ErrInvalidSecondFactorCode):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, entity.ErrTwoFactorAlreadyEnabled),
+		errors.Is(err, entity.ErrTwoFactorNotEnrolled),
+		errors.Is(err, entity.ErrTwoFactorRequired):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	s.log.Error().Err(err).Msg(msg)
+
+	return status.Error(codes.Unknown, msg)
+}
@@ -0,0 +1,92 @@
+package httpserver
+
+import (
+	"embed"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/llravell/go-pass/internal/entity"
+	usecase "github.com/llravell/go-pass/internal/usecase/server"
+	"github.com/rs/zerolog"
+)
+
+//go:embed static
+var staticFiles embed.FS
+
+// contentSecurityPolicy разрешает странице только собственный скрипт
+// и запросы к своему серверу: ключ из фрагмента ссылки никуда не уходит.
+const contentSecurityPolicy = "default-src 'none'; script-src 'self'; connect-src 'self'; style-src 'self'"
+
+type sendPayload struct {
+	Ciphertext string `json:"ciphertext,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// SendsHandler открывает одноразовые передачи в браузере. Страница по ссылке
+// не трогает запись, просмотр засчитывается только POST-запросом после нажатия
+// кнопки, чтобы ссылку не "сжигали" превью мессенджеров. Расшифровка выполняется
+// в браузере ключом из фрагмента ссылки.
+type SendsHandler struct {
+	sendsUC *usecase.SendsUseCase
+	log     *zerolog.Logger
+}
+
+func NewSendsHandler(sendsUC *usecase.SendsUseCase, log *zerolog.Logger) *SendsHandler {
+	return &SendsHandler{
+		sendsUC: sendsUC,
+		log:     log,
+	}
+}
+
+func (h *SendsHandler) Routes() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.Handle("GET /static/", h.withSecurityHeaders(http.FileServerFS(staticFiles)))
+	mux.Handle("GET /s/{id}", h.withSecurityHeaders(http.HandlerFunc(h.page)))
+	mux.Handle("POST /api/sends/{id}", h.withSecurityHeaders(http.HandlerFunc(h.receive)))
+
+	return mux
+}
+
+func (h *SendsHandler) page(w http.ResponseWriter, r *http.Request) {
+	http.ServeFileFS(w, r, staticFiles, "static/send.html")
+}
+
+func (h *SendsHandler) receive(w http.ResponseWriter, r *http.Request) {
+	send, err := h.sendsUC.Receive(r.Context(), r.PathValue("id"))
+	if err != nil {
+		if errors.Is(err, entity.ErrSendNotFound) {
+			h.writeJSON(w, http.StatusNotFound, sendPayload{Error: err.Error()})
+
+			return
+		}
+
+		h.log.Error().Err(err).Msg("send receiving failed")
+		h.writeJSON(w, http.StatusInternalServerError, sendPayload{Error: "send receiving failed"})
+
+		return
+	}
+
+	h.writeJSON(w, http.StatusOK, sendPayload{Ciphertext: send.Ciphertext})
+}
+
+func (h *SendsHandler) writeJSON(w http.ResponseWriter, code int, payload sendPayload) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+
+	if err := json.NewEncoder(w).Encode(payload); err != nil {
+		h.log.Error().Err(err).Msg("send response writing failed")
+	}
+}
+
+func (h *SendsHandler) withSecurityHeaders(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", "no-store")
+		w.Header().Set("Referrer-Policy", "no-referrer")
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		w.Header().Set("Content-Security-Policy", contentSecurityPolicy)
+
+		next.ServeHTTP(w, r)
+	})
+}
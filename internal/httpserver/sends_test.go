@@ -0,0 +1,128 @@
+package httpserver_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/llravell/go-pass/internal/entity"
+	"github.com/llravell/go-pass/internal/httpserver"
+	usecase "github.com/llravell/go-pass/internal/usecase/server"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type sendsRepoStub struct {
+	mu    sync.Mutex
+	sends map[string]*entity.Send
+}
+
+func (r *sendsRepoStub) CreateSend(_ context.Context, send *entity.Send) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.sends[send.ID] = send
+
+	return nil
+}
+
+func (r *sendsRepoStub) ConsumeSend(_ context.Context, id string, now time.Time) (*entity.Send, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	send, ok := r.sends[id]
+	if !ok || !send.ExpiresAt.After(now) {
+		return nil, entity.ErrSendNotFound
+	}
+
+	send.Views++
+
+	if send.Views >= send.MaxViews {
+		delete(r.sends, id)
+	}
+
+	return send, nil
+}
+
+func (r *sendsRepoStub) GetSends(context.Context, int, time.Time) ([]*entity.Send, error) {
+	return nil, nil
+}
+
+func (r *sendsRepoStub) DeleteSend(context.Context, int, string) error {
+	return nil
+}
+
+func (r *sendsRepoStub) DeleteExpiredSends(context.Context, time.Time) (int64, error) {
+	return 0, nil
+}
+
+func TestSendsHandler(t *testing.T) {
+	log := zerolog.Nop()
+	repo := &sendsRepoStub{sends: make(map[string]*entity.Send)}
+	sendsUC := usecase.NewSendsUseCase(repo, time.Hour, "https://pass.example.com/")
+	srv := httptest.NewServer(httpserver.NewSendsHandler(sendsUC, &log).Routes())
+
+	defer srv.Close()
+
+	receive := func(t *testing.T, id string) (int, map[string]string) {
+		t.Helper()
+
+		response, err := http.Post(srv.URL+"/api/sends/"+id, "", nil) //nolint:noctx
+		require.NoError(t, err)
+
+		defer response.Body.Close()
+
+		var payload map[string]string
+
+		require.NoError(t, json.NewDecoder(response.Body).Decode(&payload))
+
+		return response.StatusCode, payload
+	}
+
+	t.Run("page does not consume views", func(t *testing.T) {
+		send, err := sendsUC.Create(t.Context(), 1, "ciphertext", time.Minute, 1)
+		require.NoError(t, err)
+		assert.Equal(t, "https://pass.example.com/s/"+send.ID, sendsUC.URL(send.ID))
+
+		for range 2 {
+			response, err := http.Get(srv.URL + "/s/" + send.ID) //nolint:noctx
+			require.NoError(t, err)
+			response.Body.Close()
+
+			assert.Equal(t, http.StatusOK, response.StatusCode)
+			assert.Equal(t, "no-store", response.Header.Get("Cache-Control"))
+			assert.Contains(t, response.Header.Get("Content-Security-Policy"), "default-src 'none'")
+		}
+
+		code, payload := receive(t, send.ID)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "ciphertext", payload["ciphertext"])
+	})
+
+	t.Run("last view destroys send", func(t *testing.T) {
+		send, err := sendsUC.Create(t.Context(), 1, "ciphertext", time.Minute, 2)
+		require.NoError(t, err)
+
+		for range 2 {
+			code, _ := receive(t, send.ID)
+			assert.Equal(t, http.StatusOK, code)
+		}
+
+		code, payload := receive(t, send.ID)
+		assert.Equal(t, http.StatusNotFound, code)
+		assert.Empty(t, payload["ciphertext"])
+	})
+
+	t.Run("rejects invalid sends", func(t *testing.T) {
+		_, err := sendsUC.Create(t.Context(), 1, "ciphertext", 2*time.Hour, 1)
+		require.ErrorIs(t, err, entity.ErrInvalidSend)
+
+		_, err = sendsUC.Create(t.Context(), 1, "ciphertext", time.Minute, 0)
+		require.ErrorIs(t, err, entity.ErrInvalidSend)
+	})
+}
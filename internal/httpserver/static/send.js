@@ -0,0 +1,65 @@
+// Шифротекст: base64(nonce || ciphertext || tag), AES-256-GCM, как в pkg/encryption.
+// Ключ передается во фрагменте ссылки в base64url и на сервер не отправляется.
+(function () {
+  const nonceSize = 12;
+
+  const reveal = document.getElementById('reveal');
+  const secret = document.getElementById('secret');
+  const error = document.getElementById('error');
+
+  const id = decodeURIComponent(location.pathname.split('/').pop());
+  const encodedKey = location.hash.slice(1);
+
+  function fromBase64(value) {
+    const normalized = value.replace(/-/g, '+').replace(/_/g, '/');
+    const padded = normalized + '='.repeat((4 - (normalized.length % 4)) % 4);
+
+    return Uint8Array.from(atob(padded), (c) => c.charCodeAt(0));
+  }
+
+  function showError(message) {
+    reveal.hidden = true;
+    error.textContent = message;
+    error.hidden = false;
+  }
+
+  async function decrypt(ciphertext) {
+    const data = fromBase64(ciphertext);
+    const key = await crypto.subtle.importKey('raw', fromBase64(encodedKey), 'AES-GCM', false, ['decrypt']);
+    const plaintext = await crypto.subtle.decrypt(
+      { name: 'AES-GCM', iv: data.slice(0, nonceSize) },
+      key,
+      data.slice(nonceSize),
+    );
+
+    return new TextDecoder().decode(plaintext);
+  }
+
+  if (!encodedKey) {
+    showError('The link is incomplete: it has no decryption key.');
+
+    return;
+  }
+
+  reveal.addEventListener('click', async () => {
+    reveal.disabled = true;
+
+    try {
+      const response = await fetch('/api/sends/' + encodeURIComponent(id), { method: 'POST' });
+      const payload = await response.json();
+
+      if (!response.ok) {
+        showError(payload.error || 'The secret is not available.');
+
+        return;
+      }
+
+      secret.textContent = await decrypt(payload.ciphertext);
+      secret.hidden = false;
+      reveal.hidden = true;
+      history.replaceState(null, '', location.pathname);
+    } catch (e) {
+      showError('The secret could not be decrypted, check that the link is complete.');
+    }
+  });
+})();
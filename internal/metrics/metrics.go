@@ -0,0 +1,118 @@
+// Package metrics собирает метрики сервера в формате Prometheus: вызовы gRPC,
+// пул соединений с базой и события, которые не видны по кодам ответов.
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+
+	grpcprom "github.com/grpc-ecosystem/go-grpc-middleware/providers/prometheus"
+	"github.com/llravell/go-pass/internal/entity"
+	pb "github.com/llravell/go-pass/pkg/grpc"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const namespace = "gopass"
+
+type Metrics struct {
+	registry *prometheus.Registry
+	grpc     *grpcprom.ServerMetrics
+
+	syncConflicts   *prometheus.CounterVec
+	loginFailures   prometheus.Counter
+	registeredUsers prometheus.Counter
+}
+
+// New регистрирует метрики в отдельном реестре, чтобы в выдачу не попадало
+// ничего, кроме метрик сервера, рантайма Go и процесса.
+func New(db *sql.DB) *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		grpc: grpcprom.NewServerMetrics(
+			grpcprom.WithServerHandlingTimeHistogram(),
+		),
+		syncConflicts: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "sync_conflicts_total",
+			Help:      "Number of rejected password syncs by conflict type.",
+		}, []string{"type"}),
+		loginFailures: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "login_failures_total",
+			Help:      "Number of failed login attempts.",
+		}),
+		registeredUsers: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "registered_users_total",
+			Help:      "Number of users registered since the server start.",
+		}),
+	}
+
+	// оба типа конфликта видны в выдаче с нуля, а не с первого конфликта
+	m.syncConflicts.WithLabelValues(string(entity.PasswordDiffConflictType))
+	m.syncConflicts.WithLabelValues(string(entity.PasswordDeletedConflictType))
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		collectors.NewDBStatsCollector(db, namespace),
+		m.grpc,
+		m.syncConflicts,
+		m.loginFailures,
+		m.registeredUsers,
+	)
+
+	return m
+}
+
+// UnaryServerInterceptors возвращает интерцепторы для цепочки сервера:
+// общие метрики вызовов и счетчики событий по ответам методов.
+func (m *Metrics) UnaryServerInterceptors() []grpc.UnaryServerInterceptor {
+	return []grpc.UnaryServerInterceptor{
+		m.grpc.UnaryServerInterceptor(),
+		m.eventsInterceptor,
+	}
+}
+
+// InitializeMetrics заводит нулевые значения для всех зарегистрированных
+// методов, вызывается после регистрации сервисов.
+func (m *Metrics) InitializeMetrics(srv *grpc.Server) {
+	m.grpc.InitializeMetrics(srv)
+}
+
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+func (m *Metrics) eventsInterceptor(
+	ctx context.Context,
+	req any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	resp, err := handler(ctx, req)
+
+	switch info.FullMethod {
+	case pb.Auth_Login_FullMethodName, pb.Auth_VerifySecondFactor_FullMethodName:
+		if status.Code(err) == codes.Unauthenticated {
+			m.loginFailures.Inc()
+		}
+	case pb.Auth_Register_FullMethodName:
+		if err == nil {
+			m.registeredUsers.Inc()
+		}
+	case pb.Passwords_Sync_FullMethodName:
+		if syncResp, ok := resp.(*pb.PasswordSyncResponse); ok && syncResp.GetConflict() != nil {
+			conflict := entity.NewPasswordConflictErrorFromPB(nil, syncResp.GetConflict())
+			m.syncConflicts.WithLabelValues(string(conflict.Type())).Inc()
+		}
+	}
+
+	return resp, err
+}
@@ -0,0 +1,88 @@
+package metrics_test
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/llravell/go-pass/internal/metrics"
+	pb "github.com/llravell/go-pass/pkg/grpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func scrape(t *testing.T, handler http.Handler) string {
+	t.Helper()
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	body, err := io.ReadAll(recorder.Body)
+	require.NoError(t, err)
+
+	return string(body)
+}
+
+func TestMetrics(t *testing.T) {
+	// соединение не устанавливается, пока к базе нет запросов
+	db, err := sql.Open("pgx", "postgres://localhost:1/gopass")
+	require.NoError(t, err)
+
+	defer db.Close()
+
+	m := metrics.New(db)
+
+	call := func(method string, handler grpc.UnaryHandler) {
+		for _, interceptor := range m.UnaryServerInterceptors() {
+			next := handler
+			current := interceptor
+
+			handler = func(ctx context.Context, req any) (any, error) {
+				return current(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, next)
+			}
+		}
+
+		_, _ = handler(t.Context(), nil)
+	}
+
+	t.Run("counts sync conflicts by type", func(t *testing.T) {
+		call(pb.Passwords_Sync_FullMethodName, func(context.Context, any) (any, error) {
+			return &pb.PasswordSyncResponse{
+				Conflict: &pb.Conflict{Type: pb.ConflictType_DELETED},
+			}, nil
+		})
+
+		body := scrape(t, m.Handler())
+
+		assert.Contains(t, body, `gopass_sync_conflicts_total{type="deleted"} 1`)
+		assert.Contains(t, body, `gopass_sync_conflicts_total{type="diff"} 0`)
+	})
+
+	t.Run("counts login failures and registrations", func(t *testing.T) {
+		call(pb.Auth_Login_FullMethodName, func(context.Context, any) (any, error) {
+			return nil, status.Error(codes.Unauthenticated, "invalid login or password")
+		})
+		call(pb.Auth_Register_FullMethodName, func(context.Context, any) (any, error) {
+			return &pb.AuthResponse{}, nil
+		})
+
+		body := scrape(t, m.Handler())
+
+		assert.Contains(t, body, "gopass_login_failures_total 1")
+		assert.Contains(t, body, "gopass_registered_users_total 1")
+		assert.Contains(t, body, `grpc_server_handled_total{grpc_code="Unauthenticated",grpc_method="Login"`)
+	})
+
+	t.Run("exports db pool stats", func(t *testing.T) {
+		assert.Contains(t, scrape(t, m.Handler()), `go_sql_max_open_connections{db_name="gopass"}`)
+	})
+}
@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"strings"
+
+	"github.com/llravell/go-pass/internal/entity"
+)
+
+const selectAuditEvents = `
+	SELECT id, COALESCE(user_id, 0), login, device_id, token_id, action, entry, outcome, ip, created_at
+	FROM audit_events
+`
+
+type AuditPostgresRepository struct {
+	conn *sql.DB
+}
+
+func NewAuditPostgresRepository(conn *sql.DB) *AuditPostgresRepository {
+	return &AuditPostgresRepository{
+		conn: conn,
+	}
+}
+
+func (repo *AuditPostgresRepository) AppendAuditEvent(ctx context.Context, event *entity.AuditEvent) error {
+	var userID sql.NullInt64
+
+	if event.UserID > 0 {
+		userID = sql.NullInt64{Int64: int64(event.UserID), Valid: true}
+	}
+
+	row := repo.conn.QueryRowContext(ctx, `
+		INSERT INTO audit_events (user_id, login, device_id, token_id, action, entry, outcome, ip)
+		VALUES
+			($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, created_at;
+	`,
+		userID,
+		event.Login,
+		event.DeviceID,
+		event.TokenID,
+		event.Action,
+		event.Entry,
+		event.Outcome,
+		event.IP,
+	)
+
+	return row.Scan(&event.ID, &event.CreatedAt)
+}
+
+// GetAuditEvents возвращает страницу событий пользователя от новых к старым.
+func (repo *AuditPostgresRepository) GetAuditEvents(
+	ctx context.Context,
+	userID int,
+	beforeID int64,
+	limit int,
+) ([]*entity.AuditEvent, error) {
+	events := make([]*entity.AuditEvent, 0, limit)
+
+	err := repo.queryAuditEvents(ctx, func(event *entity.AuditEvent) error {
+		events = append(events, event)
+
+		return nil
+	}, selectAuditEvents+`
+		WHERE user_id=$1 AND ($2 = 0 OR id < $2)
+		ORDER BY id DESC
+		LIMIT $3;
+	`, userID, beforeID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// ExportAuditEvents передает события в порядке записи, не загружая весь журнал в память.
+func (repo *AuditPostgresRepository) ExportAuditEvents(
+	ctx context.Context,
+	filter entity.AuditFilter,
+	fn func(event *entity.AuditEvent) error,
+) error {
+	conditions := make([]string, 0, 3)
+	args := make([]any, 0, 3)
+
+	addCondition := func(condition string, arg any) {
+		args = append(args, arg)
+		conditions = append(conditions, condition+"$"+strconv.Itoa(len(args)))
+	}
+
+	if filter.UserID > 0 {
+		addCondition("user_id=", filter.UserID)
+	}
+
+	if !filter.Since.IsZero() {
+		addCondition("created_at >= ", filter.Since)
+	}
+
+	if !filter.Until.IsZero() {
+		addCondition("created_at < ", filter.Until)
+	}
+
+	query := selectAuditEvents
+
+	if len(conditions) > 0 {
+		query += "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	return repo.queryAuditEvents(ctx, fn, query+" ORDER BY id;", args...)
+}
+
+func (repo *AuditPostgresRepository) queryAuditEvents(
+	ctx context.Context,
+	fn func(event *entity.AuditEvent) error,
+	query string,
+	args ...any,
+) error {
+	rows, err := repo.conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var event entity.AuditEvent
+
+		err = rows.Scan(
+			&event.ID,
+			&event.UserID,
+			&event.Login,
+			&event.DeviceID,
+			&event.TokenID,
+			&event.Action,
+			&event.Entry,
+			&event.Outcome,
+			&event.IP,
+			&event.CreatedAt,
+		)
+		if err != nil {
+			return err
+		}
+
+		if err = fn(&event); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/llravell/go-pass/internal/entity"
+)
+
+type AuthAttemptsPostgresRepository struct {
+	conn *sql.DB
+}
+
+func NewAuthAttemptsPostgresRepository(conn *sql.DB) *AuthAttemptsPostgresRepository {
+	return &AuthAttemptsPostgresRepository{
+		conn: conn,
+	}
+}
+
+func (repo *AuthAttemptsPostgresRepository) GetAttempts(
+	ctx context.Context,
+	keys []string,
+) ([]*entity.AuthAttempts, error) {
+	attempts := make([]*entity.AuthAttempts, 0, len(keys))
+
+	rows, err := repo.conn.QueryContext(ctx, `
+		SELECT key, failures, COALESCE(last_failure_at, 'epoch'), COALESCE(locked_until, 'epoch')
+		FROM auth_attempts
+		WHERE key = ANY($1);
+	`, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var attempt entity.AuthAttempts
+
+		err = rows.Scan(&attempt.Key, &attempt.Failures, &attempt.LastFailureAt, &attempt.LockedUntil)
+		if err != nil {
+			return nil, err
+		}
+
+		attempts = append(attempts, &attempt)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return attempts, nil
+}
+
+func (repo *AuthAttemptsPostgresRepository) UpdateAttempts(
+	ctx context.Context,
+	key string,
+	updateFn func(attempts *entity.AuthAttempts),
+) error {
+	return runInTx(repo.conn, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO auth_attempts (key)
+			VALUES
+				($1)
+			ON CONFLICT (key) DO NOTHING;
+		`, key)
+		if err != nil {
+			return err
+		}
+
+		attempts := entity.AuthAttempts{Key: key}
+
+		row := tx.QueryRowContext(ctx, `
+			SELECT failures, COALESCE(last_failure_at, 'epoch'), COALESCE(locked_until, 'epoch')
+			FROM auth_attempts
+			WHERE key=$1
+			FOR UPDATE;
+		`, key)
+
+		err = row.Scan(&attempts.Failures, &attempts.LastFailureAt, &attempts.LockedUntil)
+		if err != nil {
+			return err
+		}
+
+		updateFn(&attempts)
+
+		_, err = tx.ExecContext(ctx, `
+			UPDATE auth_attempts
+			SET failures=$1, last_failure_at=$2, locked_until=$3
+			WHERE key=$4;
+		`, attempts.Failures, attempts.LastFailureAt, attempts.LockedUntil, key)
+
+		return err
+	})
+}
+
+func (repo *AuthAttemptsPostgresRepository) ResetAttempts(ctx context.Context, key string) error {
+	_, err := repo.conn.ExecContext(ctx, `
+		DELETE FROM auth_attempts
+		WHERE key=$1;
+	`, key)
+
+	return err
+}
@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/llravell/go-pass/internal/entity"
+)
+
+type DevicesPostgresRepository struct {
+	conn *sql.DB
+}
+
+func NewDevicesPostgresRepository(conn *sql.DB) *DevicesPostgresRepository {
+	return &DevicesPostgresRepository{
+		conn: conn,
+	}
+}
+
+func (repo *DevicesPostgresRepository) CreateDevice(
+	ctx context.Context,
+	device *entity.Device,
+) error {
+	row := repo.conn.QueryRowContext(ctx, `
+		INSERT INTO devices (user_id, name, client_version, ip)
+		VALUES
+			($1, $2, $3, $4)
+		RETURNING id, last_seen_at, created_at;
+	`, device.UserID, device.Name, device.ClientVersion, device.IP)
+
+	return row.Scan(&device.ID, &device.LastSeenAt, &device.CreatedAt)
+}
+
+func (repo *DevicesPostgresRepository) TouchDevice(
+	ctx context.Context,
+	device *entity.Device,
+) error {
+	_, err := repo.conn.ExecContext(ctx, `
+		UPDATE devices
+		SET client_version=$1, ip=$2, last_seen_at=NOW()
+		WHERE id=$3;
+	`, device.ClientVersion, device.IP, device.ID)
+
+	return err
+}
+
+func (repo *DevicesPostgresRepository) GetDevices(
+	ctx context.Context,
+	userID int,
+) ([]*entity.Device, error) {
+	devices := make([]*entity.Device, 0)
+
+	rows, err := repo.conn.QueryContext(ctx, `
+		SELECT id, user_id, name, client_version, ip, last_seen_at, created_at, is_revoked
+		FROM devices
+		WHERE user_id=$1
+		ORDER BY last_seen_at DESC;
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var device entity.Device
+
+		err = rows.Scan(
+			&device.ID,
+			&device.UserID,
+			&device.Name,
+			&device.ClientVersion,
+			&device.IP,
+			&device.LastSeenAt,
+			&device.CreatedAt,
+			&device.Revoked,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		devices = append(devices, &device)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return devices, nil
+}
+
+// RevokeDevice помечает устройство отозванным и отзывает его refresh-токены.
+func (repo *DevicesPostgresRepository) RevokeDevice(
+	ctx context.Context,
+	userID int,
+	deviceID int,
+) error {
+	return runInTx(repo.conn, func(tx *sql.Tx) error {
+		result, err := tx.ExecContext(ctx, `
+			UPDATE devices
+			SET is_revoked=TRUE, revoked_at=COALESCE(revoked_at, CURRENT_TIMESTAMP)
+			WHERE user_id=$1 AND id=$2;
+		`, userID, deviceID)
+		if err != nil {
+			return err
+		}
+
+		if err = requireAffected(result, entity.ErrDeviceNotFound); err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			UPDATE refresh_tokens
+			SET is_revoked=TRUE
+			WHERE device_id=$1;
+		`, deviceID)
+
+		return err
+	})
+}
+
+// GetRevokedDeviceIDs возвращает устройства, отозванные после since.
+func (repo *DevicesPostgresRepository) GetRevokedDeviceIDs(ctx context.Context, since time.Time) ([]int, error) {
+	ids := make([]int, 0)
+
+	rows, err := repo.conn.QueryContext(ctx, `
+		SELECT id
+		FROM devices
+		WHERE is_revoked AND revoked_at > $1;
+	`, since)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int
+
+		if err = rows.Scan(&id); err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,219 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/llravell/go-pass/internal/entity"
+)
+
+const selectEmergencyAccess = `
+	SELECT
+		e.id, e.grantor_id, g.login, e.grantee_id, c.login,
+		e.wait_period_seconds, e.status, e.requested_at, e.wrapped_key
+	FROM emergency_access e
+	JOIN users g ON g.id = e.grantor_id
+	JOIN users c ON c.id = e.grantee_id
+`
+
+type EmergencyPostgresRepository struct {
+	conn *sql.DB
+}
+
+func NewEmergencyPostgresRepository(conn *sql.DB) *EmergencyPostgresRepository {
+	return &EmergencyPostgresRepository{
+		conn: conn,
+	}
+}
+
+// StoreEmergencyAccess создает доступ или заменяет ключ и срок ожидания
+// у существующего, начатый запрос при этом сбрасывается.
+func (repo *EmergencyPostgresRepository) StoreEmergencyAccess(
+	ctx context.Context,
+	access *entity.EmergencyAccess,
+) error {
+	_, err := repo.conn.ExecContext(ctx, `
+		INSERT INTO emergency_access (grantor_id, grantee_id, wait_period_seconds, wrapped_key)
+		VALUES
+			($1, $2, $3, $4)
+		ON CONFLICT (grantor_id, grantee_id) DO UPDATE
+		SET wait_period_seconds=EXCLUDED.wait_period_seconds,
+			wrapped_key=EXCLUDED.wrapped_key,
+			status=0,
+			requested_at=NULL;
+	`, access.GrantorID, access.GranteeID, int64(access.WaitPeriod.Seconds()), access.Key)
+
+	return err
+}
+
+func (repo *EmergencyPostgresRepository) GetEmergencyAccesses(
+	ctx context.Context,
+	userID int,
+	incoming bool,
+) ([]*entity.EmergencyAccess, error) {
+	accesses := make([]*entity.EmergencyAccess, 0)
+
+	filter := "WHERE e.grantor_id=$1 ORDER BY c.login;"
+	if incoming {
+		filter = "WHERE e.grantee_id=$1 ORDER BY g.login;"
+	}
+
+	rows, err := repo.conn.QueryContext(ctx, selectEmergencyAccess+filter, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		access, err := scanEmergencyAccess(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		accesses = append(accesses, access)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return accesses, nil
+}
+
+// GetEmergencyAccessByGrantor ищет доступ, выданный пользователю владельцем с логином grantor.
+func (repo *EmergencyPostgresRepository) GetEmergencyAccessByGrantor(
+	ctx context.Context,
+	granteeID int,
+	grantor string,
+) (*entity.EmergencyAccess, error) {
+	row := repo.conn.QueryRowContext(ctx, selectEmergencyAccess+"WHERE e.grantee_id=$1 AND g.login=$2;", granteeID, grantor)
+
+	access, err := scanEmergencyAccess(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.ErrEmergencyAccessNotFound
+		}
+
+		return nil, err
+	}
+
+	return access, nil
+}
+
+func (repo *EmergencyPostgresRepository) UpdateEmergencyAccess(
+	ctx context.Context,
+	grantorID int,
+	granteeID int,
+	updateFn func(access *entity.EmergencyAccess) (*entity.EmergencyAccess, error),
+) (*entity.EmergencyAccess, error) {
+	var updatedAccess *entity.EmergencyAccess
+
+	err := runInTx(repo.conn, func(tx *sql.Tx) error {
+		row := tx.QueryRowContext(
+			ctx,
+			selectEmergencyAccess+"WHERE e.grantor_id=$1 AND e.grantee_id=$2 FOR UPDATE OF e;",
+			grantorID,
+			granteeID,
+		)
+
+		access, err := scanEmergencyAccess(row)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return entity.ErrEmergencyAccessNotFound
+			}
+
+			return err
+		}
+
+		updatedAccess, err = updateFn(access)
+		if err != nil {
+			return err
+		}
+
+		var requestedAt sql.NullTime
+
+		if !updatedAccess.RequestedAt.IsZero() {
+			requestedAt = sql.NullTime{Time: updatedAccess.RequestedAt, Valid: true}
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			UPDATE emergency_access
+			SET status=$1, requested_at=$2
+			WHERE id=$3;
+		`, updatedAccess.Status, requestedAt, updatedAccess.ID)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedAccess, nil
+}
+
+func (repo *EmergencyPostgresRepository) DeleteEmergencyAccess(
+	ctx context.Context,
+	grantorID int,
+	granteeID int,
+) error {
+	result, err := repo.conn.ExecContext(ctx, `
+		DELETE FROM emergency_access
+		WHERE grantor_id=$1 AND grantee_id=$2;
+	`, grantorID, granteeID)
+	if err != nil {
+		return err
+	}
+
+	return requireAffected(result, entity.ErrEmergencyAccessNotFound)
+}
+
+// ApproveExpiredEmergencyAccess одобряет запросы, срок ожидания которых истек к now.
+func (repo *EmergencyPostgresRepository) ApproveExpiredEmergencyAccess(
+	ctx context.Context,
+	now time.Time,
+) (int64, error) {
+	result, err := repo.conn.ExecContext(ctx, `
+		UPDATE emergency_access
+		SET status=$1
+		WHERE status=$2 AND requested_at + wait_period_seconds * INTERVAL '1 second' <= $3;
+	`, entity.EmergencyStatusApproved, entity.EmergencyStatusRequested, now)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+func scanEmergencyAccess(row rowScanner) (*entity.EmergencyAccess, error) {
+	var (
+		access      entity.EmergencyAccess
+		waitSeconds int64
+		requestedAt sql.NullTime
+	)
+
+	err := row.Scan(
+		&access.ID,
+		&access.GrantorID,
+		&access.Grantor,
+		&access.GranteeID,
+		&access.Grantee,
+		&waitSeconds,
+		&access.Status,
+		&requestedAt,
+		&access.Key,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	access.WaitPeriod = time.Duration(waitSeconds) * time.Second
+
+	if requestedAt.Valid {
+		access.RequestedAt = requestedAt.Time
+	}
+
+	return &access, nil
+}
@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/llravell/go-pass/internal/entity"
+)
+
+const selectMachineTokens = `
+	SELECT
+		t.id, t.user_id, t.name, t.collection_id, o.name || '/' || c.name,
+		t.writable, t.secret_hash, t.wrapped_key, t.expires_at,
+		t.revoked_at, t.last_used_at, t.last_used_ip, t.created_at
+	FROM machine_tokens t
+	JOIN collections c ON c.id = t.collection_id
+	JOIN organizations o ON o.id = c.org_id
+`
+
+type MachineTokensPostgresRepository struct {
+	conn *sql.DB
+}
+
+func NewMachineTokensPostgresRepository(conn *sql.DB) *MachineTokensPostgresRepository {
+	return &MachineTokensPostgresRepository{
+		conn: conn,
+	}
+}
+
+func (repo *MachineTokensPostgresRepository) CreateMachineToken(
+	ctx context.Context,
+	token *entity.MachineToken,
+) error {
+	row := repo.conn.QueryRowContext(ctx, `
+		INSERT INTO machine_tokens (user_id, name, collection_id, writable, secret_hash, wrapped_key, expires_at)
+		VALUES
+			($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at;
+	`,
+		token.UserID,
+		token.Name,
+		token.CollectionID,
+		token.Writable,
+		token.SecretHash,
+		token.WrappedKey,
+		token.ExpiresAt,
+	)
+
+	return row.Scan(&token.ID, &token.CreatedAt)
+}
+
+func (repo *MachineTokensPostgresRepository) GetMachineToken(
+	ctx context.Context,
+	tokenID int,
+) (*entity.MachineToken, error) {
+	row := repo.conn.QueryRowContext(ctx, selectMachineTokens+"WHERE t.id=$1;", tokenID)
+
+	token, err := scanMachineToken(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.ErrMachineTokenNotFound
+		}
+
+		return nil, err
+	}
+
+	return token, nil
+}
+
+func (repo *MachineTokensPostgresRepository) GetMachineTokens(
+	ctx context.Context,
+	userID int,
+) ([]*entity.MachineToken, error) {
+	tokens := make([]*entity.MachineToken, 0)
+
+	rows, err := repo.conn.QueryContext(ctx, selectMachineTokens+"WHERE t.user_id=$1 ORDER BY t.created_at;", userID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		token, err := scanMachineToken(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		tokens = append(tokens, token)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return tokens, nil
+}
+
+func (repo *MachineTokensPostgresRepository) RevokeMachineToken(
+	ctx context.Context,
+	userID int,
+	tokenID int,
+) error {
+	result, err := repo.conn.ExecContext(ctx, `
+		UPDATE machine_tokens
+		SET revoked_at=NOW()
+		WHERE id=$1 AND user_id=$2 AND revoked_at IS NULL;
+	`, tokenID, userID)
+	if err != nil {
+		return err
+	}
+
+	return requireAffected(result, entity.ErrMachineTokenNotFound)
+}
+
+func (repo *MachineTokensPostgresRepository) TouchMachineToken(
+	ctx context.Context,
+	tokenID int,
+	ip string,
+	now time.Time,
+) error {
+	_, err := repo.conn.ExecContext(ctx, `
+		UPDATE machine_tokens
+		SET last_used_at=$1, last_used_ip=$2
+		WHERE id=$3;
+	`, now, ip, tokenID)
+
+	return err
+}
+
+func scanMachineToken(row rowScanner) (*entity.MachineToken, error) {
+	var (
+		token      entity.MachineToken
+		revokedAt  sql.NullTime
+		lastUsedAt sql.NullTime
+	)
+
+	err := row.Scan(
+		&token.ID,
+		&token.UserID,
+		&token.Name,
+		&token.CollectionID,
+		&token.Collection,
+		&token.Writable,
+		&token.SecretHash,
+		&token.WrappedKey,
+		&token.ExpiresAt,
+		&revokedAt,
+		&lastUsedAt,
+		&token.LastUsedIP,
+		&token.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if revokedAt.Valid {
+		token.RevokedAt = revokedAt.Time
+	}
+
+	if lastUsedAt.Valid {
+		token.LastUsedAt = lastUsedAt.Time
+	}
+
+	return &token, nil
+}
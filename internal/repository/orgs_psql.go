@@ -0,0 +1,415 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/llravell/go-pass/internal/entity"
+)
+
+type OrgsPostgresRepository struct {
+	conn *sql.DB
+}
+
+func NewOrgsPostgresRepository(conn *sql.DB) *OrgsPostgresRepository {
+	return &OrgsPostgresRepository{
+		conn: conn,
+	}
+}
+
+// CreateOrganization создает организацию, ее создатель становится владельцем.
+func (repo *OrgsPostgresRepository) CreateOrganization(
+	ctx context.Context,
+	name string,
+	ownerID int,
+) (*entity.Organization, error) {
+	org := &entity.Organization{
+		Name: name,
+		Role: entity.OrgRoleOwner,
+	}
+
+	err := runInTx(repo.conn, func(tx *sql.Tx) error {
+		row := tx.QueryRowContext(ctx, `
+			INSERT INTO organizations (name)
+			VALUES
+				($1)
+			RETURNING id;
+		`, name)
+
+		if err := row.Scan(&org.ID); err != nil {
+			if isUniqueViolation(err) {
+				return entity.ErrOrgAlreadyExists
+			}
+
+			return err
+		}
+
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO org_members (org_id, user_id, role)
+			VALUES
+				($1, $2, $3);
+		`, org.ID, ownerID, org.Role)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return org, nil
+}
+
+func (repo *OrgsPostgresRepository) GetOrganizations(
+	ctx context.Context,
+	userID int,
+) ([]*entity.Organization, error) {
+	orgs := make([]*entity.Organization, 0)
+
+	rows, err := repo.conn.QueryContext(ctx, `
+		SELECT o.id, o.name, m.role
+		FROM organizations o
+		JOIN org_members m ON m.org_id = o.id
+		WHERE m.user_id=$1
+		ORDER BY o.name;
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var org entity.Organization
+
+		if err = rows.Scan(&org.ID, &org.Name, &org.Role); err != nil {
+			return nil, err
+		}
+
+		orgs = append(orgs, &org)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return orgs, nil
+}
+
+// GetMembership возвращает организацию с ролью пользователя в ней.
+// Чужие организации неотличимы от несуществующих.
+func (repo *OrgsPostgresRepository) GetMembership(
+	ctx context.Context,
+	orgName string,
+	userID int,
+) (*entity.Organization, error) {
+	var org entity.Organization
+
+	row := repo.conn.QueryRowContext(ctx, `
+		SELECT o.id, o.name, m.role
+		FROM organizations o
+		JOIN org_members m ON m.org_id = o.id
+		WHERE o.name=$1 AND m.user_id=$2;
+	`, orgName, userID)
+
+	err := row.Scan(&org.ID, &org.Name, &org.Role)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.ErrOrgNotFound
+		}
+
+		return nil, err
+	}
+
+	return &org, nil
+}
+
+func (repo *OrgsPostgresRepository) GetMembers(
+	ctx context.Context,
+	orgID int,
+) ([]*entity.OrgMember, error) {
+	return queryMembers(ctx, repo.conn, orgID)
+}
+
+// SetMember добавляет пользователя в организацию или меняет его роль.
+// checkFn получает состав организации внутри транзакции: изменения участников
+// одной организации выполняются по очереди.
+func (repo *OrgsPostgresRepository) SetMember(
+	ctx context.Context,
+	orgID int,
+	login string,
+	role entity.OrgRole,
+	checkFn func(members []*entity.OrgMember) error,
+) error {
+	return runInTx(repo.conn, func(tx *sql.Tx) error {
+		if err := lockMembers(ctx, tx, orgID, checkFn); err != nil {
+			return err
+		}
+
+		result, err := tx.ExecContext(ctx, `
+			INSERT INTO org_members (org_id, user_id, role)
+			SELECT $1, id, $3
+			FROM users
+			WHERE login=$2
+			ON CONFLICT (org_id, user_id) DO UPDATE SET role=EXCLUDED.role;
+		`, orgID, login, role)
+		if err != nil {
+			return err
+		}
+
+		return requireAffected(result, entity.ErrOrgMemberNotFound)
+	})
+}
+
+// RemoveMember исключает участника вместе с его копиями ключей коллекций.
+func (repo *OrgsPostgresRepository) RemoveMember(
+	ctx context.Context,
+	orgID int,
+	login string,
+	checkFn func(members []*entity.OrgMember) error,
+) error {
+	return runInTx(repo.conn, func(tx *sql.Tx) error {
+		if err := lockMembers(ctx, tx, orgID, checkFn); err != nil {
+			return err
+		}
+
+		_, err := tx.ExecContext(ctx, `
+			DELETE FROM collection_members
+			WHERE user_id=(SELECT id FROM users WHERE login=$2)
+				AND collection_id IN (SELECT id FROM collections WHERE org_id=$1);
+		`, orgID, login)
+		if err != nil {
+			return err
+		}
+
+		result, err := tx.ExecContext(ctx, `
+			DELETE FROM org_members
+			WHERE org_id=$1 AND user_id=(SELECT id FROM users WHERE login=$2);
+		`, orgID, login)
+		if err != nil {
+			return err
+		}
+
+		return requireAffected(result, entity.ErrOrgMemberNotFound)
+	})
+}
+
+// lockMembers блокирует организацию до конца транзакции и проверяет ее состав.
+func lockMembers(
+	ctx context.Context,
+	tx *sql.Tx,
+	orgID int,
+	checkFn func(members []*entity.OrgMember) error,
+) error {
+	var id int
+
+	row := tx.QueryRowContext(ctx, "SELECT id FROM organizations WHERE id=$1 FOR UPDATE;", orgID)
+
+	err := row.Scan(&id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.ErrOrgNotFound
+		}
+
+		return err
+	}
+
+	members, err := queryMembers(ctx, tx, orgID)
+	if err != nil {
+		return err
+	}
+
+	return checkFn(members)
+}
+
+type rowsQuerier interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+func queryMembers(ctx context.Context, querier rowsQuerier, orgID int) ([]*entity.OrgMember, error) {
+	members := make([]*entity.OrgMember, 0)
+
+	rows, err := querier.QueryContext(ctx, `
+		SELECT u.id, u.login, m.role
+		FROM org_members m
+		JOIN users u ON u.id = m.user_id
+		WHERE m.org_id=$1
+		ORDER BY m.role DESC, u.login;
+	`, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var member entity.OrgMember
+
+		if err = rows.Scan(&member.UserID, &member.Login, &member.Role); err != nil {
+			return nil, err
+		}
+
+		members = append(members, &member)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+// CreateCollection создает коллекцию и сохраняет ключ создателя.
+func (repo *OrgsPostgresRepository) CreateCollection(
+	ctx context.Context,
+	collection *entity.Collection,
+	creatorID int,
+) error {
+	return runInTx(repo.conn, func(tx *sql.Tx) error {
+		row := tx.QueryRowContext(ctx, `
+			INSERT INTO collections (org_id, name)
+			VALUES
+				($1, $2)
+			RETURNING id;
+		`, collection.OrgID, collection.Name)
+
+		if err := row.Scan(&collection.ID); err != nil {
+			if isUniqueViolation(err) {
+				return entity.ErrCollectionAlreadyExists
+			}
+
+			return err
+		}
+
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO collection_members (collection_id, user_id, wrapped_key)
+			VALUES
+				($1, $2, $3);
+		`, collection.ID, creatorID, collection.Key)
+
+		return err
+	})
+}
+
+// GetCollections возвращает коллекции, ключ которых выдан пользователю.
+func (repo *OrgsPostgresRepository) GetCollections(
+	ctx context.Context,
+	userID int,
+) ([]*entity.Collection, error) {
+	collections := make([]*entity.Collection, 0)
+
+	rows, err := repo.conn.QueryContext(ctx, `
+		SELECT c.id, o.id, o.name, c.name, m.role, cm.wrapped_key
+		FROM collection_members cm
+		JOIN collections c ON c.id = cm.collection_id
+		JOIN organizations o ON o.id = c.org_id
+		JOIN org_members m ON m.org_id = o.id AND m.user_id = cm.user_id
+		WHERE cm.user_id=$1
+		ORDER BY o.name, c.name;
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var collection entity.Collection
+
+		err = rows.Scan(
+			&collection.ID,
+			&collection.OrgID,
+			&collection.OrgName,
+			&collection.Name,
+			&collection.Role,
+			&collection.Key,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		collections = append(collections, &collection)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return collections, nil
+}
+
+// GetCollection возвращает коллекцию с ролью пользователя в ее организации.
+// Роль определена, только если у пользователя есть ключ коллекции.
+func (repo *OrgsPostgresRepository) GetCollection(
+	ctx context.Context,
+	userID int,
+	collectionID int,
+) (*entity.Collection, error) {
+	var collection entity.Collection
+
+	row := repo.conn.QueryRowContext(ctx, `
+		SELECT c.id, o.id, o.name, c.name, m.role, cm.wrapped_key
+		FROM collection_members cm
+		JOIN collections c ON c.id = cm.collection_id
+		JOIN organizations o ON o.id = c.org_id
+		JOIN org_members m ON m.org_id = o.id AND m.user_id = cm.user_id
+		WHERE cm.user_id=$1 AND cm.collection_id=$2;
+	`, userID, collectionID)
+
+	err := row.Scan(
+		&collection.ID,
+		&collection.OrgID,
+		&collection.OrgName,
+		&collection.Name,
+		&collection.Role,
+		&collection.Key,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.ErrCollectionNotFound
+		}
+
+		return nil, err
+	}
+
+	return &collection, nil
+}
+
+// GrantCollection выдает ключ коллекции участнику ее организации.
+func (repo *OrgsPostgresRepository) GrantCollection(
+	ctx context.Context,
+	collectionID int,
+	login string,
+	wrappedKey string,
+) error {
+	result, err := repo.conn.ExecContext(ctx, `
+		INSERT INTO collection_members (collection_id, user_id, wrapped_key)
+		SELECT c.id, u.id, $3
+		FROM collections c
+		JOIN org_members m ON m.org_id = c.org_id
+		JOIN users u ON u.id = m.user_id
+		WHERE c.id=$1 AND u.login=$2
+		ON CONFLICT (collection_id, user_id) DO UPDATE SET wrapped_key=EXCLUDED.wrapped_key;
+	`, collectionID, login, wrappedKey)
+	if err != nil {
+		return err
+	}
+
+	return requireAffected(result, entity.ErrOrgMemberNotFound)
+}
+
+func (repo *OrgsPostgresRepository) RevokeCollection(
+	ctx context.Context,
+	collectionID int,
+	login string,
+) error {
+	result, err := repo.conn.ExecContext(ctx, `
+		DELETE FROM collection_members
+		WHERE collection_id=$1 AND user_id=(SELECT id FROM users WHERE login=$2);
+	`, collectionID, login)
+	if err != nil {
+		return err
+	}
+
+	return requireAffected(result, entity.ErrOrgMemberNotFound)
+}
@@ -8,6 +8,10 @@ import (
 	"github.com/llravell/go-pass/internal/entity"
 )
 
+// scopeFilter выбирает личные записи пользователя ($1 = 0, $2 = user_id)
+// или записи коллекции ($1 = collection_id).
+const scopeFilter = "COALESCE(collection_id, 0)=$1 AND (collection_id IS NOT NULL OR user_id=$2)"
+
 type PasswordsPostgresRepository struct {
 	conn *sql.DB
 }
@@ -21,14 +25,15 @@ func NewPasswordsPostgresRepository(conn *sql.DB) *PasswordsPostgresRepository {
 func (repo *PasswordsPostgresRepository) GetPasswords(
 	ctx context.Context,
 	userID int,
+	collectionID int,
 ) ([]*entity.Password, error) {
 	passwords := make([]*entity.Password, 0)
 
 	rows, err := repo.conn.QueryContext(ctx, `
 		SELECT name, encrypted_pass, meta, version
 		FROM passwords
-		WHERE user_id=$1 AND NOT is_deleted;
-	`, userID)
+		WHERE `+scopeFilter+` AND NOT is_deleted;
+	`, collectionID, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -43,6 +48,8 @@ func (repo *PasswordsPostgresRepository) GetPasswords(
 			return nil, err
 		}
 
+		password.CollectionID = collectionID
+
 		passwords = append(passwords, &password)
 	}
 
@@ -57,33 +64,80 @@ func (repo *PasswordsPostgresRepository) GetPasswords(
 	return passwords, nil
 }
 
-func (repo *PasswordsPostgresRepository) AddNewPassword(
+// checkEntriesQuota блокирует строку владельца области — пользователя для личных
+// записей или коллекцию — и передает checkFn число живых записей в ней.
+// Блокировка не дает параллельным вставкам обойти квоту до конца транзакции,
+// удаленные записи в квоту не входят.
+func checkEntriesQuota(
 	ctx context.Context,
+	tx *sql.Tx,
 	userID int,
-	password *entity.Password,
+	collectionID int,
+	checkFn func(count int) error,
 ) error {
-	_, err := repo.conn.ExecContext(ctx, `
-		INSERT INTO passwords (name, encrypted_pass, meta, version, user_id)
-		VALUES
-			($1, $2, $3, $4, $5);
-	`, password.Name, password.Value, password.Meta, password.Version, userID)
+	var err error
+
+	if collectionID != 0 {
+		_, err = tx.ExecContext(ctx, `SELECT id FROM collections WHERE id=$1 FOR UPDATE;`, collectionID)
+	} else {
+		_, err = tx.ExecContext(ctx, `SELECT id FROM users WHERE id=$1 FOR UPDATE;`, userID)
+	}
+
 	if err != nil {
 		return err
 	}
 
-	return nil
+	var count int
+
+	err = tx.QueryRowContext(ctx, `
+		SELECT count(*)
+		FROM passwords
+		WHERE `+scopeFilter+` AND NOT is_deleted;
+	`, collectionID, userID).Scan(&count)
+	if err != nil {
+		return err
+	}
+
+	return checkFn(count)
+}
+
+// AddNewPassword добавляет запись. Если checkFn задан, он получает число живых
+// записей области и может отменить вставку, счет и вставка идут в одной транзакции.
+func (repo *PasswordsPostgresRepository) AddNewPassword(
+	ctx context.Context,
+	userID int,
+	password *entity.Password,
+	checkFn func(count int) error,
+) error {
+	return runInTx(repo.conn, func(tx *sql.Tx) error {
+		if checkFn != nil {
+			err := checkEntriesQuota(ctx, tx, userID, password.CollectionID, checkFn)
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO passwords (name, encrypted_pass, meta, version, user_id, collection_id)
+			VALUES
+				($1, $2, $3, $4, $5, NULLIF($6, 0));
+		`, password.Name, password.Value, password.Meta, password.Version, userID, password.CollectionID)
+
+		return err
+	})
 }
 
 func (repo *PasswordsPostgresRepository) DeletePasswordByName(
 	ctx context.Context,
 	userID int,
+	collectionID int,
 	name string,
 ) error {
 	_, err := repo.conn.ExecContext(ctx, `
 		UPDATE passwords
 		SET is_deleted=TRUE
-		WHERE user_id=$1 AND name=$2;
-	`, userID, name)
+		WHERE `+scopeFilter+` AND name=$3;
+	`, collectionID, userID, name)
 	if err != nil {
 		return err
 	}
@@ -91,23 +145,31 @@ func (repo *PasswordsPostgresRepository) DeletePasswordByName(
 	return nil
 }
 
+// UpdateByName меняет запись через updateFn под блокировкой строки. Если
+// updateFn восстанавливает удаленную запись, она снова входит в квоту,
+// и checkFn проверяет ее так же, как при AddNewPassword.
 func (repo *PasswordsPostgresRepository) UpdateByName(
 	ctx context.Context,
 	userID int,
+	collectionID int,
 	name string,
 	updateFn func(password *entity.Password) (*entity.Password, error),
+	checkFn func(count int) error,
 ) error {
 	return runInTx(repo.conn, func(tx *sql.Tx) error {
-		var pass entity.Password
+		var (
+			passID int
+			pass   entity.Password
+		)
 
 		row := tx.QueryRowContext(ctx, `
-			SELECT name, encrypted_pass, meta, version, is_deleted
+			SELECT id, name, encrypted_pass, meta, version, is_deleted
 			FROM passwords
-			WHERE user_id=$1 AND name=$2
+			WHERE `+scopeFilter+` AND name=$3
 			FOR UPDATE;
-		`, userID, name)
+		`, collectionID, userID, name)
 
-		err := row.Scan(&pass.Name, &pass.Value, &pass.Meta, &pass.Version, &pass.Deleted)
+		err := row.Scan(&passID, &pass.Name, &pass.Value, &pass.Meta, &pass.Version, &pass.Deleted)
 		if err != nil {
 			if errors.Is(err, sql.ErrNoRows) {
 				return entity.ErrPasswordDoesNotExist
@@ -116,6 +178,8 @@ func (repo *PasswordsPostgresRepository) UpdateByName(
 			return err
 		}
 
+		pass.CollectionID = collectionID
+
 		updatedPass, err := updateFn(&pass)
 		if err != nil {
 			return err
@@ -125,11 +189,18 @@ func (repo *PasswordsPostgresRepository) UpdateByName(
 			return nil
 		}
 
+		if pass.Deleted && !updatedPass.Deleted && checkFn != nil {
+			err = checkEntriesQuota(ctx, tx, userID, collectionID, checkFn)
+			if err != nil {
+				return err
+			}
+		}
+
 		_, err = tx.ExecContext(ctx, `
 			UPDATE passwords
 			SET encrypted_pass=$1, meta=$2, version=$3, is_deleted=$4
-			WHERE user_id=$5 AND name=$6;
-		`, updatedPass.Value, updatedPass.Meta, updatedPass.Version, updatedPass.Deleted, userID, pass.Name)
+			WHERE id=$5;
+		`, updatedPass.Value, updatedPass.Meta, updatedPass.Version, updatedPass.Deleted, passID)
 		if err != nil {
 			return err
 		}
@@ -192,3 +192,27 @@ func (repo *PasswordsSqliteRepository) DeletePasswordSoft(
 
 	return nil
 }
+
+// WipePasswords удаляет все пароли, включая чужие расшаренные, с затиранием освобожденных страниц
+// и пересобирает файл базы, чтобы шифртексты не остались на диске.
+func (repo *PasswordsSqliteRepository) WipePasswords(ctx context.Context) error {
+	conn, err := repo.conn.Conn(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer conn.Close()
+
+	for _, query := range []string{
+		"PRAGMA secure_delete=ON;",
+		"DELETE FROM passwords;",
+		"DELETE FROM shared_passwords;",
+		"VACUUM;",
+	} {
+		if _, err = conn.ExecContext(ctx, query); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
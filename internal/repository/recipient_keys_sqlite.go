@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+)
+
+// RecipientKeysSqliteRepository хранит закрепленные открытые ключи других пользователей.
+type RecipientKeysSqliteRepository struct {
+	conn *sql.DB
+}
+
+func NewRecipientKeysSqliteRepository(conn *sql.DB) *RecipientKeysSqliteRepository {
+	return &RecipientKeysSqliteRepository{
+		conn: conn,
+	}
+}
+
+// GetRecipientKey возвращает закрепленный ключ или пустую строку, если ключа еще нет.
+func (repo *RecipientKeysSqliteRepository) GetRecipientKey(ctx context.Context, login string) (string, error) {
+	var publicKey string
+
+	row := repo.conn.QueryRowContext(ctx, "SELECT public_key FROM recipient_keys WHERE login=?", login)
+
+	err := row.Scan(&publicKey)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return "", err
+	}
+
+	return publicKey, nil
+}
+
+// PinRecipientKey закрепляет ключ, уже закрепленный ключ не перезаписывается.
+func (repo *RecipientKeysSqliteRepository) PinRecipientKey(
+	ctx context.Context,
+	login string,
+	publicKey string,
+) error {
+	_, err := repo.conn.ExecContext(ctx, `
+		INSERT INTO recipient_keys (login, public_key)
+		VALUES (?, ?)
+		ON CONFLICT (login) DO NOTHING;
+	`, login, publicKey)
+
+	return err
+}
+
+func (repo *RecipientKeysSqliteRepository) DeleteRecipientKey(ctx context.Context, login string) error {
+	_, err := repo.conn.ExecContext(ctx, "DELETE FROM recipient_keys WHERE login=?", login)
+
+	return err
+}
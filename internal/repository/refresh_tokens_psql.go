@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/llravell/go-pass/internal/entity"
+)
+
+type RefreshTokensPostgresRepository struct {
+	conn *sql.DB
+}
+
+func NewRefreshTokensPostgresRepository(conn *sql.DB) *RefreshTokensPostgresRepository {
+	return &RefreshTokensPostgresRepository{
+		conn: conn,
+	}
+}
+
+func (repo *RefreshTokensPostgresRepository) StoreRefreshToken(
+	ctx context.Context,
+	token *entity.RefreshToken,
+) error {
+	_, err := repo.conn.ExecContext(ctx, `
+		INSERT INTO refresh_tokens (token_hash, family_id, user_id, device_id, expires_at)
+		VALUES
+			($1, $2, $3, $4, $5);
+	`, token.Hash, token.FamilyID, token.UserID, token.DeviceID, token.ExpiresAt)
+
+	return err
+}
+
+func (repo *RefreshTokensPostgresRepository) FindRefreshToken(
+	ctx context.Context,
+	hash string,
+) (*entity.RefreshToken, error) {
+	var token entity.RefreshToken
+
+	row := repo.conn.QueryRowContext(ctx, `
+		SELECT token_hash, family_id, user_id, COALESCE(device_id, 0), expires_at, is_used, is_revoked
+		FROM refresh_tokens
+		WHERE token_hash=$1;
+	`, hash)
+
+	err := row.Scan(
+		&token.Hash,
+		&token.FamilyID,
+		&token.UserID,
+		&token.DeviceID,
+		&token.ExpiresAt,
+		&token.Used,
+		&token.Revoked,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.ErrRefreshTokenNotFound
+		}
+
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+func (repo *RefreshTokensPostgresRepository) RotateRefreshToken(
+	ctx context.Context,
+	hash string,
+	rotateFn func(token *entity.RefreshToken) (*entity.RefreshToken, error),
+) error {
+	return runInTx(repo.conn, func(tx *sql.Tx) error {
+		var token entity.RefreshToken
+
+		row := tx.QueryRowContext(ctx, `
+			SELECT token_hash, family_id, user_id, COALESCE(device_id, 0), expires_at, is_used, is_revoked
+			FROM refresh_tokens
+			WHERE token_hash=$1
+			FOR UPDATE;
+		`, hash)
+
+		err := row.Scan(
+			&token.Hash,
+			&token.FamilyID,
+			&token.UserID,
+			&token.DeviceID,
+			&token.ExpiresAt,
+			&token.Used,
+			&token.Revoked,
+		)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return entity.ErrRefreshTokenNotFound
+			}
+
+			return err
+		}
+
+		nextToken, err := rotateFn(&token)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			UPDATE refresh_tokens
+			SET is_used=TRUE
+			WHERE token_hash=$1;
+		`, token.Hash)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO refresh_tokens (token_hash, family_id, user_id, device_id, expires_at)
+			VALUES
+				($1, $2, $3, $4, $5);
+		`, nextToken.Hash, nextToken.FamilyID, nextToken.UserID, nextToken.DeviceID, nextToken.ExpiresAt)
+
+		return err
+	})
+}
+
+func (repo *RefreshTokensPostgresRepository) RevokeRefreshTokenFamily(
+	ctx context.Context,
+	familyID string,
+) error {
+	_, err := repo.conn.ExecContext(ctx, `
+		UPDATE refresh_tokens
+		SET is_revoked=TRUE
+		WHERE family_id=$1;
+	`, familyID)
+
+	return err
+}
+
+func (repo *RefreshTokensPostgresRepository) DeleteExpiredRefreshTokens(
+	ctx context.Context,
+	before time.Time,
+) (int64, error) {
+	result, err := repo.conn.ExecContext(ctx, `
+		DELETE FROM refresh_tokens
+		WHERE expires_at <= $1;
+	`, before)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/llravell/go-pass/internal/entity"
+)
+
+type SendsPostgresRepository struct {
+	conn *sql.DB
+}
+
+func NewSendsPostgresRepository(conn *sql.DB) *SendsPostgresRepository {
+	return &SendsPostgresRepository{
+		conn: conn,
+	}
+}
+
+func (repo *SendsPostgresRepository) CreateSend(ctx context.Context, send *entity.Send) error {
+	row := repo.conn.QueryRowContext(ctx, `
+		INSERT INTO sends (id, user_id, ciphertext, max_views, expires_at)
+		VALUES
+			($1, $2, $3, $4, $5)
+		RETURNING created_at;
+	`, send.ID, send.UserID, send.Ciphertext, send.MaxViews, send.ExpiresAt)
+
+	return row.Scan(&send.CreatedAt)
+}
+
+// ConsumeSend засчитывает просмотр и отдает шифротекст. Последний
+// просмотр удаляет запись, истекшие записи считаются несуществующими.
+func (repo *SendsPostgresRepository) ConsumeSend(
+	ctx context.Context,
+	id string,
+	now time.Time,
+) (*entity.Send, error) {
+	var send entity.Send
+
+	err := runInTx(repo.conn, func(tx *sql.Tx) error {
+		row := tx.QueryRowContext(ctx, `
+			SELECT id, user_id, ciphertext, views, max_views, expires_at, created_at
+			FROM sends
+			WHERE id=$1 AND expires_at > $2
+			FOR UPDATE;
+		`, id, now)
+
+		err := row.Scan(
+			&send.ID,
+			&send.UserID,
+			&send.Ciphertext,
+			&send.Views,
+			&send.MaxViews,
+			&send.ExpiresAt,
+			&send.CreatedAt,
+		)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return entity.ErrSendNotFound
+			}
+
+			return err
+		}
+
+		send.Views++
+
+		if send.Views >= send.MaxViews {
+			_, err = tx.ExecContext(ctx, `DELETE FROM sends WHERE id=$1;`, id)
+		} else {
+			_, err = tx.ExecContext(ctx, `UPDATE sends SET views=$1 WHERE id=$2;`, send.Views, id)
+		}
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &send, nil
+}
+
+func (repo *SendsPostgresRepository) GetSends(
+	ctx context.Context,
+	userID int,
+	now time.Time,
+) ([]*entity.Send, error) {
+	sends := make([]*entity.Send, 0)
+
+	rows, err := repo.conn.QueryContext(ctx, `
+		SELECT id, views, max_views, expires_at, created_at
+		FROM sends
+		WHERE user_id=$1 AND expires_at > $2
+		ORDER BY created_at;
+	`, userID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		send := entity.Send{UserID: userID}
+
+		err = rows.Scan(&send.ID, &send.Views, &send.MaxViews, &send.ExpiresAt, &send.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		sends = append(sends, &send)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return sends, nil
+}
+
+func (repo *SendsPostgresRepository) DeleteSend(ctx context.Context, userID int, id string) error {
+	result, err := repo.conn.ExecContext(ctx, `
+		DELETE FROM sends
+		WHERE id=$1 AND user_id=$2;
+	`, id, userID)
+	if err != nil {
+		return err
+	}
+
+	return requireAffected(result, entity.ErrSendNotFound)
+}
+
+func (repo *SendsPostgresRepository) DeleteExpiredSends(ctx context.Context, now time.Time) (int64, error) {
+	result, err := repo.conn.ExecContext(ctx, `
+		DELETE FROM sends
+		WHERE expires_at <= $1;
+	`, now)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
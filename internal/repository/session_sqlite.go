@@ -4,14 +4,20 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/llravell/go-pass/internal/entity"
 )
 
 const (
-	loginKey      = "login"
-	masterPassKey = "master_password"
-	authTokenKey  = "auth_token"
+	loginKey        = "login"
+	masterPassKey   = "master_password"
+	authTokenKey    = "auth_token"
+	refreshTokenKey = "refresh_token"
+	serverPinKey    = "server_pin"
+
+	sessionLockTimeout = 30 * time.Second
 )
 
 type SessionSqliteRepository struct {
@@ -50,6 +56,20 @@ func (repo *SessionSqliteRepository) GetSession(
 		return nil, err
 	}
 
+	refreshTokenRow := repo.conn.QueryRowContext(ctx, "SELECT value FROM session WHERE key=?", refreshTokenKey)
+
+	err = refreshTokenRow.Scan(&session.RefreshToken)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	serverPinRow := repo.conn.QueryRowContext(ctx, "SELECT value FROM session WHERE key=?", serverPinKey)
+
+	err = serverPinRow.Scan(&session.ServerPin)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
 	return &session, nil
 }
 
@@ -60,6 +80,8 @@ func (repo *SessionSqliteRepository) SetSession(
 	_, err := repo.conn.ExecContext(ctx, `
 		INSERT OR REPLACE INTO session (key, value)
 		VALUES
+			(?, ?),
+			(?, ?),
 			(?, ?),
 			(?, ?),
 			(?, ?);
@@ -67,7 +89,94 @@ func (repo *SessionSqliteRepository) SetSession(
 		loginKey, session.Login,
 		masterPassKey, session.MasterPassHash,
 		authTokenKey, session.AuthToken,
+		refreshTokenKey, session.RefreshToken,
+		serverPinKey, session.ServerPin,
+	)
+
+	return err
+}
+
+func (repo *SessionSqliteRepository) SetServerPin(
+	ctx context.Context,
+	pin string,
+) error {
+	_, err := repo.conn.ExecContext(ctx, `
+		INSERT OR REPLACE INTO session (key, value)
+		VALUES
+			(?, ?);
+	`, serverPinKey, pin)
+
+	return err
+}
+
+// UpdateTokens заменяет пару токенов под блокировкой базы на запись (BEGIN IMMEDIATE).
+// Сессию делят несколько процессов gopass (credential helper'ы и интерактивные
+// команды), блокировка не дает им одновременно предъявить один refresh-токен:
+// второй процесс дождется конца обмена и увидит уже новую пару.
+func (repo *SessionSqliteRepository) UpdateTokens(
+	ctx context.Context,
+	updateFn func(authToken, refreshToken string) (string, string, error),
+) (err error) {
+	conn, err := repo.conn.Conn(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer conn.Close()
+
+	// без таймаута второй процесс сразу получил бы SQLITE_BUSY вместо ожидания
+	if _, err = conn.ExecContext(ctx, fmt.Sprintf("PRAGMA busy_timeout = %d;", sessionLockTimeout.Milliseconds())); err != nil {
+		return err
+	}
+
+	if _, err = conn.ExecContext(ctx, "BEGIN IMMEDIATE;"); err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			_, _ = conn.ExecContext(context.WithoutCancel(ctx), "ROLLBACK;")
+		}
+	}()
+
+	var authToken, refreshToken string
+
+	for key, value := range map[string]*string{authTokenKey: &authToken, refreshTokenKey: &refreshToken} {
+		err = conn.QueryRowContext(ctx, "SELECT value FROM session WHERE key=?", key).Scan(value)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+	}
+
+	nextAuthToken, nextRefreshToken, err := updateFn(authToken, refreshToken)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.ExecContext(ctx, `
+		INSERT OR REPLACE INTO session (key, value)
+		VALUES
+			(?, ?),
+			(?, ?);
+	`,
+		authTokenKey, nextAuthToken,
+		refreshTokenKey, nextRefreshToken,
 	)
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.ExecContext(ctx, "COMMIT;")
+
+	return err
+}
+
+// ClearSession удаляет данные входа, закрепленный ключ сервера сохраняется.
+func (repo *SessionSqliteRepository) ClearSession(ctx context.Context) error {
+	_, err := repo.conn.ExecContext(ctx, `
+		DELETE FROM session
+		WHERE key != ?;
+	`, serverPinKey)
 
 	return err
 }
@@ -0,0 +1,113 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/llravell/go-pass/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	_ "modernc.org/sqlite"
+)
+
+func openSessionDB(t *testing.T, path string) *sql.DB {
+	t.Helper()
+
+	db, err := sql.Open("sqlite", path)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { db.Close() })
+
+	_, err = db.Exec(`
+		CREATE TABLE IF NOT EXISTS session (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			key TEXT NOT NULL UNIQUE,
+			value TEXT NOT NULL
+		);
+	`)
+	require.NoError(t, err)
+
+	return db
+}
+
+func TestSessionSqliteRepositoryUpdateTokens(t *testing.T) {
+	t.Run("serializes refresh between processes", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "pass.db")
+
+		// у каждого процесса gopass свое подключение к файлу сессии
+		first := repository.NewSessionSqliteRepository(openSessionDB(t, path))
+		second := repository.NewSessionSqliteRepository(openSessionDB(t, path))
+
+		require.NoError(t, first.UpdateTokens(context.Background(), func(string, string) (string, string, error) {
+			return "access-0", "refresh-0", nil
+		}))
+
+		var (
+			inside    atomic.Int32
+			overlaps  atomic.Int32
+			exchanges atomic.Int32
+			wg        sync.WaitGroup
+		)
+
+		refresh := func(authToken, refreshToken string) (string, string, error) {
+			if inside.Add(1) > 1 {
+				overlaps.Add(1)
+			}
+			defer inside.Add(-1)
+
+			// второй процесс должен увидеть уже обновленную пару
+			if authToken != "access-0" {
+				return authToken, refreshToken, nil
+			}
+
+			time.Sleep(50 * time.Millisecond)
+
+			n := exchanges.Add(1)
+
+			return fmt.Sprintf("access-%d", n), fmt.Sprintf("refresh-%d", n), nil
+		}
+
+		for _, repo := range []*repository.SessionSqliteRepository{first, second} {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				assert.NoError(t, repo.UpdateTokens(context.Background(), refresh))
+			}()
+		}
+
+		wg.Wait()
+
+		assert.Zero(t, overlaps.Load())
+		assert.Equal(t, int32(1), exchanges.Load())
+
+		session, err := second.GetSession(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "access-1", session.AuthToken)
+		assert.Equal(t, "refresh-1", session.RefreshToken)
+	})
+
+	t.Run("keeps tokens on error", func(t *testing.T) {
+		repo := repository.NewSessionSqliteRepository(openSessionDB(t, filepath.Join(t.TempDir(), "pass.db")))
+
+		require.NoError(t, repo.UpdateTokens(context.Background(), func(string, string) (string, string, error) {
+			return "access", "refresh", nil
+		}))
+
+		err := repo.UpdateTokens(context.Background(), func(string, string) (string, string, error) {
+			return "", "", assert.AnError
+		})
+		require.ErrorIs(t, err, assert.AnError)
+
+		session, err := repo.GetSession(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "refresh", session.RefreshToken)
+	})
+}
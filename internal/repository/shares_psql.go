@@ -0,0 +1,260 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/llravell/go-pass/internal/entity"
+)
+
+const selectShares = `
+	SELECT
+		s.id, s.name, s.owner_id, o.login, s.recipient_id, r.login,
+		s.encrypted_pass, s.meta, s.version, s.source_version,
+		s.is_recipient_modified, s.permission, s.owner_key, s.recipient_key
+	FROM shares s
+	JOIN users o ON o.id = s.owner_id
+	JOIN users r ON r.id = s.recipient_id
+`
+
+type SharesPostgresRepository struct {
+	conn *sql.DB
+}
+
+func NewSharesPostgresRepository(conn *sql.DB) *SharesPostgresRepository {
+	return &SharesPostgresRepository{
+		conn: conn,
+	}
+}
+
+func (repo *SharesPostgresRepository) SetKeyPair(
+	ctx context.Context,
+	userID int,
+	keyPair *entity.UserKeyPair,
+) error {
+	result, err := repo.conn.ExecContext(ctx, `
+		INSERT INTO user_keys (user_id, public_key, encrypted_private_key)
+		VALUES
+			($1, $2, $3)
+		ON CONFLICT (user_id) DO NOTHING;
+	`, userID, keyPair.PublicKey, keyPair.EncryptedPrivateKey)
+	if err != nil {
+		return err
+	}
+
+	return requireAffected(result, entity.ErrKeyPairAlreadyExists)
+}
+
+func (repo *SharesPostgresRepository) GetKeyPair(
+	ctx context.Context,
+	userID int,
+) (*entity.UserKeyPair, error) {
+	var keyPair entity.UserKeyPair
+
+	row := repo.conn.QueryRowContext(ctx, `
+		SELECT public_key, encrypted_private_key
+		FROM user_keys
+		WHERE user_id=$1;
+	`, userID)
+
+	err := row.Scan(&keyPair.PublicKey, &keyPair.EncryptedPrivateKey)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.ErrKeyPairNotFound
+		}
+
+		return nil, err
+	}
+
+	return &keyPair, nil
+}
+
+// GetPublicKeyByLogin возвращает id пользователя и его открытый ключ.
+func (repo *SharesPostgresRepository) GetPublicKeyByLogin(
+	ctx context.Context,
+	login string,
+) (int, string, error) {
+	var (
+		userID    int
+		publicKey string
+	)
+
+	row := repo.conn.QueryRowContext(ctx, `
+		SELECT u.id, k.public_key
+		FROM users u
+		JOIN user_keys k ON k.user_id = u.id
+		WHERE u.login=$1;
+	`, login)
+
+	err := row.Scan(&userID, &publicKey)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, "", entity.ErrRecipientNotFound
+		}
+
+		return 0, "", err
+	}
+
+	return userID, publicKey, nil
+}
+
+func (repo *SharesPostgresRepository) CreateShare(
+	ctx context.Context,
+	share *entity.Share,
+) error {
+	row := repo.conn.QueryRowContext(ctx, `
+		INSERT INTO shares (
+			owner_id, recipient_id, name, encrypted_pass, meta,
+			version, source_version, permission, owner_key, recipient_key
+		)
+		VALUES
+			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id;
+	`,
+		share.OwnerID,
+		share.RecipientID,
+		share.Name,
+		share.Value,
+		share.Meta,
+		share.Version,
+		share.SourceVersion,
+		share.Permission,
+		share.OwnerKey,
+		share.RecipientKey,
+	)
+
+	err := row.Scan(&share.ID)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return entity.ErrShareAlreadyExists
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// GetShares возвращает записи, которыми поделился пользователь (outgoing)
+// или которыми поделились с ним.
+func (repo *SharesPostgresRepository) GetShares(
+	ctx context.Context,
+	userID int,
+	outgoing bool,
+) ([]*entity.Share, error) {
+	shares := make([]*entity.Share, 0)
+
+	filter := "WHERE s.recipient_id=$1 ORDER BY o.login, s.name;"
+	if outgoing {
+		filter = "WHERE s.owner_id=$1 ORDER BY s.name, r.login;"
+	}
+
+	rows, err := repo.conn.QueryContext(ctx, selectShares+filter, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		share, err := scanShare(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		shares = append(shares, share)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return shares, nil
+}
+
+func (repo *SharesPostgresRepository) UpdateShare(
+	ctx context.Context,
+	shareID int,
+	updateFn func(share *entity.Share) (*entity.Share, error),
+) error {
+	return runInTx(repo.conn, func(tx *sql.Tx) error {
+		row := tx.QueryRowContext(ctx, selectShares+"WHERE s.id=$1 FOR UPDATE OF s;", shareID)
+
+		share, err := scanShare(row)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return entity.ErrShareNotFound
+			}
+
+			return err
+		}
+
+		updatedShare, err := updateFn(share)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			UPDATE shares
+			SET encrypted_pass=$1, meta=$2, version=$3, source_version=$4, is_recipient_modified=$5
+			WHERE id=$6;
+		`,
+			updatedShare.Value,
+			updatedShare.Meta,
+			updatedShare.Version,
+			updatedShare.SourceVersion,
+			updatedShare.RecipientModified,
+			shareID,
+		)
+
+		return err
+	})
+}
+
+// DeleteShare удаляет запись, отозвать ее может как владелец, так и получатель.
+func (repo *SharesPostgresRepository) DeleteShare(
+	ctx context.Context,
+	userID int,
+	shareID int,
+) error {
+	result, err := repo.conn.ExecContext(ctx, `
+		DELETE FROM shares
+		WHERE id=$1 AND (owner_id=$2 OR recipient_id=$2);
+	`, shareID, userID)
+	if err != nil {
+		return err
+	}
+
+	return requireAffected(result, entity.ErrShareNotFound)
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanShare(row rowScanner) (*entity.Share, error) {
+	var share entity.Share
+
+	err := row.Scan(
+		&share.ID,
+		&share.Name,
+		&share.OwnerID,
+		&share.Owner,
+		&share.RecipientID,
+		&share.Recipient,
+		&share.Value,
+		&share.Meta,
+		&share.Version,
+		&share.SourceVersion,
+		&share.RecipientModified,
+		&share.Permission,
+		&share.OwnerKey,
+		&share.RecipientKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &share, nil
+}
@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/llravell/go-pass/internal/entity"
+)
+
+// SharesSqliteRepository хранит записи, которыми поделились с пользователем.
+// Значения перешифрованы ключом хранилища, чтобы читать их без сети.
+type SharesSqliteRepository struct {
+	conn *sql.DB
+}
+
+func NewSharesSqliteRepository(conn *sql.DB) *SharesSqliteRepository {
+	return &SharesSqliteRepository{
+		conn: conn,
+	}
+}
+
+func (repo *SharesSqliteRepository) GetShares(ctx context.Context) ([]*entity.Share, error) {
+	shares := make([]*entity.Share, 0)
+
+	rows, err := repo.conn.QueryContext(ctx, `
+		SELECT id, name, owner, encrypted_pass, meta, version, permission, share_key
+		FROM shared_passwords
+		ORDER BY owner, name;
+	`)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		share, err := scanLocalShare(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		shares = append(shares, share)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return shares, nil
+}
+
+func (repo *SharesSqliteRepository) GetShare(
+	ctx context.Context,
+	owner string,
+	name string,
+) (*entity.Share, error) {
+	row := repo.conn.QueryRowContext(ctx, `
+		SELECT id, name, owner, encrypted_pass, meta, version, permission, share_key
+		FROM shared_passwords
+		WHERE owner=? AND name=?;
+	`, owner, name)
+
+	share, err := scanLocalShare(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.ErrShareNotFound
+		}
+
+		return nil, err
+	}
+
+	return share, nil
+}
+
+// ReplaceShares заменяет локальную копию списком с сервера:
+// отозванные записи при этом исчезают.
+func (repo *SharesSqliteRepository) ReplaceShares(
+	ctx context.Context,
+	shares []*entity.Share,
+) error {
+	return runInTx(repo.conn, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM shared_passwords;"); err != nil {
+			return err
+		}
+
+		for _, share := range shares {
+			if err := insertLocalShare(ctx, tx, share); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (repo *SharesSqliteRepository) UpdateShare(
+	ctx context.Context,
+	share *entity.Share,
+) error {
+	_, err := repo.conn.ExecContext(ctx, `
+		UPDATE shared_passwords
+		SET encrypted_pass=?, meta=?, version=?
+		WHERE id=?;
+	`, share.Value, share.Meta, share.Version, share.ID)
+
+	return err
+}
+
+func (repo *SharesSqliteRepository) DeleteShare(ctx context.Context, shareID int) error {
+	_, err := repo.conn.ExecContext(ctx, `
+		DELETE FROM shared_passwords
+		WHERE id=?;
+	`, shareID)
+
+	return err
+}
+
+func insertLocalShare(ctx context.Context, tx *sql.Tx, share *entity.Share) error {
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO shared_passwords (id, name, owner, encrypted_pass, meta, version, permission, share_key)
+		VALUES
+			(?, ?, ?, ?, ?, ?, ?, ?);
+	`, share.ID, share.Name, share.Owner, share.Value, share.Meta, share.Version, share.Permission, share.Key)
+
+	return err
+}
+
+func scanLocalShare(row rowScanner) (*entity.Share, error) {
+	var share entity.Share
+
+	err := row.Scan(
+		&share.ID,
+		&share.Name,
+		&share.Owner,
+		&share.Value,
+		&share.Meta,
+		&share.Version,
+		&share.Permission,
+		&share.Key,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &share, nil
+}
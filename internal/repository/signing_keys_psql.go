@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/llravell/go-pass/internal/entity"
+)
+
+type SigningKeysPostgresRepository struct {
+	conn *sql.DB
+}
+
+func NewSigningKeysPostgresRepository(conn *sql.DB) *SigningKeysPostgresRepository {
+	return &SigningKeysPostgresRepository{
+		conn: conn,
+	}
+}
+
+// GetSigningKeys возвращает ключи от старых к новым.
+func (repo *SigningKeysPostgresRepository) GetSigningKeys(ctx context.Context) ([]*entity.SigningKey, error) {
+	keys := make([]*entity.SigningKey, 0)
+
+	rows, err := repo.conn.QueryContext(ctx, `
+		SELECT kid, algorithm, private_key, created_at
+		FROM signing_keys
+		ORDER BY created_at ASC;
+	`)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var key entity.SigningKey
+
+		err = rows.Scan(&key.ID, &key.Algorithm, &key.PrivateKey, &key.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, &key)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+func (repo *SigningKeysPostgresRepository) StoreSigningKey(
+	ctx context.Context,
+	key *entity.SigningKey,
+) error {
+	_, err := repo.conn.ExecContext(ctx, `
+		INSERT INTO signing_keys (kid, algorithm, private_key, created_at)
+		VALUES
+			($1, $2, $3, $4);
+	`, key.ID, key.Algorithm, key.PrivateKey, key.CreatedAt)
+
+	return err
+}
+
+func (repo *SigningKeysPostgresRepository) DeleteSigningKeys(
+	ctx context.Context,
+	ids []string,
+) error {
+	_, err := repo.conn.ExecContext(ctx, `
+		DELETE FROM signing_keys
+		WHERE kid = ANY($1);
+	`, ids)
+
+	return err
+}
@@ -48,16 +48,168 @@ func (r *UsersRepository) StoreUser(
 }
 
 func (r *UsersRepository) FindUserByLogin(ctx context.Context, login string) (*entity.User, error) {
-	var user entity.User
-
 	row := r.conn.QueryRowContext(ctx, `
-		SELECT id, login, password
+		SELECT id, login, password, totp_secret, totp_enabled, totp_last_step
 		FROM users
 		WHERE
 			login=$1;
 	`, login)
 
-	err := row.Scan(&user.ID, &user.Login, &user.Password)
+	return scanUser(row)
+}
+
+func (r *UsersRepository) FindUserByID(ctx context.Context, userID int) (*entity.User, error) {
+	row := r.conn.QueryRowContext(ctx, `
+		SELECT id, login, password, totp_secret, totp_enabled, totp_last_step
+		FROM users
+		WHERE
+			id=$1;
+	`, userID)
+
+	return scanUser(row)
+}
+
+// SetTOTPSecret сохраняет секрет еще не подтвержденного второго фактора
+// и заменяет коды восстановления.
+func (r *UsersRepository) SetTOTPSecret(
+	ctx context.Context,
+	userID int,
+	secret string,
+	recoveryCodeHashes []string,
+) error {
+	return runInTx(r.conn, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+			UPDATE users
+			SET totp_secret=$1, totp_enabled=FALSE, totp_last_step=0
+			WHERE id=$2;
+		`, secret, userID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			DELETE FROM recovery_codes
+			WHERE user_id=$1;
+		`, userID)
+		if err != nil {
+			return err
+		}
+
+		for _, hash := range recoveryCodeHashes {
+			_, err = tx.ExecContext(ctx, `
+				INSERT INTO recovery_codes (user_id, code_hash)
+				VALUES
+					($1, $2);
+			`, userID, hash)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (r *UsersRepository) EnableTOTP(ctx context.Context, userID int, step int64) error {
+	_, err := r.conn.ExecContext(ctx, `
+		UPDATE users
+		SET totp_enabled=TRUE, totp_last_step=$1
+		WHERE id=$2;
+	`, step, userID)
+
+	return err
+}
+
+func (r *UsersRepository) DisableTOTP(ctx context.Context, userID int) error {
+	return runInTx(r.conn, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+			UPDATE users
+			SET totp_secret='', totp_enabled=FALSE, totp_last_step=0
+			WHERE id=$1;
+		`, userID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			DELETE FROM recovery_codes
+			WHERE user_id=$1;
+		`, userID)
+
+		return err
+	})
+}
+
+// UseTOTPStep запоминает шаг использованного кода. Код того же или более
+// раннего шага уже не принимается, даже если параллельный запрос успел раньше.
+func (r *UsersRepository) UseTOTPStep(ctx context.Context, userID int, step int64) error {
+	result, err := r.conn.ExecContext(ctx, `
+		UPDATE users
+		SET totp_last_step=$1
+		WHERE id=$2 AND totp_last_step < $1;
+	`, step, userID)
+	if err != nil {
+		return err
+	}
+
+	return requireAffected(result, entity.ErrInvalidSecondFactorCode)
+}
+
+func (r *UsersRepository) GetRecoveryCodes(ctx context.Context, userID int) ([]*entity.RecoveryCode, error) {
+	codes := make([]*entity.RecoveryCode, 0)
+
+	rows, err := r.conn.QueryContext(ctx, `
+		SELECT id, code_hash
+		FROM recovery_codes
+		WHERE user_id=$1 AND NOT is_used;
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var code entity.RecoveryCode
+
+		if err = rows.Scan(&code.ID, &code.Hash); err != nil {
+			return nil, err
+		}
+
+		codes = append(codes, &code)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+func (r *UsersRepository) UseRecoveryCode(ctx context.Context, codeID int) error {
+	result, err := r.conn.ExecContext(ctx, `
+		UPDATE recovery_codes
+		SET is_used=TRUE
+		WHERE id=$1 AND NOT is_used;
+	`, codeID)
+	if err != nil {
+		return err
+	}
+
+	return requireAffected(result, entity.ErrInvalidSecondFactorCode)
+}
+
+func scanUser(row *sql.Row) (*entity.User, error) {
+	var user entity.User
+
+	err := row.Scan(
+		&user.ID,
+		&user.Login,
+		&user.Password,
+		&user.TOTPSecret,
+		&user.TOTPEnabled,
+		&user.TOTPLastStep,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -3,6 +3,9 @@ package repository
 import (
 	"database/sql"
 	"errors"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
 )
 
 func runInTx(db *sql.DB, fn func(tx *sql.Tx) error) error {
@@ -23,3 +26,22 @@ func runInTx(db *sql.DB, fn func(tx *sql.Tx) error) error {
 
 	return err
 }
+
+func requireAffected(result sql.Result, notAffectedErr error) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return notAffectedErr
+	}
+
+	return nil
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+
+	return errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation
+}
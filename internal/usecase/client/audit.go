@@ -0,0 +1,37 @@
+package client
+
+import (
+	"context"
+
+	"github.com/llravell/go-pass/internal/entity"
+	pb "github.com/llravell/go-pass/pkg/grpc"
+)
+
+type AuditUseCase struct {
+	auditClient pb.AuditClient
+}
+
+func NewAuditUseCase(auditClient pb.AuditClient) *AuditUseCase {
+	return &AuditUseCase{
+		auditClient: auditClient,
+	}
+}
+
+// GetPage возвращает события старше beforeID, самые новые первыми.
+func (a *AuditUseCase) GetPage(ctx context.Context, beforeID int64, limit int) ([]*entity.AuditEvent, error) {
+	response, err := a.auditClient.List(ctx, &pb.AuditListRequest{
+		BeforeId: beforeID,
+		Limit:    int32(limit), //nolint:gosec
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*entity.AuditEvent, 0, len(response.GetEvents()))
+
+	for _, event := range response.GetEvents() {
+		events = append(events, entity.NewAuditEventFromPB(event))
+	}
+
+	return events, nil
+}
@@ -2,55 +2,153 @@ package client
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/llravell/go-pass/internal/entity"
 	pb "github.com/llravell/go-pass/pkg/grpc"
 	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type AuthUseCase struct {
 	sessionRepo SessionRepository
 	authClient  pb.AuthClient
+	pinner      ServerPinner
 }
 
 func NewAuthUseCase(
 	sessionRepo SessionRepository,
 	authClient pb.AuthClient,
+	pinner ServerPinner,
 ) *AuthUseCase {
 	return &AuthUseCase{
 		sessionRepo: sessionRepo,
 		authClient:  authClient,
+		pinner:      pinner,
 	}
 }
 
+// SecondFactorChallenge - незавершенный вход: пароль принят,
+// сервер ждет код второго фактора.
+type SecondFactorChallenge struct {
+	Token              string
+	EnrollmentRequired bool
+
+	login, password, deviceName string
+}
+
 func (auth *AuthUseCase) Register(
 	ctx context.Context,
-	login, password string,
-) error {
+	login, password, deviceName string,
+) (*SecondFactorChallenge, error) {
+	auth.pinner.AllowFirstUse()
+
 	resp, err := auth.authClient.Register(ctx, &pb.AuthRequest{
-		Login:    login,
-		Password: password,
+		Login:      login,
+		Password:   password,
+		DeviceName: deviceName,
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return auth.saveUserSession(ctx, login, password, resp.GetToken())
+	return auth.handleAuthResponse(ctx, resp, login, password, deviceName)
 }
 
 func (auth *AuthUseCase) Login(
 	ctx context.Context,
-	login, password string,
-) error {
+	login, password, deviceName string,
+) (*SecondFactorChallenge, error) {
+	auth.pinner.AllowFirstUse()
+
 	resp, err := auth.authClient.Login(ctx, &pb.AuthRequest{
-		Login:    login,
-		Password: password,
+		Login:      login,
+		Password:   password,
+		DeviceName: deviceName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return auth.handleAuthResponse(ctx, resp, login, password, deviceName)
+}
+
+// BeginEnrollment подключает второй фактор во время входа, когда его требует сервер.
+func (auth *AuthUseCase) BeginEnrollment(
+	ctx context.Context,
+	challenge *SecondFactorChallenge,
+) (*entity.TwoFactorEnrollment, error) {
+	resp, err := auth.authClient.BeginEnrollment(ctx, &pb.ChallengeRequest{ChallengeToken: challenge.Token})
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.NewTwoFactorEnrollmentFromPB(resp), nil
+}
+
+func (auth *AuthUseCase) CompleteSecondFactor(
+	ctx context.Context,
+	challenge *SecondFactorChallenge,
+	code string,
+) error {
+	resp, err := auth.authClient.VerifySecondFactor(ctx, &pb.SecondFactorRequest{
+		ChallengeToken: challenge.Token,
+		Code:           code,
+		DeviceName:     challenge.deviceName,
 	})
 	if err != nil {
 		return err
 	}
 
-	return auth.saveUserSession(ctx, login, password, resp.GetToken())
+	return auth.saveUserSession(ctx, challenge.login, challenge.password, resp)
+}
+
+func (auth *AuthUseCase) handleAuthResponse(
+	ctx context.Context,
+	resp *pb.AuthResponse,
+	login, password, deviceName string,
+) (*SecondFactorChallenge, error) {
+	if resp.GetSecondFactor() == pb.SecondFactor_NONE {
+		return nil, auth.saveUserSession(ctx, login, password, resp)
+	}
+
+	return &SecondFactorChallenge{
+		Token:              resp.GetChallengeToken(),
+		EnrollmentRequired: resp.GetSecondFactor() == pb.SecondFactor_ENROLLMENT_REQUIRED,
+		login:              login,
+		password:           password,
+		deviceName:         deviceName,
+	}, nil
+}
+
+// Logout отзывает сессию на сервере и удаляет ее локально. Локальная сессия
+// удаляется, даже если сервер недоступен, тогда возвращается ErrServerLogoutFailed.
+func (auth *AuthUseCase) Logout(ctx context.Context) error {
+	session, err := auth.sessionRepo.GetSession(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(session.Login) == 0 && len(session.AuthToken) == 0 {
+		return entity.ErrNoSession
+	}
+
+	var serverErr error
+
+	if len(session.RefreshToken) > 0 {
+		_, err = auth.authClient.Logout(ctx, &pb.LogoutRequest{RefreshToken: session.RefreshToken})
+		if err != nil && status.Code(err) != codes.Unauthenticated {
+			serverErr = fmt.Errorf("%w: %w", entity.ErrServerLogoutFailed, err)
+		}
+	}
+
+	err = auth.sessionRepo.ClearSession(ctx)
+	if err != nil {
+		return err
+	}
+
+	return serverErr
 }
 
 func (auth *AuthUseCase) ValidateMasterPassword(
@@ -69,18 +167,42 @@ func (auth *AuthUseCase) ValidateMasterPassword(
 	return bcrypt.CompareHashAndPassword([]byte(session.MasterPassHash), []byte(masterPassword))
 }
 
+func (auth *AuthUseCase) GetServerPin(ctx context.Context) (string, error) {
+	session, err := auth.sessionRepo.GetSession(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return session.ServerPin, nil
+}
+
+func (auth *AuthUseCase) SetServerPin(ctx context.Context, pin string) error {
+	return auth.sessionRepo.SetServerPin(ctx, pin)
+}
+
 func (auth *AuthUseCase) saveUserSession(
 	ctx context.Context,
-	login, password, authToken string,
+	login, password string,
+	tokens *pb.AuthResponse,
 ) error {
 	passHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 	if err != nil {
 		return err
 	}
 
+	serverPin := auth.pinner.Observed()
+	if len(serverPin) == 0 {
+		serverPin, err = auth.GetServerPin(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
 	return auth.sessionRepo.SetSession(ctx, &entity.ClientSession{
 		Login:          login,
 		MasterPassHash: string(passHash),
-		AuthToken:      authToken,
+		AuthToken:      tokens.GetToken(),
+		RefreshToken:   tokens.GetRefreshToken(),
+		ServerPin:      serverPin,
 	})
 }
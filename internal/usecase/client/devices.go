@@ -0,0 +1,42 @@
+package client
+
+import (
+	"context"
+
+	"github.com/llravell/go-pass/internal/entity"
+	pb "github.com/llravell/go-pass/pkg/grpc"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+type DevicesUseCase struct {
+	devicesClient pb.DevicesClient
+}
+
+func NewDevicesUseCase(devicesClient pb.DevicesClient) *DevicesUseCase {
+	return &DevicesUseCase{
+		devicesClient: devicesClient,
+	}
+}
+
+func (d *DevicesUseCase) GetList(ctx context.Context) ([]*entity.Device, error) {
+	response, err := d.devicesClient.List(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	devices := make([]*entity.Device, 0, len(response.GetDevices()))
+
+	for _, device := range response.GetDevices() {
+		devices = append(devices, entity.NewDeviceFromPB(device))
+	}
+
+	return devices, nil
+}
+
+func (d *DevicesUseCase) RevokeDevice(ctx context.Context, id int) error {
+	_, err := d.devicesClient.Revoke(ctx, &pb.DeviceRevokeRequest{
+		Id: int32(id), //nolint:gosec
+	})
+
+	return err
+}
@@ -0,0 +1,219 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/llravell/go-pass/internal/entity"
+	"github.com/llravell/go-pass/pkg/encryption"
+	pb "github.com/llravell/go-pass/pkg/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// emergencyErrors — ошибки операций с уже выданным доступом.
+var emergencyErrors = statusErrors{
+	codes.NotFound: entity.ErrEmergencyAccessNotFound,
+}
+
+// EmergencyUseCase — экстренный доступ к хранилищу. Владелец заранее отдает
+// серверу ключ хранилища, зашифрованный открытым ключом доверенного контакта,
+// сервер выдает его контакту только после одобрения запроса.
+type EmergencyUseCase struct {
+	emergencyClient pb.EmergencyClient
+	passwordsClient pb.PasswordsClient
+	userKeysUC      *UserKeysUseCase
+}
+
+func NewEmergencyUseCase(
+	emergencyClient pb.EmergencyClient,
+	passwordsClient pb.PasswordsClient,
+	userKeysUC *UserKeysUseCase,
+) *EmergencyUseCase {
+	return &EmergencyUseCase{
+		emergencyClient: emergencyClient,
+		passwordsClient: passwordsClient,
+		userKeysUC:      userKeysUC,
+	}
+}
+
+func (e *EmergencyUseCase) Grant(
+	ctx context.Context,
+	vaultKey *encryption.Key,
+	login string,
+	waitPeriod time.Duration,
+) error {
+	if waitPeriod <= 0 {
+		return entity.ErrInvalidWaitPeriod
+	}
+
+	publicKey, err := e.userKeysUC.PublicKey(ctx, login)
+	if err != nil {
+		return err
+	}
+
+	wrappedKey, err := encryption.Seal(publicKey, vaultKey.String())
+	if err != nil {
+		return err
+	}
+
+	_, err = e.emergencyClient.Grant(ctx, &pb.EmergencyGrantRequest{
+		Login:      login,
+		WaitPeriod: durationpb.New(waitPeriod),
+		Key:        wrappedKey,
+	})
+	if err != nil {
+		// срок ожидания проверен выше, InvalidArgument остается только для себя самого
+		return errorFromStatus(err, statusErrors{
+			codes.NotFound:        entity.ErrRecipientNotFound,
+			codes.InvalidArgument: entity.ErrEmergencyAccessWithSelf,
+		})
+	}
+
+	return e.userKeysUC.TrustPublicKey(ctx, login, publicKey)
+}
+
+// Revoke удаляет доверенный контакт. exposed сообщает, что доступ уже был
+// одобрен: контакт мог получить ключ хранилища, и забрать его обратно нельзя.
+func (e *EmergencyUseCase) Revoke(ctx context.Context, login string) (exposed bool, err error) {
+	exposed, err = e.approvedFor(ctx, login)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = e.emergencyClient.Revoke(ctx, &pb.EmergencyContactRequest{Login: login})
+	if err != nil {
+		return false, errorFromStatus(err, emergencyErrors)
+	}
+
+	return exposed, nil
+}
+
+func (e *EmergencyUseCase) GetList(ctx context.Context, incoming bool) ([]*entity.EmergencyAccess, error) {
+	response, err := e.emergencyClient.List(ctx, &pb.EmergencyListRequest{Incoming: incoming})
+	if err != nil {
+		return nil, errorFromStatus(err, emergencyErrors)
+	}
+
+	accesses := make([]*entity.EmergencyAccess, 0, len(response.GetAccesses()))
+
+	for _, access := range response.GetAccesses() {
+		accesses = append(accesses, entity.NewEmergencyAccessFromPB(access))
+	}
+
+	return accesses, nil
+}
+
+func (e *EmergencyUseCase) Request(ctx context.Context, grantor string) (*entity.EmergencyAccess, error) {
+	response, err := e.emergencyClient.Request(ctx, &pb.EmergencyContactRequest{Login: grantor})
+	if err != nil {
+		return nil, errorFromStatus(err, emergencyErrors)
+	}
+
+	return entity.NewEmergencyAccessFromPB(response), nil
+}
+
+func (e *EmergencyUseCase) Approve(ctx context.Context, login string) error {
+	_, err := e.emergencyClient.Approve(ctx, &pb.EmergencyContactRequest{Login: login})
+
+	return errorFromStatus(err, statusErrors{
+		codes.NotFound:           entity.ErrEmergencyAccessNotFound,
+		codes.FailedPrecondition: entity.ErrEmergencyAccessNotRequested,
+	})
+}
+
+// Reject отклоняет запрос или закрывает одобренный доступ к записям.
+// exposed означает то же, что и в Revoke.
+func (e *EmergencyUseCase) Reject(ctx context.Context, login string) (exposed bool, err error) {
+	exposed, err = e.approvedFor(ctx, login)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = e.emergencyClient.Reject(ctx, &pb.EmergencyContactRequest{Login: login})
+	if err != nil {
+		return false, errorFromStatus(err, emergencyErrors)
+	}
+
+	return exposed, nil
+}
+
+func (e *EmergencyUseCase) approvedFor(ctx context.Context, grantee string) (bool, error) {
+	accesses, err := e.GetList(ctx, false)
+	if err != nil {
+		return false, err
+	}
+
+	for _, access := range accesses {
+		if access.Grantee == grantee {
+			return access.Approved(), nil
+		}
+	}
+
+	return false, nil
+}
+
+// GetGrantorPasswords загружает записи владельца после одобрения доступа
+// и расшифровывает их полученной копией его ключа хранилища.
+func (e *EmergencyUseCase) GetGrantorPasswords(
+	ctx context.Context,
+	vaultKey *encryption.Key,
+	grantor string,
+) ([]*entity.Password, error) {
+	grantorKey, err := e.grantorKey(ctx, vaultKey, grantor)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := e.passwordsClient.GetEmergencyList(ctx, &pb.PasswordEmergencyRequest{Grantor: grantor})
+	if err != nil {
+		return nil, errorFromStatus(err, statusErrors{
+			codes.NotFound:           entity.ErrEmergencyAccessNotFound,
+			codes.FailedPrecondition: entity.ErrEmergencyAccessNotApproved,
+		})
+	}
+
+	passwords := make([]*entity.Password, 0, len(response.GetPasswords()))
+
+	for _, pass := range response.GetPasswords() {
+		password := entity.NewPasswordFromPB(pass)
+
+		if err = password.Open(grantorKey); err != nil {
+			return nil, err
+		}
+
+		passwords = append(passwords, password)
+	}
+
+	return passwords, nil
+}
+
+func (e *EmergencyUseCase) grantorKey(
+	ctx context.Context,
+	vaultKey *encryption.Key,
+	grantor string,
+) (*encryption.Key, error) {
+	accesses, err := e.GetList(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, access := range accesses {
+		if access.Grantor != grantor {
+			continue
+		}
+
+		if !access.Approved() {
+			return nil, entity.ErrEmergencyAccessNotApproved
+		}
+
+		keyPair, err := e.userKeysUC.KeyPair(ctx, vaultKey)
+		if err != nil {
+			return nil, err
+		}
+
+		return openDataKey(keyPair, access.Key)
+	}
+
+	return nil, entity.ErrEmergencyAccessNotFound
+}
@@ -0,0 +1,69 @@
+package client_test
+
+import (
+	"context"
+	"testing"
+
+	usecase "github.com/llravell/go-pass/internal/usecase/client"
+	pb "github.com/llravell/go-pass/pkg/grpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+type emergencyClientStub struct {
+	pb.EmergencyClient
+
+	outgoing []*pb.EmergencyAccess
+}
+
+func (c *emergencyClientStub) List(
+	_ context.Context,
+	_ *pb.EmergencyListRequest,
+	_ ...grpc.CallOption,
+) (*pb.EmergencyListResponse, error) {
+	return &pb.EmergencyListResponse{Accesses: c.outgoing}, nil
+}
+
+func (c *emergencyClientStub) Reject(
+	_ context.Context,
+	_ *pb.EmergencyContactRequest,
+	_ ...grpc.CallOption,
+) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+func (c *emergencyClientStub) Revoke(
+	_ context.Context,
+	_ *pb.EmergencyContactRequest,
+	_ ...grpc.CallOption,
+) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, nil
+}
+
+func TestEmergencyUseCaseExposed(t *testing.T) {
+	client := &emergencyClientStub{outgoing: []*pb.EmergencyAccess{
+		{Grantee: "bob", Status: pb.EmergencyStatus_APPROVED},
+		{Grantee: "carol", Status: pb.EmergencyStatus_REQUESTED},
+	}}
+	emergencyUC := usecase.NewEmergencyUseCase(client, nil, nil)
+
+	t.Run("reject of approved access reports exposed key", func(t *testing.T) {
+		exposed, err := emergencyUC.Reject(context.Background(), "bob")
+		require.NoError(t, err)
+		assert.True(t, exposed)
+	})
+
+	t.Run("reject of request does not", func(t *testing.T) {
+		exposed, err := emergencyUC.Reject(context.Background(), "carol")
+		require.NoError(t, err)
+		assert.False(t, exposed)
+	})
+
+	t.Run("revoke of approved access reports exposed key", func(t *testing.T) {
+		exposed, err := emergencyUC.Revoke(context.Background(), "bob")
+		require.NoError(t, err)
+		assert.True(t, exposed)
+	})
+}
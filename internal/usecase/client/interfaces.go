@@ -12,6 +12,12 @@ type (
 	SessionRepository interface {
 		GetSession(ctx context.Context) (*entity.ClientSession, error)
 		SetSession(ctx context.Context, session *entity.ClientSession) error
+		SetServerPin(ctx context.Context, pin string) error
+		ClearSession(ctx context.Context) error
+	}
+	ServerPinner interface {
+		AllowFirstUse()
+		Observed() string
 	}
 	PasswordsRepository interface {
 		PasswordExists(ctx context.Context, name string) (bool, error)
@@ -22,5 +28,18 @@ type (
 		GetPasswords(ctx context.Context) ([]*entity.Password, error)
 		DeletePasswordHard(ctx context.Context, name string) error
 		DeletePasswordSoft(ctx context.Context, name string) error
+		WipePasswords(ctx context.Context) error
+	}
+	SharesRepository interface {
+		GetShares(ctx context.Context) ([]*entity.Share, error)
+		GetShare(ctx context.Context, owner string, name string) (*entity.Share, error)
+		ReplaceShares(ctx context.Context, shares []*entity.Share) error
+		UpdateShare(ctx context.Context, share *entity.Share) error
+		DeleteShare(ctx context.Context, shareID int) error
+	}
+	RecipientKeysRepository interface {
+		GetRecipientKey(ctx context.Context, login string) (string, error)
+		PinRecipientKey(ctx context.Context, login string, publicKey string) error
+		DeleteRecipientKey(ctx context.Context, login string) error
 	}
 )
@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/llravell/go-pass/internal/entity"
+)
+
+func (p *PasswordsUseCase) GetLogins(
+	ctx context.Context,
+) ([]*entity.Login, error) {
+	passwords, err := p.passwordsRepo.GetPasswords(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logins := make([]*entity.Login, 0, len(passwords))
+
+	for _, password := range passwords {
+		if password.Deleted || !entity.IsLoginPassword(password) {
+			continue
+		}
+
+		logins = append(logins, entity.NewLoginFromPassword(password))
+	}
+
+	return logins, nil
+}
+
+// FindLogin ищет актуальную (не помеченную как устаревшая) запись для URL.
+// Запись без пути подходит для любого пути на том же хосте,
+// но запись с совпадающим путем имеет приоритет.
+func (p *PasswordsUseCase) FindLogin(
+	ctx context.Context,
+	rawURL, username string,
+) (*entity.Login, error) {
+	target, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	logins, err := p.GetLogins(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *entity.Login
+
+	for _, login := range logins {
+		if login.Stale || (len(username) > 0 && login.Username != username) {
+			continue
+		}
+
+		candidate, err := url.Parse(login.URL)
+		if err != nil || candidate.Scheme != target.Scheme || candidate.Host != target.Host {
+			continue
+		}
+
+		candidatePath := strings.Trim(candidate.Path, "/")
+		if len(candidatePath) > 0 && candidatePath != strings.Trim(target.Path, "/") {
+			continue
+		}
+
+		if found == nil || len(candidatePath) > 0 {
+			found = login
+		}
+	}
+
+	if found == nil {
+		return nil, entity.ErrPasswordDoesNotExist
+	}
+
+	return found, nil
+}
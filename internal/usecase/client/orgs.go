@@ -0,0 +1,276 @@
+package client
+
+import (
+	"context"
+
+	"github.com/llravell/go-pass/internal/entity"
+	"github.com/llravell/go-pass/pkg/encryption"
+	pb "github.com/llravell/go-pass/pkg/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// collectionErrors — ошибки операций с записями коллекции.
+var collectionErrors = statusErrors{
+	codes.NotFound:         entity.ErrCollectionNotFound,
+	codes.PermissionDenied: entity.ErrPermissionDenied,
+}
+
+// collectionSyncErrors дополнительно учитывает квоты сервера.
+var collectionSyncErrors = statusErrors{
+	codes.NotFound:          entity.ErrCollectionNotFound,
+	codes.PermissionDenied:  entity.ErrPermissionDenied,
+	codes.InvalidArgument:   entity.ErrEntryTooLarge,
+	codes.ResourceExhausted: entity.ErrEntriesQuotaExceeded,
+}
+
+// OrgsUseCase управляет организациями и коллекциями. Ключ коллекции создается
+// на клиенте и выдается участникам зашифрованным их открытыми ключами,
+// поэтому выдать доступ может только тот, у кого этот ключ уже есть.
+type OrgsUseCase struct {
+	orgsClient pb.OrgsClient
+	userKeysUC *UserKeysUseCase
+}
+
+func NewOrgsUseCase(orgsClient pb.OrgsClient, userKeysUC *UserKeysUseCase) *OrgsUseCase {
+	return &OrgsUseCase{
+		orgsClient: orgsClient,
+		userKeysUC: userKeysUC,
+	}
+}
+
+func (o *OrgsUseCase) Create(ctx context.Context, name string) (*entity.Organization, error) {
+	response, err := o.orgsClient.Create(ctx, &pb.OrgCreateRequest{Name: name})
+	if err != nil {
+		return nil, errorFromStatus(err, statusErrors{
+			codes.AlreadyExists:   entity.ErrOrgAlreadyExists,
+			codes.InvalidArgument: entity.ErrInvalidOrgName,
+		})
+	}
+
+	return entity.NewOrganizationFromPB(response), nil
+}
+
+func (o *OrgsUseCase) GetList(ctx context.Context) ([]*entity.Organization, error) {
+	response, err := o.orgsClient.List(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	orgs := make([]*entity.Organization, 0, len(response.GetOrgs()))
+
+	for _, org := range response.GetOrgs() {
+		orgs = append(orgs, entity.NewOrganizationFromPB(org))
+	}
+
+	return orgs, nil
+}
+
+func (o *OrgsUseCase) GetMembers(ctx context.Context, org string) ([]*entity.OrgMember, error) {
+	response, err := o.orgsClient.ListMembers(ctx, &pb.OrgRequest{Org: org})
+	if err != nil {
+		return nil, errorFromStatus(err, statusErrors{codes.NotFound: entity.ErrOrgNotFound})
+	}
+
+	members := make([]*entity.OrgMember, 0, len(response.GetMembers()))
+
+	for _, member := range response.GetMembers() {
+		members = append(members, entity.NewOrgMemberFromPB(member))
+	}
+
+	return members, nil
+}
+
+func (o *OrgsUseCase) SetMember(ctx context.Context, org string, login string, role entity.OrgRole) error {
+	_, err := o.orgsClient.SetMember(ctx, &pb.OrgMemberRequest{
+		Org:   org,
+		Login: login,
+		Role:  role.String(),
+	})
+
+	// NotFound означает и чужую организацию, и неизвестный логин, его отдаем как есть
+	return errorFromStatus(err, statusErrors{
+		codes.InvalidArgument:    entity.ErrInvalidOrgRole,
+		codes.FailedPrecondition: entity.ErrLastOrgOwner,
+		codes.PermissionDenied:   entity.ErrPermissionDenied,
+	})
+}
+
+func (o *OrgsUseCase) RemoveMember(ctx context.Context, org string, login string) error {
+	_, err := o.orgsClient.RemoveMember(ctx, &pb.OrgMemberRequest{
+		Org:   org,
+		Login: login,
+	})
+
+	return errorFromStatus(err, statusErrors{
+		codes.FailedPrecondition: entity.ErrLastOrgOwner,
+		codes.PermissionDenied:   entity.ErrPermissionDenied,
+	})
+}
+
+func (o *OrgsUseCase) CreateCollection(
+	ctx context.Context,
+	vaultKey *encryption.Key,
+	org string,
+	name string,
+) (*entity.Collection, error) {
+	keyPair, err := o.userKeysUC.KeyPair(ctx, vaultKey)
+	if err != nil {
+		return nil, err
+	}
+
+	collectionKey, err := encryption.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	wrappedKey, err := encryption.Seal(keyPair.PublicKey(), collectionKey.String())
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := o.orgsClient.CreateCollection(ctx, &pb.CollectionCreateRequest{
+		Org:  org,
+		Name: name,
+		Key:  wrappedKey,
+	})
+	if err != nil {
+		return nil, errorFromStatus(err, statusErrors{
+			codes.NotFound:         entity.ErrOrgNotFound,
+			codes.AlreadyExists:    entity.ErrCollectionAlreadyExists,
+			codes.InvalidArgument:  entity.ErrInvalidOrgName,
+			codes.PermissionDenied: entity.ErrPermissionDenied,
+		})
+	}
+
+	return entity.NewCollectionFromPB(response), nil
+}
+
+func (o *OrgsUseCase) GetCollections(ctx context.Context) ([]*entity.Collection, error) {
+	response, err := o.orgsClient.ListCollections(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	collections := make([]*entity.Collection, 0, len(response.GetCollections()))
+
+	for _, collection := range response.GetCollections() {
+		collections = append(collections, entity.NewCollectionFromPB(collection))
+	}
+
+	return collections, nil
+}
+
+// GetAvailableCollections как GetCollections, но без подключения к серверу
+// возвращает пустой список: локальные записи должны оставаться доступными.
+func (o *OrgsUseCase) GetAvailableCollections(ctx context.Context) ([]*entity.Collection, error) {
+	collections, err := o.GetCollections(ctx)
+	if isOffline(err) {
+		return nil, nil
+	}
+
+	return collections, err
+}
+
+// FindCollection ищет доступную пользователю коллекцию по пути org/collection.
+func (o *OrgsUseCase) FindCollection(ctx context.Context, path string) (*entity.Collection, error) {
+	org, name, ok := entity.ParseCollectionPath(path)
+	if !ok {
+		return nil, entity.ErrCollectionNotFound
+	}
+
+	collections, err := o.GetCollections(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, collection := range collections {
+		if collection.OrgName == org && collection.Name == name {
+			return collection, nil
+		}
+	}
+
+	return nil, entity.ErrCollectionNotFound
+}
+
+// CollectionKey расшифровывает ключ коллекции закрытым ключом пользователя.
+func (o *OrgsUseCase) CollectionKey(
+	ctx context.Context,
+	vaultKey *encryption.Key,
+	collection *entity.Collection,
+) (*encryption.Key, error) {
+	keyPair, err := o.userKeysUC.KeyPair(ctx, vaultKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return openDataKey(keyPair, collection.Key)
+}
+
+// Grant выдает участнику организации ключ коллекции.
+func (o *OrgsUseCase) Grant(
+	ctx context.Context,
+	vaultKey *encryption.Key,
+	collection *entity.Collection,
+	login string,
+) error {
+	collectionKey, err := o.CollectionKey(ctx, vaultKey, collection)
+	if err != nil {
+		return err
+	}
+
+	publicKey, err := o.userKeysUC.PublicKey(ctx, login)
+	if err != nil {
+		return err
+	}
+
+	wrappedKey, err := encryption.Seal(publicKey, collectionKey.String())
+	if err != nil {
+		return err
+	}
+
+	_, err = o.orgsClient.GrantCollection(ctx, &pb.CollectionGrantRequest{
+		CollectionId: int32(collection.ID), //nolint:gosec
+		Login:        login,
+		Key:          wrappedKey,
+	})
+	if err != nil {
+		return collectionGrantError(err)
+	}
+
+	return o.userKeysUC.TrustPublicKey(ctx, login, publicKey)
+}
+
+func (o *OrgsUseCase) Revoke(ctx context.Context, collection *entity.Collection, login string) error {
+	_, err := o.orgsClient.RevokeCollection(ctx, &pb.CollectionGrantRequest{
+		CollectionId: int32(collection.ID), //nolint:gosec
+		Login:        login,
+	})
+
+	return collectionGrantError(err)
+}
+
+// collectionGrantError: NotFound означает и недоступную коллекцию, и логин
+// не из организации, поэтому такой статус отдается как есть.
+func collectionGrantError(err error) error {
+	return errorFromStatus(err, statusErrors{codes.PermissionDenied: entity.ErrPermissionDenied})
+}
+
+// statusErrors сопоставляет коду статуса ошибку entity, которую вызванный
+// метод сервера возвращает с этим кодом.
+type statusErrors map[codes.Code]error
+
+// errorFromStatus восстанавливает ошибку entity по коду статуса, текст статуса
+// не сравнивается. Коды, которых нет в known, возвращаются как есть.
+func errorFromStatus(err error, known statusErrors) error {
+	if err == nil {
+		return nil
+	}
+
+	if knownErr, ok := known[status.Code(err)]; ok {
+		return knownErr
+	}
+
+	return err
+}
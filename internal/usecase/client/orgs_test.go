@@ -0,0 +1,67 @@
+package client_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/llravell/go-pass/internal/entity"
+	usecase "github.com/llravell/go-pass/internal/usecase/client"
+	pb "github.com/llravell/go-pass/pkg/grpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+type orgsClientStub struct {
+	pb.OrgsClient
+
+	err error
+}
+
+func (c *orgsClientStub) SetMember(_ context.Context, _ *pb.OrgMemberRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	return nil, c.err
+}
+
+func TestOrgsUseCaseErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		wantErr error
+	}{
+		{
+			name:    "maps by code regardless of message",
+			err:     status.Error(codes.FailedPrecondition, "организация должна сохранить владельца"),
+			wantErr: entity.ErrLastOrgOwner,
+		},
+		{
+			name:    "permission denied",
+			err:     status.Error(codes.PermissionDenied, "denied"),
+			wantErr: entity.ErrPermissionDenied,
+		},
+		{
+			name:    "message of other error does not matter",
+			err:     status.Error(codes.InvalidArgument, entity.ErrLastOrgOwner.Error()),
+			wantErr: entity.ErrInvalidOrgRole,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orgsUC := usecase.NewOrgsUseCase(&orgsClientStub{err: tt.err}, nil)
+
+			err := orgsUC.SetMember(context.Background(), "acme", "bob", entity.OrgRoleAdmin)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+
+	t.Run("keeps unknown codes", func(t *testing.T) {
+		statusErr := status.Error(codes.NotFound, "organization not found")
+		orgsUC := usecase.NewOrgsUseCase(&orgsClientStub{err: statusErr}, nil)
+
+		err := orgsUC.SetMember(context.Background(), "acme", "bob", entity.OrgRoleAdmin)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
@@ -2,13 +2,28 @@ package client
 
 import (
 	"context"
+	"errors"
 
 	"github.com/llravell/go-pass/internal/entity"
 	pb "github.com/llravell/go-pass/pkg/grpc"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
+// isOffline сообщает, что изменение можно сохранить только локально и синхронизировать позже:
+// сервер недоступен или пользователь не входил. Ошибки авторизации так не маскируются.
+func isOffline(err error) bool {
+	if errors.Is(err, entity.ErrNoSession) {
+		return true
+	}
+
+	code := status.Code(err)
+
+	return code == codes.Unavailable || code == codes.DeadlineExceeded
+}
+
 type PasswordsUpdates struct {
 	ToAdd    []*entity.Password
 	ToUpdate []*entity.Password
@@ -47,7 +62,11 @@ func (p *PasswordsUseCase) AddNewPassword(
 
 	response, err := p.passwordsClient.Sync(ctx, password.ToPB())
 	if err != nil {
-		return p.passwordsRepo.CreateNewPassword(ctx, &password)
+		if isOffline(err) {
+			return p.passwordsRepo.CreateNewPassword(ctx, &password)
+		}
+
+		return err
 	}
 
 	if response.GetSuccess() {
@@ -70,7 +89,11 @@ func (p *PasswordsUseCase) UpdatePassword(
 ) error {
 	response, err := p.passwordsClient.Sync(ctx, password.ToPB())
 	if err != nil {
-		return p.passwordsRepo.UpdatePassword(ctx, password)
+		if isOffline(err) {
+			return p.passwordsRepo.UpdatePassword(ctx, password)
+		}
+
+		return err
 	}
 
 	if response.GetSuccess() {
@@ -80,6 +103,36 @@ func (p *PasswordsUseCase) UpdatePassword(
 	return entity.NewPasswordConflictErrorFromPB(password, response.GetConflict())
 }
 
+// OverridePassword перезаписывает серверную версию записи локальной после конфликта.
+// Локально запись могла еще не существовать (конфликт при добавлении), тогда она создается.
+func (p *PasswordsUseCase) OverridePassword(
+	ctx context.Context,
+	conflict *entity.PasswordConflictError,
+) error {
+	password := conflict.Actual()
+	password.Version = conflict.Incoming().Version + 1
+
+	response, err := p.passwordsClient.Sync(ctx, password.ToPB())
+	if err != nil {
+		return err
+	}
+
+	if !response.GetSuccess() {
+		return entity.NewPasswordConflictErrorFromPB(password, response.GetConflict())
+	}
+
+	exists, err := p.passwordsRepo.PasswordExists(ctx, password.Name)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return p.passwordsRepo.UpdatePassword(ctx, password)
+	}
+
+	return p.passwordsRepo.CreateNewPassword(ctx, password)
+}
+
 func (p *PasswordsUseCase) UpdatePasswordLocal(
 	ctx context.Context,
 	password *entity.Password,
@@ -122,12 +175,104 @@ func (p *PasswordsUseCase) DeletePasswordByName(
 
 	_, err = p.passwordsClient.Delete(ctx, &pb.PasswordDeleteRequest{Name: name})
 	if err != nil {
-		return p.passwordsRepo.DeletePasswordSoft(ctx, name)
+		if isOffline(err) {
+			return p.passwordsRepo.DeletePasswordSoft(ctx, name)
+		}
+
+		return err
 	}
 
 	return p.passwordsRepo.DeletePasswordHard(ctx, name)
 }
 
+// GetCollectionList загружает записи коллекции. Коллекции не хранятся локально,
+// поэтому работа с ними требует подключения к серверу.
+func (p *PasswordsUseCase) GetCollectionList(
+	ctx context.Context,
+	collectionID int,
+) ([]*entity.Password, error) {
+	response, err := p.passwordsClient.GetCollectionList(ctx, &pb.PasswordCollectionRequest{
+		CollectionId: int32(collectionID), //nolint:gosec
+	})
+	if err != nil {
+		return nil, errorFromStatus(err, collectionErrors)
+	}
+
+	passwords := make([]*entity.Password, 0, len(response.GetPasswords()))
+
+	for _, pass := range response.GetPasswords() {
+		passwords = append(passwords, entity.NewPasswordFromPB(pass))
+	}
+
+	return passwords, nil
+}
+
+func (p *PasswordsUseCase) GetCollectionPasswordByName(
+	ctx context.Context,
+	collectionID int,
+	name string,
+) (*entity.Password, error) {
+	passwords, err := p.GetCollectionList(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, password := range passwords {
+		if password.Name == name {
+			return password, nil
+		}
+	}
+
+	return nil, entity.ErrPasswordDoesNotExist
+}
+
+func (p *PasswordsUseCase) AddCollectionPassword(
+	ctx context.Context,
+	password *entity.Password,
+) error {
+	_, err := p.GetCollectionPasswordByName(ctx, password.CollectionID, password.Name)
+	if err == nil {
+		return entity.ErrPasswordAlreadyExist
+	}
+
+	if !errors.Is(err, entity.ErrPasswordDoesNotExist) {
+		return err
+	}
+
+	password.Version = 1
+
+	return p.UpdateCollectionPassword(ctx, password)
+}
+
+func (p *PasswordsUseCase) UpdateCollectionPassword(
+	ctx context.Context,
+	password *entity.Password,
+) error {
+	response, err := p.passwordsClient.Sync(ctx, password.ToPB())
+	if err != nil {
+		return errorFromStatus(err, collectionSyncErrors)
+	}
+
+	if response.GetSuccess() {
+		return nil
+	}
+
+	return entity.NewPasswordConflictErrorFromPB(password, response.GetConflict())
+}
+
+func (p *PasswordsUseCase) DeleteCollectionPassword(
+	ctx context.Context,
+	collectionID int,
+	name string,
+) error {
+	_, err := p.passwordsClient.Delete(ctx, &pb.PasswordDeleteRequest{
+		Name:         name,
+		CollectionId: int32(collectionID), //nolint:gosec
+	})
+
+	return errorFromStatus(err, collectionErrors)
+}
+
 func (p *PasswordsUseCase) GetUpdates(
 	ctx context.Context,
 ) (*PasswordsUpdates, error) {
@@ -171,6 +316,41 @@ func (p *PasswordsUseCase) GetUpdates(
 	return updates, nil
 }
 
+// GetPendingChanges возвращает локальные изменения, которых еще нет на сервере,
+// включая удаления, сделанные без подключения.
+func (p *PasswordsUseCase) GetPendingChanges(
+	ctx context.Context,
+) ([]*entity.Password, error) {
+	updates, err := p.GetUpdates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := updates.ToSync
+	pendingNames := make(map[string]bool, len(pending))
+
+	for _, password := range pending {
+		pendingNames[password.Name] = true
+	}
+
+	localPasswords, err := p.passwordsRepo.GetPasswords(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, password := range localPasswords {
+		if password.Deleted && !pendingNames[password.Name] {
+			pending = append(pending, password)
+		}
+	}
+
+	return pending, nil
+}
+
+func (p *PasswordsUseCase) WipeLocal(ctx context.Context) error {
+	return p.passwordsRepo.WipePasswords(ctx)
+}
+
 func (p *PasswordsUseCase) fetchLocalAndServerPasswords(
 	ctx context.Context,
 ) (map[string]*entity.Password, map[string]*entity.Password, error) {
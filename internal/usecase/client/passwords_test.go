@@ -0,0 +1,126 @@
+package client_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/llravell/go-pass/internal/entity"
+	usecase "github.com/llravell/go-pass/internal/usecase/client"
+	pb "github.com/llravell/go-pass/pkg/grpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+type passwordsRepoStub struct {
+	usecase.PasswordsRepository
+
+	passwords map[string]*entity.Password
+}
+
+func (r *passwordsRepoStub) PasswordExists(_ context.Context, name string) (bool, error) {
+	_, ok := r.passwords[name]
+
+	return ok, nil
+}
+
+func (r *passwordsRepoStub) GetPasswordByName(_ context.Context, name string) (*entity.Password, error) {
+	password, ok := r.passwords[name]
+	if !ok {
+		return nil, entity.ErrPasswordDoesNotExist
+	}
+
+	return password, nil
+}
+
+func (r *passwordsRepoStub) CreateNewPassword(_ context.Context, password *entity.Password) error {
+	if _, ok := r.passwords[password.Name]; ok {
+		return entity.ErrPasswordAlreadyExist
+	}
+
+	stored := *password
+	r.passwords[password.Name] = &stored
+
+	return nil
+}
+
+func (r *passwordsRepoStub) UpdatePassword(_ context.Context, password *entity.Password) error {
+	// как и UPDATE ... WHERE name=?, обновление несуществующей записи ничего не делает
+	if _, ok := r.passwords[password.Name]; ok {
+		stored := *password
+		r.passwords[password.Name] = &stored
+	}
+
+	return nil
+}
+
+// passwordsClientStub хранит серверные версии записей и отвечает конфликтом,
+// если пришла версия не новее серверной.
+type passwordsClientStub struct {
+	pb.PasswordsClient
+
+	server map[string]*pb.Password
+}
+
+func (c *passwordsClientStub) Sync(
+	_ context.Context,
+	in *pb.Password,
+	_ ...grpc.CallOption,
+) (*pb.PasswordSyncResponse, error) {
+	if actual, ok := c.server[in.GetName()]; ok && in.GetVersion() <= actual.GetVersion() {
+		return &pb.PasswordSyncResponse{
+			Conflict: &pb.Conflict{Password: actual, Type: pb.ConflictType_DIFF},
+		}, nil
+	}
+
+	c.server[in.GetName()] = in
+
+	return &pb.PasswordSyncResponse{Success: true}, nil
+}
+
+func TestPasswordsUseCaseOverridePassword(t *testing.T) {
+	t.Run("creates local entry after conflict on add", func(t *testing.T) {
+		repo := &passwordsRepoStub{passwords: map[string]*entity.Password{}}
+		client := &passwordsClientStub{server: map[string]*pb.Password{
+			"github": {Name: "github", Value: "server", Version: 3},
+		}}
+		passwordsUC := usecase.NewPasswordsUseCase(repo, client)
+
+		err := passwordsUC.AddNewPassword(context.Background(), entity.Password{Name: "github", Value: "local"})
+
+		var conflictErr *entity.PasswordConflictError
+		require.ErrorAs(t, err, &conflictErr)
+
+		require.NoError(t, passwordsUC.OverridePassword(context.Background(), conflictErr))
+
+		local, err := repo.GetPasswordByName(context.Background(), "github")
+		require.NoError(t, err)
+		assert.Equal(t, "local", local.Value)
+		assert.Equal(t, 4, local.Version)
+
+		assert.Equal(t, "local", client.server["github"].GetValue())
+		assert.Equal(t, int32(4), client.server["github"].GetVersion())
+	})
+
+	t.Run("updates existing local entry", func(t *testing.T) {
+		repo := &passwordsRepoStub{passwords: map[string]*entity.Password{
+			"github": {Name: "github", Value: "old", Version: 1},
+		}}
+		client := &passwordsClientStub{server: map[string]*pb.Password{
+			"github": {Name: "github", Value: "server", Version: 2},
+		}}
+		passwordsUC := usecase.NewPasswordsUseCase(repo, client)
+
+		err := passwordsUC.UpdatePassword(context.Background(), &entity.Password{Name: "github", Value: "new", Version: 2})
+
+		var conflictErr *entity.PasswordConflictError
+		require.ErrorAs(t, err, &conflictErr)
+
+		require.NoError(t, passwordsUC.OverridePassword(context.Background(), conflictErr))
+
+		local, err := repo.GetPasswordByName(context.Background(), "github")
+		require.NoError(t, err)
+		assert.Equal(t, "new", local.Value)
+		assert.Equal(t, 3, local.Version)
+	})
+}
@@ -0,0 +1,173 @@
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"net/url"
+	"path"
+	"time"
+
+	"github.com/llravell/go-pass/internal/entity"
+	"github.com/llravell/go-pass/pkg/encryption"
+	pb "github.com/llravell/go-pass/pkg/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// sendLinkScheme используется в ссылках, если у сервера не настроен
+// публичный адрес: такие ссылки открывает только gopass receive.
+const sendLinkScheme = "gopass"
+
+var sendErrors = statusErrors{
+	codes.NotFound:          entity.ErrSendNotFound,
+	codes.InvalidArgument:   entity.ErrInvalidSend,
+	codes.ResourceExhausted: entity.ErrSendTooLarge,
+}
+
+// SendsUseCase передает секреты тем, у кого нет go-pass. Текст шифруется
+// случайным ключом, серверу уходит только шифротекст, а ключ — во фрагмент
+// ссылки, который браузеры на сервер не отправляют.
+type SendsUseCase struct {
+	sendsClient       pb.SendsClient
+	sendsAccessClient pb.SendsAccessClient
+	server            string
+}
+
+func NewSendsUseCase(
+	sendsClient pb.SendsClient,
+	sendsAccessClient pb.SendsAccessClient,
+	server string,
+) *SendsUseCase {
+	return &SendsUseCase{
+		sendsClient:       sendsClient,
+		sendsAccessClient: sendsAccessClient,
+		server:            server,
+	}
+}
+
+// Create шифрует текст и возвращает ссылку на передачу.
+func (s *SendsUseCase) Create(
+	ctx context.Context,
+	text string,
+	ttl time.Duration,
+	maxViews int,
+) (string, time.Time, error) {
+	key, err := encryption.GenerateKey()
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	ciphertext, err := key.Encrypt(text)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	response, err := s.sendsClient.Create(ctx, &pb.SendCreateRequest{
+		Ciphertext: ciphertext,
+		Ttl:        durationpb.New(ttl),
+		MaxViews:   int32(maxViews), //nolint:gosec
+	})
+	if err != nil {
+		return "", time.Time{}, errorFromStatus(err, sendErrors)
+	}
+
+	link, err := s.link(response, key)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	return link, response.GetExpiresAt().AsTime(), nil
+}
+
+// Receive забирает передачу по ссылке и расшифровывает ее. Просмотр
+// засчитывается сервером, последний просмотр удаляет передачу.
+func (s *SendsUseCase) Receive(ctx context.Context, link string) (string, error) {
+	id, key, err := parseSendLink(link)
+	if err != nil {
+		return "", err
+	}
+
+	response, err := s.sendsAccessClient.Receive(ctx, &pb.SendRequest{Id: id})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return "", entity.ErrSendNotFound
+		}
+
+		return "", err
+	}
+
+	return key.Decrypt(response.GetCiphertext())
+}
+
+func (s *SendsUseCase) GetList(ctx context.Context) ([]*entity.Send, error) {
+	response, err := s.sendsClient.List(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, errorFromStatus(err, sendErrors)
+	}
+
+	sends := make([]*entity.Send, 0, len(response.GetSends()))
+
+	for _, send := range response.GetSends() {
+		sends = append(sends, entity.NewSendFromPB(send))
+	}
+
+	return sends, nil
+}
+
+func (s *SendsUseCase) Delete(ctx context.Context, id string) error {
+	_, err := s.sendsClient.Delete(ctx, &pb.SendRequest{Id: id})
+
+	return errorFromStatus(err, sendErrors)
+}
+
+func (s *SendsUseCase) link(response *pb.SendCreateResponse, key *encryption.Key) (string, error) {
+	rawKey, err := base64.StdEncoding.DecodeString(key.String())
+	if err != nil {
+		return "", err
+	}
+
+	link := &url.URL{
+		Scheme: sendLinkScheme,
+		Host:   s.server,
+		Path:   "/s/" + response.GetId(),
+	}
+
+	if response.GetUrl() != "" {
+		link, err = url.Parse(response.GetUrl())
+		if err != nil {
+			return "", err
+		}
+	}
+
+	link.Fragment = base64.RawURLEncoding.EncodeToString(rawKey)
+
+	return link.String(), nil
+}
+
+// parseSendLink достает из ссылки id передачи и ключ. Сервер, указанный
+// в ссылке, не используется: передача забирается с сервера профиля.
+func parseSendLink(link string) (string, *encryption.Key, error) {
+	parsed, err := url.Parse(link)
+	if err != nil || parsed.Fragment == "" {
+		return "", nil, entity.ErrInvalidSendLink
+	}
+
+	id := path.Base(parsed.Path)
+	if id == "" || id == "." || id == "/" {
+		return "", nil, entity.ErrInvalidSendLink
+	}
+
+	rawKey, err := base64.RawURLEncoding.DecodeString(parsed.Fragment)
+	if err != nil {
+		return "", nil, entity.ErrInvalidSendLink
+	}
+
+	key, err := encryption.ParseKey(base64.StdEncoding.EncodeToString(rawKey))
+	if err != nil {
+		return "", nil, entity.ErrInvalidSendLink
+	}
+
+	return id, key, nil
+}
@@ -0,0 +1,388 @@
+package client
+
+import (
+	"context"
+	"errors"
+
+	"github.com/llravell/go-pass/internal/entity"
+	"github.com/llravell/go-pass/pkg/encryption"
+	pb "github.com/llravell/go-pass/pkg/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type SharesSyncResult struct {
+	Pushed   int
+	Pulled   int
+	Incoming int
+	// Conflicts — записи, измененные и владельцем, и получателем:
+	// в копию записана версия владельца.
+	Conflicts []string
+}
+
+// SharesUseCase делится записями с другими пользователями. Для каждой копии
+// создается свой ключ данных, он шифруется открытыми ключами X25519 владельца
+// и получателя.
+type SharesUseCase struct {
+	sharesRepo   SharesRepository
+	passwordsUC  *PasswordsUseCase
+	userKeysUC   *UserKeysUseCase
+	sharesClient pb.SharesClient
+}
+
+func NewSharesUseCase(
+	sharesRepo SharesRepository,
+	passwordsUC *PasswordsUseCase,
+	userKeysUC *UserKeysUseCase,
+	sharesClient pb.SharesClient,
+) *SharesUseCase {
+	return &SharesUseCase{
+		sharesRepo:   sharesRepo,
+		passwordsUC:  passwordsUC,
+		userKeysUC:   userKeysUC,
+		sharesClient: sharesClient,
+	}
+}
+
+func (s *SharesUseCase) Share(
+	ctx context.Context,
+	vaultKey *encryption.Key,
+	name string,
+	recipient string,
+	permission entity.SharePermission,
+) (*entity.Share, error) {
+	password, err := s.passwordsUC.GetPasswordByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = password.Open(vaultKey); err != nil {
+		return nil, err
+	}
+
+	keyPair, err := s.userKeysUC.KeyPair(ctx, vaultKey)
+	if err != nil {
+		return nil, err
+	}
+
+	recipientKey, err := s.userKeysUC.PublicKey(ctx, recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	dataKey, err := encryption.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := dataKey.Encrypt(password.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerKey, err := encryption.Seal(keyPair.PublicKey(), dataKey.String())
+	if err != nil {
+		return nil, err
+	}
+
+	sealedRecipientKey, err := encryption.Seal(recipientKey, dataKey.String())
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.sharesClient.Create(ctx, &pb.ShareCreateRequest{
+		Name:          password.Name,
+		Recipient:     recipient,
+		Value:         value,
+		Meta:          password.Meta,
+		SourceVersion: int32(password.Version),        //nolint:gosec
+		Permission:    pb.SharePermission(permission), //nolint:gosec
+		OwnerKey:      ownerKey,
+		RecipientKey:  sealedRecipientKey,
+	})
+	if err != nil {
+		return nil, shareError(err, entity.ErrRecipientNotFound)
+	}
+
+	if err = s.userKeysUC.TrustPublicKey(ctx, recipient, recipientKey); err != nil {
+		return nil, err
+	}
+
+	return entity.NewShareFromPB(response), nil
+}
+
+func (s *SharesUseCase) GetOutgoing(ctx context.Context) ([]*entity.Share, error) {
+	return s.fetchShares(ctx, true)
+}
+
+// GetIncoming возвращает записи других пользователей, загруженные при последней синхронизации.
+func (s *SharesUseCase) GetIncoming(ctx context.Context) ([]*entity.Share, error) {
+	return s.sharesRepo.GetShares(ctx)
+}
+
+func (s *SharesUseCase) GetIncomingByAddress(ctx context.Context, address string) (*entity.Share, error) {
+	owner, name, ok := entity.ParseShareAddress(address)
+	if !ok {
+		return nil, entity.ErrShareNotFound
+	}
+
+	return s.sharesRepo.GetShare(ctx, owner, name)
+}
+
+// UpdateIncoming сохраняет изменение чужой записи, share содержит открытое значение.
+// Изменение сразу отправляется на сервер, владелец получит его при синхронизации.
+func (s *SharesUseCase) UpdateIncoming(
+	ctx context.Context,
+	vaultKey *encryption.Key,
+	share *entity.Share,
+) error {
+	if !share.Writable() {
+		return entity.ErrShareReadOnly
+	}
+
+	keyPair, err := s.userKeysUC.KeyPair(ctx, vaultKey)
+	if err != nil {
+		return err
+	}
+
+	dataKey, err := openDataKey(keyPair, share.Key)
+	if err != nil {
+		return err
+	}
+
+	value, err := dataKey.Encrypt(share.Value)
+	if err != nil {
+		return err
+	}
+
+	response, err := s.sharesClient.Update(ctx, &pb.ShareUpdateRequest{
+		Id:      int32(share.ID), //nolint:gosec
+		Value:   value,
+		Meta:    share.Meta,
+		Version: int32(share.Version), //nolint:gosec
+	})
+	if err != nil {
+		return shareError(err, entity.ErrShareNotFound)
+	}
+
+	share.Version = int(response.GetVersion())
+
+	if err = share.Close(vaultKey); err != nil {
+		return err
+	}
+
+	return s.sharesRepo.UpdateShare(ctx, share)
+}
+
+func (s *SharesUseCase) Revoke(ctx context.Context, shareID int) error {
+	_, err := s.sharesClient.Revoke(ctx, &pb.ShareRevokeRequest{
+		Id: int32(shareID), //nolint:gosec
+	})
+	if err != nil {
+		return shareError(err, entity.ErrShareNotFound)
+	}
+
+	return s.sharesRepo.DeleteShare(ctx, shareID)
+}
+
+// Sync переносит изменения записей владельца в их копии, забирает правки
+// получателей с правом записи и обновляет локальный список чужих записей.
+func (s *SharesUseCase) Sync(ctx context.Context, vaultKey *encryption.Key) (*SharesSyncResult, error) {
+	keyPair, err := s.userKeysUC.KeyPair(ctx, vaultKey)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SharesSyncResult{}
+
+	if err = s.syncOutgoing(ctx, vaultKey, keyPair, result); err != nil {
+		return nil, err
+	}
+
+	if err = s.syncIncoming(ctx, vaultKey, keyPair, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *SharesUseCase) syncOutgoing(
+	ctx context.Context,
+	vaultKey *encryption.Key,
+	keyPair *encryption.KeyPair,
+	result *SharesSyncResult,
+) error {
+	shares, err := s.fetchShares(ctx, true)
+	if err != nil {
+		return err
+	}
+
+	for _, share := range shares {
+		// запись читается заново: ее могла обновить правка из другой копии
+		password, err := s.passwordsUC.GetPasswordByName(ctx, share.Name)
+		if err != nil {
+			if errors.Is(err, entity.ErrPasswordDoesNotExist) {
+				continue
+			}
+
+			return err
+		}
+
+		dataKey, err := openDataKey(keyPair, share.Key)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case share.RecipientModified && password.Version == share.SourceVersion:
+			if err = s.pullShare(ctx, vaultKey, dataKey, share, password); err != nil {
+				return err
+			}
+
+			result.Pulled++
+		case share.RecipientModified:
+			result.Conflicts = append(result.Conflicts, share.Name+" ("+share.Recipient+")")
+		case password.Version > share.SourceVersion:
+		default:
+			continue
+		}
+
+		if err = s.pushShare(ctx, vaultKey, dataKey, share, password); err != nil {
+			return err
+		}
+
+		result.Pushed++
+	}
+
+	return nil
+}
+
+// pullShare записывает правку получателя в запись владельца.
+func (s *SharesUseCase) pullShare(
+	ctx context.Context,
+	vaultKey *encryption.Key,
+	dataKey *encryption.Key,
+	share *entity.Share,
+	password *entity.Password,
+) error {
+	value, err := dataKey.Decrypt(share.Value)
+	if err != nil {
+		return err
+	}
+
+	password.Value = value
+	password.Meta = share.Meta
+
+	if err = password.Close(vaultKey); err != nil {
+		return err
+	}
+
+	password.BumpVersion()
+
+	return s.passwordsUC.UpdatePassword(ctx, password)
+}
+
+func (s *SharesUseCase) pushShare(
+	ctx context.Context,
+	vaultKey *encryption.Key,
+	dataKey *encryption.Key,
+	share *entity.Share,
+	password *entity.Password,
+) error {
+	value, err := vaultKey.Decrypt(password.Value)
+	if err != nil {
+		return err
+	}
+
+	value, err = dataKey.Encrypt(value)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.sharesClient.Update(ctx, &pb.ShareUpdateRequest{
+		Id:            int32(share.ID), //nolint:gosec
+		Value:         value,
+		Meta:          password.Meta,
+		Version:       int32(share.Version),    //nolint:gosec
+		SourceVersion: int32(password.Version), //nolint:gosec
+	})
+	if err != nil {
+		return shareError(err, entity.ErrShareNotFound)
+	}
+
+	return nil
+}
+
+func (s *SharesUseCase) syncIncoming(
+	ctx context.Context,
+	vaultKey *encryption.Key,
+	keyPair *encryption.KeyPair,
+	result *SharesSyncResult,
+) error {
+	shares, err := s.fetchShares(ctx, false)
+	if err != nil {
+		return err
+	}
+
+	for _, share := range shares {
+		dataKey, err := openDataKey(keyPair, share.Key)
+		if err != nil {
+			return err
+		}
+
+		if err = share.Open(dataKey); err != nil {
+			return err
+		}
+
+		if err = share.Close(vaultKey); err != nil {
+			return err
+		}
+	}
+
+	result.Incoming = len(shares)
+
+	return s.sharesRepo.ReplaceShares(ctx, shares)
+}
+
+func (s *SharesUseCase) fetchShares(ctx context.Context, outgoing bool) ([]*entity.Share, error) {
+	response, err := s.sharesClient.List(ctx, &pb.ShareListRequest{Outgoing: outgoing})
+	if err != nil {
+		return nil, err
+	}
+
+	shares := make([]*entity.Share, 0, len(response.GetShares()))
+
+	for _, share := range response.GetShares() {
+		shares = append(shares, entity.NewShareFromPB(share))
+	}
+
+	return shares, nil
+}
+
+func openDataKey(keyPair *encryption.KeyPair, sealedKey string) (*encryption.Key, error) {
+	encodedKey, err := keyPair.Open(sealedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return encryption.ParseKey(encodedKey)
+}
+
+// shareError переводит статусы сервера в ошибки entity, notFoundErr уточняет,
+// что именно не найдено в контексте вызова.
+func shareError(err error, notFoundErr error) error {
+	switch status.Code(err) {
+	case codes.NotFound:
+		return notFoundErr
+	case codes.AlreadyExists:
+		return entity.ErrShareAlreadyExists
+	case codes.PermissionDenied:
+		return entity.ErrShareReadOnly
+	case codes.Aborted:
+		return entity.ErrShareVersionConflict
+	case codes.InvalidArgument:
+		return entity.ErrShareWithSelf
+	default:
+		return err
+	}
+}
@@ -0,0 +1,145 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/llravell/go-pass/internal/entity"
+	tokens "github.com/llravell/go-pass/pkg/auth"
+	"github.com/llravell/go-pass/pkg/encryption"
+	pb "github.com/llravell/go-pass/pkg/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// machineKeyInfo отделяет ключ обертки от других ключей, выводимых из секретов.
+const machineKeyInfo = "go-pass machine token collection key"
+
+var tokenErrors = statusErrors{
+	codes.NotFound:         entity.ErrMachineTokenNotFound,
+	codes.PermissionDenied: entity.ErrMachineTokenScope,
+}
+
+// TokensUseCase выдает токены сервисным аккаунтам. Ключ коллекции шифруется
+// ключом, выведенным из секрета, который есть только в самом токене, поэтому
+// владелец токена расшифрует записи своей коллекции и никакие другие.
+type TokensUseCase struct {
+	tokensClient pb.TokensClient
+	orgsUC       *OrgsUseCase
+}
+
+func NewTokensUseCase(tokensClient pb.TokensClient, orgsUC *OrgsUseCase) *TokensUseCase {
+	return &TokensUseCase{
+		tokensClient: tokensClient,
+		orgsUC:       orgsUC,
+	}
+}
+
+// Create выпускает токен для коллекции и возвращает его целиком. Токен
+// показывается один раз: сервер хранит только хеш его серверной части.
+func (t *TokensUseCase) Create(
+	ctx context.Context,
+	vaultKey *encryption.Key,
+	collection *entity.Collection,
+	name string,
+	writable bool,
+	ttl time.Duration,
+) (string, error) {
+	collectionKey, err := t.orgsUC.CollectionKey(ctx, vaultKey, collection)
+	if err != nil {
+		return "", err
+	}
+
+	keySecret, err := tokens.GenerateMachineKeySecret()
+	if err != nil {
+		return "", err
+	}
+
+	wrappingKey, err := encryption.DeriveKey(keySecret, machineKeyInfo)
+	if err != nil {
+		return "", err
+	}
+
+	wrappedKey, err := wrappingKey.Encrypt(collectionKey.String())
+	if err != nil {
+		return "", err
+	}
+
+	response, err := t.tokensClient.Create(ctx, &pb.TokenCreateRequest{
+		Name:         name,
+		CollectionId: int32(collection.ID), //nolint:gosec
+		Writable:     writable,
+		Ttl:          durationpb.New(ttl),
+		WrappedKey:   wrappedKey,
+	})
+	if err != nil {
+		return "", errorFromStatus(err, statusErrors{
+			codes.NotFound:         entity.ErrCollectionNotFound,
+			codes.InvalidArgument:  entity.ErrInvalidMachineToken,
+			codes.PermissionDenied: entity.ErrPermissionDenied,
+		})
+	}
+
+	token := &tokens.MachineToken{
+		ID:         int(response.GetId()),
+		AuthSecret: response.GetAuthSecret(),
+		KeySecret:  keySecret,
+	}
+
+	return token.String(), nil
+}
+
+func (t *TokensUseCase) GetList(ctx context.Context) ([]*entity.MachineToken, error) {
+	response, err := t.tokensClient.List(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	machineTokens := make([]*entity.MachineToken, 0, len(response.GetTokens()))
+
+	for _, token := range response.GetTokens() {
+		machineTokens = append(machineTokens, entity.NewMachineTokenFromPB(token))
+	}
+
+	return machineTokens, nil
+}
+
+func (t *TokensUseCase) Revoke(ctx context.Context, tokenID int) error {
+	_, err := t.tokensClient.Revoke(ctx, &pb.TokenRevokeRequest{
+		Id: int32(tokenID), //nolint:gosec
+	})
+
+	return errorFromStatus(err, tokenErrors)
+}
+
+// Open запрашивает область токена и расшифровывает ключ его коллекции.
+// Вызывается в режиме токена, когда соединение авторизовано им же.
+func (t *TokensUseCase) Open(
+	ctx context.Context,
+	token *tokens.MachineToken,
+) (*entity.MachineToken, *encryption.Key, error) {
+	response, err := t.tokensClient.Self(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, nil, errorFromStatus(err, tokenErrors)
+	}
+
+	machineToken := entity.NewMachineTokenFromPB(response)
+
+	wrappingKey, err := encryption.DeriveKey(token.KeySecret, machineKeyInfo)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	encodedKey, err := wrappingKey.Decrypt(machineToken.WrappedKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	collectionKey, err := encryption.ParseKey(encodedKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return machineToken, collectionKey, nil
+}
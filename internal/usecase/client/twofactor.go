@@ -0,0 +1,40 @@
+package client
+
+import (
+	"context"
+
+	"github.com/llravell/go-pass/internal/entity"
+	pb "github.com/llravell/go-pass/pkg/grpc"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+type TwoFactorUseCase struct {
+	twoFactorClient pb.TwoFactorClient
+}
+
+func NewTwoFactorUseCase(twoFactorClient pb.TwoFactorClient) *TwoFactorUseCase {
+	return &TwoFactorUseCase{
+		twoFactorClient: twoFactorClient,
+	}
+}
+
+func (t *TwoFactorUseCase) Enroll(ctx context.Context) (*entity.TwoFactorEnrollment, error) {
+	resp, err := t.twoFactorClient.Enroll(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.NewTwoFactorEnrollmentFromPB(resp), nil
+}
+
+func (t *TwoFactorUseCase) Confirm(ctx context.Context, code string) error {
+	_, err := t.twoFactorClient.Confirm(ctx, &pb.TwoFactorCodeRequest{Code: code})
+
+	return err
+}
+
+func (t *TwoFactorUseCase) Disable(ctx context.Context, code string) error {
+	_, err := t.twoFactorClient.Disable(ctx, &pb.TwoFactorCodeRequest{Code: code})
+
+	return err
+}
@@ -0,0 +1,180 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/llravell/go-pass/internal/entity"
+	"github.com/llravell/go-pass/pkg/encryption"
+	pb "github.com/llravell/go-pass/pkg/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+var ErrRecipientKeyMismatch = errors.New(
+	"RECIPIENT KEY MISMATCH: recipient public key differs from the pinned one, " +
+		"it may be an attack; if the recipient legitimately changed keys run `gopass keys forget <login>`",
+)
+
+// UserKeysUseCase отвечает за ключевую пару X25519 пользователя, через нее
+// передаются ключи расшаренных записей и коллекций. Пара создается при первом
+// обращении, ее закрытая часть хранится на сервере зашифрованной ключом хранилища.
+//
+// Ключи других пользователей закрепляются локально при первом использовании (TOFU):
+// если сервер позже отдаст другой ключ, операция завершится ошибкой.
+type UserKeysUseCase struct {
+	sharesClient      pb.SharesClient
+	recipientKeysRepo RecipientKeysRepository
+	onNewKey          func(login string, fingerprint string)
+}
+
+func NewUserKeysUseCase(
+	sharesClient pb.SharesClient,
+	recipientKeysRepo RecipientKeysRepository,
+) *UserKeysUseCase {
+	return &UserKeysUseCase{
+		sharesClient:      sharesClient,
+		recipientKeysRepo: recipientKeysRepo,
+		onNewKey:          func(string, string) {},
+	}
+}
+
+// OnNewKey задает обработчик, который вызывается при закреплении ключа нового получателя.
+func (u *UserKeysUseCase) OnNewKey(handler func(login string, fingerprint string)) {
+	u.onNewKey = handler
+}
+
+// KeyPair загружает ключевую пару пользователя, а если ее еще нет — создает.
+func (u *UserKeysUseCase) KeyPair(ctx context.Context, vaultKey *encryption.Key) (*encryption.KeyPair, error) {
+	response, err := u.sharesClient.GetKeyPair(ctx, &emptypb.Empty{})
+	if err == nil {
+		privateKey, err := vaultKey.Decrypt(response.GetEncryptedPrivateKey())
+		if err != nil {
+			return nil, err
+		}
+
+		return encryption.ParseKeyPair(privateKey)
+	}
+
+	if status.Code(err) != codes.NotFound {
+		return nil, err
+	}
+
+	keyPair, err := encryption.GenerateKeyPair()
+	if err != nil {
+		return nil, err
+	}
+
+	encryptedPrivateKey, err := vaultKey.Encrypt(keyPair.String())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = u.sharesClient.SetKeyPair(ctx, &pb.UserKeyPair{
+		PublicKey:           keyPair.PublicKey(),
+		EncryptedPrivateKey: encryptedPrivateKey,
+	})
+	if status.Code(err) == codes.AlreadyExists {
+		// пару одновременно создало другое устройство
+		return u.KeyPair(ctx, vaultKey)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return keyPair, nil
+}
+
+// PublicKey возвращает открытый ключ другого пользователя, сверяя его с закрепленным.
+// Новый ключ закрепляется через TrustPublicKey, после того как сервер принял операцию.
+func (u *UserKeysUseCase) PublicKey(ctx context.Context, login string) (string, error) {
+	response, err := u.sharesClient.GetPublicKey(ctx, &pb.PublicKeyRequest{Login: login})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return "", entity.ErrRecipientNotFound
+		}
+
+		return "", err
+	}
+
+	publicKey := response.GetPublicKey()
+
+	pinned, err := u.recipientKeysRepo.GetRecipientKey(ctx, login)
+	if err != nil {
+		return "", err
+	}
+
+	if len(pinned) > 0 && pinned != publicKey {
+		return "", fmt.Errorf(
+			"%w (%s: pinned %s, got %s)",
+			ErrRecipientKeyMismatch,
+			login,
+			fingerprintOrRaw(pinned),
+			fingerprintOrRaw(publicKey),
+		)
+	}
+
+	return publicKey, nil
+}
+
+// TrustPublicKey закрепляет ключ получателя, если он еще не закреплен.
+func (u *UserKeysUseCase) TrustPublicKey(ctx context.Context, login string, publicKey string) error {
+	pinned, err := u.recipientKeysRepo.GetRecipientKey(ctx, login)
+	if err != nil || len(pinned) > 0 {
+		return err
+	}
+
+	fingerprint, err := encryption.PublicKeyFingerprint(publicKey)
+	if err != nil {
+		return err
+	}
+
+	if err = u.recipientKeysRepo.PinRecipientKey(ctx, login, publicKey); err != nil {
+		return err
+	}
+
+	u.onNewKey(login, fingerprint)
+
+	return nil
+}
+
+// Fingerprint возвращает отпечаток собственного ключа пользователя.
+func (u *UserKeysUseCase) Fingerprint(ctx context.Context, vaultKey *encryption.Key) (string, error) {
+	keyPair, err := u.KeyPair(ctx, vaultKey)
+	if err != nil {
+		return "", err
+	}
+
+	return encryption.PublicKeyFingerprint(keyPair.PublicKey())
+}
+
+// RecipientFingerprint возвращает отпечаток закрепленного ключа получателя.
+func (u *UserKeysUseCase) RecipientFingerprint(ctx context.Context, login string) (string, error) {
+	pinned, err := u.recipientKeysRepo.GetRecipientKey(ctx, login)
+	if err != nil {
+		return "", err
+	}
+
+	if len(pinned) == 0 {
+		return "", entity.ErrRecipientKeyNotPinned
+	}
+
+	return encryption.PublicKeyFingerprint(pinned)
+}
+
+// ForgetRecipientKey снимает закрепление, следующий ключ получателя будет закреплен заново.
+func (u *UserKeysUseCase) ForgetRecipientKey(ctx context.Context, login string) error {
+	return u.recipientKeysRepo.DeleteRecipientKey(ctx, login)
+}
+
+func fingerprintOrRaw(publicKey string) string {
+	fingerprint, err := encryption.PublicKeyFingerprint(publicKey)
+	if err != nil {
+		return publicKey
+	}
+
+	return fingerprint
+}
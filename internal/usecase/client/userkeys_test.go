@@ -0,0 +1,112 @@
+package client_test
+
+import (
+	"context"
+	"testing"
+
+	usecase "github.com/llravell/go-pass/internal/usecase/client"
+	"github.com/llravell/go-pass/pkg/encryption"
+	pb "github.com/llravell/go-pass/pkg/grpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+type recipientKeysRepoStub struct {
+	keys map[string]string
+}
+
+func (r *recipientKeysRepoStub) GetRecipientKey(_ context.Context, login string) (string, error) {
+	return r.keys[login], nil
+}
+
+func (r *recipientKeysRepoStub) PinRecipientKey(_ context.Context, login string, publicKey string) error {
+	if _, ok := r.keys[login]; !ok {
+		r.keys[login] = publicKey
+	}
+
+	return nil
+}
+
+func (r *recipientKeysRepoStub) DeleteRecipientKey(_ context.Context, login string) error {
+	delete(r.keys, login)
+
+	return nil
+}
+
+type sharesClientStub struct {
+	pb.SharesClient
+
+	publicKeys map[string]string
+}
+
+func (c *sharesClientStub) GetPublicKey(
+	_ context.Context,
+	in *pb.PublicKeyRequest,
+	_ ...grpc.CallOption,
+) (*pb.UserPublicKey, error) {
+	return &pb.UserPublicKey{Login: in.GetLogin(), PublicKey: c.publicKeys[in.GetLogin()]}, nil
+}
+
+func generatePublicKey(t *testing.T) string {
+	t.Helper()
+
+	keyPair, err := encryption.GenerateKeyPair()
+	require.NoError(t, err)
+
+	return keyPair.PublicKey()
+}
+
+func TestUserKeysUseCasePublicKey(t *testing.T) {
+	setup := func(t *testing.T) (*usecase.UserKeysUseCase, *sharesClientStub, *[]string) {
+		t.Helper()
+
+		client := &sharesClientStub{publicKeys: map[string]string{"bob": generatePublicKey(t)}}
+		userKeysUC := usecase.NewUserKeysUseCase(client, &recipientKeysRepoStub{keys: map[string]string{}})
+
+		pinned := make([]string, 0)
+		userKeysUC.OnNewKey(func(login string, fingerprint string) {
+			pinned = append(pinned, login+" "+fingerprint)
+		})
+
+		return userKeysUC, client, &pinned
+	}
+
+	t.Run("pins key on first use and shows fingerprint", func(t *testing.T) {
+		userKeysUC, client, pinned := setup(t)
+
+		publicKey, err := userKeysUC.PublicKey(context.Background(), "bob")
+		require.NoError(t, err)
+		require.NoError(t, userKeysUC.TrustPublicKey(context.Background(), "bob", publicKey))
+		require.NoError(t, userKeysUC.TrustPublicKey(context.Background(), "bob", publicKey))
+
+		fingerprint, err := encryption.PublicKeyFingerprint(client.publicKeys["bob"])
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"bob " + fingerprint}, *pinned)
+
+		recipientFingerprint, err := userKeysUC.RecipientFingerprint(context.Background(), "bob")
+		require.NoError(t, err)
+		assert.Equal(t, fingerprint, recipientFingerprint)
+	})
+
+	t.Run("fails when pinned key changes", func(t *testing.T) {
+		userKeysUC, client, _ := setup(t)
+
+		publicKey, err := userKeysUC.PublicKey(context.Background(), "bob")
+		require.NoError(t, err)
+		require.NoError(t, userKeysUC.TrustPublicKey(context.Background(), "bob", publicKey))
+
+		client.publicKeys["bob"] = generatePublicKey(t)
+
+		_, err = userKeysUC.PublicKey(context.Background(), "bob")
+		require.ErrorIs(t, err, usecase.ErrRecipientKeyMismatch)
+
+		// после явного сброса новый ключ принимается
+		require.NoError(t, userKeysUC.ForgetRecipientKey(context.Background(), "bob"))
+
+		publicKey, err = userKeysUC.PublicKey(context.Background(), "bob")
+		require.NoError(t, err)
+		assert.Equal(t, client.publicKeys["bob"], publicKey)
+	})
+}
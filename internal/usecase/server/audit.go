@@ -0,0 +1,87 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"io"
+
+	"github.com/llravell/go-pass/internal/entity"
+	"github.com/llravell/go-pass/pkg/tracing"
+)
+
+const (
+	DefaultAuditPageSize = 50
+	MaxAuditPageSize     = 500
+)
+
+// AuditUseCase ведет журнал аудита. События только добавляются: таблица
+// защищена от изменения и удаления триггером.
+type AuditUseCase struct {
+	repo      AuditRepository
+	usersRepo UserRepository
+}
+
+func NewAuditUseCase(repo AuditRepository, usersRepo UserRepository) *AuditUseCase {
+	return &AuditUseCase{
+		repo:      repo,
+		usersRepo: usersRepo,
+	}
+}
+
+// Record сохраняет событие. Событие входа приходит без пользователя, его
+// находим по логину, чтобы владелец аккаунта видел и чужие попытки входа.
+func (uc *AuditUseCase) Record(ctx context.Context, event *entity.AuditEvent) error {
+	ctx, span := tracing.Start(ctx, "AuditUseCase.Record")
+	defer span.End()
+
+	if event.UserID == 0 && len(event.Login) > 0 {
+		user, err := uc.usersRepo.FindUserByLogin(ctx, event.Login)
+
+		switch {
+		case err == nil:
+			event.UserID = user.ID
+		case !errors.Is(err, sql.ErrNoRows):
+			return err
+		}
+	}
+
+	return uc.repo.AppendAuditEvent(ctx, event)
+}
+
+// GetList возвращает страницу событий пользователя, начиная с самых новых.
+func (uc *AuditUseCase) GetList(
+	ctx context.Context,
+	userID int,
+	beforeID int64,
+	limit int,
+) ([]*entity.AuditEvent, error) {
+	ctx, span := tracing.Start(ctx, "AuditUseCase.GetList")
+	defer span.End()
+
+	if limit <= 0 {
+		limit = DefaultAuditPageSize
+	}
+
+	limit = min(limit, MaxAuditPageSize)
+
+	return uc.repo.GetAuditEvents(ctx, userID, beforeID, limit)
+}
+
+// Export пишет события в w в формате JSON Lines, по одному на строку.
+func (uc *AuditUseCase) Export(ctx context.Context, filter entity.AuditFilter, w io.Writer) (int, error) {
+	ctx, span := tracing.Start(ctx, "AuditUseCase.Export")
+	defer span.End()
+
+	encoder := json.NewEncoder(w)
+	count := 0
+
+	err := uc.repo.ExportAuditEvents(ctx, filter, func(event *entity.AuditEvent) error {
+		count++
+
+		return encoder.Encode(event)
+	})
+
+	return count, err
+}
@@ -2,26 +2,90 @@ package server
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"sync"
 	"time"
 
 	"github.com/llravell/go-pass/internal/entity"
+	tokens "github.com/llravell/go-pass/pkg/auth"
+	"github.com/llravell/go-pass/pkg/tracing"
 	"golang.org/x/crypto/bcrypt"
 )
 
+var dummyPasswordHash = sync.OnceValue(func() []byte {
+	hash, _ := bcrypt.GenerateFromPassword([]byte("go-pass dummy password"), bcrypt.DefaultCost)
+
+	return hash
+})
+
+const RefreshTokensCleanupInterval = time.Hour
+
+// AuthSettings — параметры аккаунтов и токенов, их можно менять без перезапуска.
+type AuthSettings struct {
+	AccessTokenTTL  time.Duration
+	RefreshTokenTTL time.Duration
+	BcryptCost      int
+	// RegistrationClosed запрещает создавать новые аккаунты
+	RegistrationClosed bool
+	// RefreshTokensRetention - сколько хранить истекшие refresh токены, 0 - не удалять
+	RefreshTokensRetention time.Duration
+}
+
 type AuthUseCase struct {
-	repo      UserRepository
-	jwtIssuer JWTIssuer
+	repo              UserRepository
+	refreshTokensRepo RefreshTokensRepository
+	devicesRepo       DevicesRepository
+	devicesUC         *DevicesUseCase
+	jwtIssuer         JWTIssuer
+
+	mu       sync.RWMutex
+	settings AuthSettings
 }
 
-func NewAuthUseCase(repo UserRepository, jwtIssuer JWTIssuer) *AuthUseCase {
+func NewAuthUseCase(
+	repo UserRepository,
+	refreshTokensRepo RefreshTokensRepository,
+	devicesRepo DevicesRepository,
+	devicesUC *DevicesUseCase,
+	jwtIssuer JWTIssuer,
+	settings AuthSettings,
+) *AuthUseCase {
 	return &AuthUseCase{
-		repo:      repo,
-		jwtIssuer: jwtIssuer,
+		repo:              repo,
+		refreshTokensRepo: refreshTokensRepo,
+		devicesRepo:       devicesRepo,
+		devicesUC:         devicesUC,
+		jwtIssuer:         jwtIssuer,
+		settings:          settings,
 	}
 }
 
+// SetSettings применяет новые настройки, уже выданные токены сохраняют свой срок.
+func (auth *AuthUseCase) SetSettings(settings AuthSettings) {
+	auth.mu.Lock()
+	defer auth.mu.Unlock()
+
+	auth.settings = settings
+}
+
+func (auth *AuthUseCase) currentSettings() AuthSettings {
+	auth.mu.RLock()
+	defer auth.mu.RUnlock()
+
+	return auth.settings
+}
+
 func (auth *AuthUseCase) RegisterUser(ctx context.Context, login string, password string) (*entity.User, error) {
-	passwordBytes, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	ctx, span := tracing.Start(ctx, "AuthUseCase.RegisterUser")
+	defer span.End()
+
+	settings := auth.currentSettings()
+	if settings.RegistrationClosed {
+		return nil, entity.ErrRegistrationClosed
+	}
+
+	passwordBytes, err := hashPassword(ctx, password, settings.BcryptCost)
 	if err != nil {
 		return nil, err
 	}
@@ -33,16 +97,221 @@ func (auth *AuthUseCase) BuildUserToken(user *entity.User, ttl time.Duration) (s
 	return auth.jwtIssuer.Issue(user.ID, ttl)
 }
 
+// IssueTokens регистрирует устройство и выдает ему пару токенов
+// с новым семейством refresh-токенов.
+func (auth *AuthUseCase) IssueTokens(
+	ctx context.Context,
+	user *entity.User,
+	device *entity.Device,
+) (*entity.TokenPair, error) {
+	ctx, span := tracing.Start(ctx, "AuthUseCase.IssueTokens")
+	defer span.End()
+
+	device.UserID = user.ID
+
+	err := auth.devicesRepo.CreateDevice(ctx, device)
+	if err != nil {
+		return nil, err
+	}
+
+	familyID, err := tokens.GenerateTokenFamilyID()
+	if err != nil {
+		return nil, err
+	}
+
+	pair, refreshToken, err := auth.buildTokenPair(user.ID, device.ID, familyID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = auth.refreshTokensRepo.StoreRefreshToken(ctx, refreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return pair, nil
+}
+
+// RefreshTokens меняет refresh-токен на новую пару. Предъявленный токен
+// становится использованным; его повторное предъявление отзывает все семейство.
+// Данные устройства (ip, версия клиента) обновляются при каждом обмене.
+func (auth *AuthUseCase) RefreshTokens(
+	ctx context.Context,
+	token string,
+	seen *entity.Device,
+) (*entity.TokenPair, error) {
+	ctx, span := tracing.Start(ctx, "AuthUseCase.RefreshTokens")
+	defer span.End()
+
+	var (
+		pair           *entity.TokenPair
+		reusedFamilyID string
+	)
+
+	err := auth.refreshTokensRepo.RotateRefreshToken(
+		ctx,
+		tokens.HashRefreshToken(token),
+		func(current *entity.RefreshToken) (*entity.RefreshToken, error) {
+			if current.Revoked {
+				return nil, entity.ErrRefreshTokenRevoked
+			}
+
+			if current.Used {
+				reusedFamilyID = current.FamilyID
+
+				return nil, entity.ErrRefreshTokenReused
+			}
+
+			if current.Expired(time.Now()) {
+				return nil, entity.ErrRefreshTokenExpired
+			}
+
+			nextPair, next, err := auth.buildTokenPair(current.UserID, current.DeviceID, current.FamilyID)
+			if err != nil {
+				return nil, err
+			}
+
+			pair = nextPair
+			seen.ID = current.DeviceID
+
+			return next, nil
+		},
+	)
+
+	if errors.Is(err, entity.ErrRefreshTokenReused) {
+		revokeErr := auth.refreshTokensRepo.RevokeRefreshTokenFamily(ctx, reusedFamilyID)
+		if revokeErr != nil {
+			return nil, errors.Join(err, revokeErr)
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if seen.ID > 0 {
+		err = auth.devicesRepo.TouchDevice(ctx, seen)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return pair, nil
+}
+
+// Logout завершает сессию устройства, которому выдан refresh-токен. Устройство
+// отзывается через DevicesUseCase, чтобы его access-токен сразу перестал
+// приниматься хотя бы на этом экземпляре. Токены без устройства отзываются всем семейством.
+func (auth *AuthUseCase) Logout(ctx context.Context, token string) error {
+	ctx, span := tracing.Start(ctx, "AuthUseCase.Logout")
+	defer span.End()
+
+	refreshToken, err := auth.refreshTokensRepo.FindRefreshToken(ctx, tokens.HashRefreshToken(token))
+	if err != nil {
+		return err
+	}
+
+	if refreshToken.DeviceID > 0 {
+		return auth.devicesUC.RevokeDevice(ctx, refreshToken.UserID, refreshToken.DeviceID)
+	}
+
+	return auth.refreshTokensRepo.RevokeRefreshTokenFamily(ctx, refreshToken.FamilyID)
+}
+
+// VerifyUser проверяет логин и пароль. Для неизвестного логина пароль сверяется
+// с фиктивным хешем, чтобы по времени ответа нельзя было узнать, есть ли такой пользователь.
 func (auth *AuthUseCase) VerifyUser(ctx context.Context, login string, password string) (*entity.User, error) {
+	ctx, span := tracing.Start(ctx, "AuthUseCase.VerifyUser")
+	defer span.End()
+
 	user, err := auth.repo.FindUserByLogin(ctx, login)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			_ = comparePassword(ctx, dummyPasswordHash(), password)
+
+			return nil, entity.ErrInvalidCredentials
+		}
+
 		return nil, err
 	}
 
-	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password))
+	err = comparePassword(ctx, []byte(user.Password), password)
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return nil, entity.ErrInvalidCredentials
+	}
+
 	if err != nil {
 		return nil, err
 	}
 
 	return user, nil
 }
+
+func (auth *AuthUseCase) buildTokenPair(
+	userID int,
+	deviceID int,
+	familyID string,
+) (*entity.TokenPair, *entity.RefreshToken, error) {
+	settings := auth.currentSettings()
+
+	accessToken, err := auth.jwtIssuer.IssueForDevice(userID, deviceID, settings.AccessTokenTTL)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	refreshToken, refreshTokenHash, err := tokens.GenerateRefreshToken()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pair := &entity.TokenPair{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}
+
+	return pair, &entity.RefreshToken{
+		Hash:      refreshTokenHash,
+		FamilyID:  familyID,
+		UserID:    userID,
+		DeviceID:  deviceID,
+		ExpiresAt: time.Now().Add(settings.RefreshTokenTTL),
+	}, nil
+}
+
+// Run периодически удаляет refresh токены, истекшие раньше окна хранения,
+// до отмены контекста. Окно берется из текущих настроек на каждом проходе.
+func (auth *AuthUseCase) Run(ctx context.Context, onError func(err error)) {
+	ticker := time.NewTicker(RefreshTokensCleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			retention := auth.currentSettings().RefreshTokensRetention
+			if retention <= 0 {
+				continue
+			}
+
+			if _, err := auth.refreshTokensRepo.DeleteExpiredRefreshTokens(ctx, time.Now().Add(-retention)); err != nil {
+				onError(err)
+			}
+		}
+	}
+}
+
+// bcrypt намеренно медленный, отдельный спан отделяет его время от запросов к базе.
+func hashPassword(ctx context.Context, password string, cost int) ([]byte, error) {
+	_, span := tracing.Start(ctx, "bcrypt.GenerateFromPassword")
+	defer span.End()
+
+	return bcrypt.GenerateFromPassword([]byte(password), cost)
+}
+
+func comparePassword(ctx context.Context, hash []byte, password string) error {
+	_, span := tracing.Start(ctx, "bcrypt.CompareHashAndPassword")
+	defer span.End()
+
+	return bcrypt.CompareHashAndPassword(hash, []byte(password))
+}
@@ -0,0 +1,176 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/llravell/go-pass/internal/entity"
+	"github.com/llravell/go-pass/pkg/tracing"
+)
+
+// DevicesUseCase управляет устройствами пользователя. Список отозванных устройств
+// кешируется и перечитывается из базы раз в cacheTTL, поэтому отзыв на одном
+// инстансе сервера применяется на остальных не позже чем через cacheTTL.
+// Загружаются только устройства, отозванные за последние window: токены,
+// выданные раньше, к этому моменту уже истекли.
+type DevicesUseCase struct {
+	repo     DevicesRepository
+	cacheTTL time.Duration
+	now      func() time.Time
+
+	// reloadMu не дает нескольким запросам одновременно перечитывать список
+	reloadMu sync.Mutex
+
+	mu       sync.RWMutex
+	window   time.Duration
+	revoked  map[int]struct{}
+	local    map[int]time.Time
+	loadedAt time.Time
+}
+
+func NewDevicesUseCase(repo DevicesRepository, cacheTTL time.Duration, window time.Duration) *DevicesUseCase {
+	return &DevicesUseCase{
+		repo:     repo,
+		cacheTTL: cacheTTL,
+		window:   window,
+		now:      time.Now,
+		revoked:  make(map[int]struct{}),
+		local:    make(map[int]time.Time),
+	}
+}
+
+// SetWindow применяет новый срок жизни refresh токена. Окно только растет:
+// токены, выданные с прежним сроком, еще могут быть действительны.
+func (uc *DevicesUseCase) SetWindow(window time.Duration) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	uc.window = max(uc.window, window)
+}
+
+// SetClock подменяет источник текущего времени.
+func (uc *DevicesUseCase) SetClock(now func() time.Time) {
+	uc.now = now
+}
+
+func (uc *DevicesUseCase) GetList(
+	ctx context.Context,
+	userID int,
+	currentDeviceID int,
+) ([]*entity.Device, error) {
+	ctx, span := tracing.Start(ctx, "DevicesUseCase.GetList")
+	defer span.End()
+
+	devices, err := uc.repo.GetDevices(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, device := range devices {
+		device.Current = device.ID == currentDeviceID
+	}
+
+	return devices, nil
+}
+
+func (uc *DevicesUseCase) RevokeDevice(
+	ctx context.Context,
+	userID int,
+	deviceID int,
+) error {
+	ctx, span := tracing.Start(ctx, "DevicesUseCase.RevokeDevice")
+	defer span.End()
+
+	err := uc.repo.RevokeDevice(ctx, userID, deviceID)
+	if err != nil {
+		return err
+	}
+
+	uc.mu.Lock()
+	uc.revoked[deviceID] = struct{}{}
+	uc.local[deviceID] = uc.now()
+	uc.mu.Unlock()
+
+	return nil
+}
+
+func (uc *DevicesUseCase) IsDeviceRevoked(ctx context.Context, deviceID int) (bool, error) {
+	ctx, span := tracing.Start(ctx, "DevicesUseCase.IsDeviceRevoked")
+	defer span.End()
+
+	uc.mu.RLock()
+	fresh := uc.now().Sub(uc.loadedAt) < uc.cacheTTL
+	loaded := !uc.loadedAt.IsZero()
+	_, revoked := uc.revoked[deviceID]
+	uc.mu.RUnlock()
+
+	if fresh {
+		return revoked, nil
+	}
+
+	if err := uc.reloadRevoked(ctx, loaded); err != nil {
+		return false, err
+	}
+
+	uc.mu.RLock()
+	defer uc.mu.RUnlock()
+
+	_, revoked = uc.revoked[deviceID]
+
+	return revoked, nil
+}
+
+// reloadRevoked перечитывает список без блокировки кеша и подменяет его целиком.
+// Если список уже перечитывает другой запрос, а кеш когда-то был загружен,
+// используется устаревший кеш.
+func (uc *DevicesUseCase) reloadRevoked(ctx context.Context, loaded bool) error {
+	if loaded {
+		if !uc.reloadMu.TryLock() {
+			return nil
+		}
+	} else {
+		uc.reloadMu.Lock()
+	}
+	defer uc.reloadMu.Unlock()
+
+	startedAt := uc.now()
+
+	uc.mu.RLock()
+	fresh := startedAt.Sub(uc.loadedAt) < uc.cacheTTL
+	window := uc.window
+	uc.mu.RUnlock()
+
+	if fresh {
+		return nil
+	}
+
+	ids, err := uc.repo.GetRevokedDeviceIDs(ctx, startedAt.Add(-window))
+	if err != nil {
+		return err
+	}
+
+	revoked := make(map[int]struct{}, len(ids))
+	for _, id := range ids {
+		revoked[id] = struct{}{}
+	}
+
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	// отзывы на этом инстансе, сделанные во время запроса, могли в него не попасть
+	for id, revokedAt := range uc.local {
+		if revokedAt.Before(startedAt) {
+			delete(uc.local, id)
+
+			continue
+		}
+
+		revoked[id] = struct{}{}
+	}
+
+	uc.revoked = revoked
+	uc.loadedAt = startedAt
+
+	return nil
+}
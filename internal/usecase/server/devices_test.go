@@ -0,0 +1,166 @@
+package server_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/llravell/go-pass/internal/entity"
+	usecase "github.com/llravell/go-pass/internal/usecase/server"
+	tokens "github.com/llravell/go-pass/pkg/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type devicesRepoStub struct {
+	usecase.DevicesRepository
+
+	mu      sync.Mutex
+	revoked []int
+	since   []time.Time
+	block   chan struct{}
+	started chan struct{}
+}
+
+func (r *devicesRepoStub) RevokeDevice(_ context.Context, _ int, deviceID int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.revoked = append(r.revoked, deviceID)
+
+	return nil
+}
+
+func (r *devicesRepoStub) GetRevokedDeviceIDs(_ context.Context, since time.Time) ([]int, error) {
+	r.mu.Lock()
+	ids := append([]int(nil), r.revoked...)
+	r.since = append(r.since, since)
+	block, started := r.block, r.started
+	r.mu.Unlock()
+
+	if started != nil {
+		close(started)
+	}
+
+	if block != nil {
+		<-block
+	}
+
+	return ids, nil
+}
+
+func TestDevicesUseCaseIsDeviceRevoked(t *testing.T) {
+	now := time.Date(2025, 4, 23, 12, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	t.Run("loads only devices revoked within window", func(t *testing.T) {
+		repo := &devicesRepoStub{revoked: []int{7}}
+		devicesUC := usecase.NewDevicesUseCase(repo, time.Minute, 24*time.Hour)
+		devicesUC.SetClock(clock)
+
+		revoked, err := devicesUC.IsDeviceRevoked(context.Background(), 7)
+		require.NoError(t, err)
+		assert.True(t, revoked)
+
+		require.Len(t, repo.since, 1)
+		assert.Equal(t, now.Add(-24*time.Hour), repo.since[0])
+
+		// уменьшение срока refresh токена не сужает окно, увеличение расширяет
+		devicesUC.SetWindow(time.Hour)
+		now = now.Add(2 * time.Minute)
+
+		_, err = devicesUC.IsDeviceRevoked(context.Background(), 7)
+		require.NoError(t, err)
+
+		devicesUC.SetWindow(48 * time.Hour)
+		now = now.Add(2 * time.Minute)
+
+		_, err = devicesUC.IsDeviceRevoked(context.Background(), 7)
+		require.NoError(t, err)
+
+		require.Len(t, repo.since, 3)
+		assert.Equal(t, now.Add(-2*time.Minute-24*time.Hour), repo.since[1])
+		assert.Equal(t, now.Add(-48*time.Hour), repo.since[2])
+	})
+
+	t.Run("serves stale cache while reloading", func(t *testing.T) {
+		repo := &devicesRepoStub{revoked: []int{7}}
+		devicesUC := usecase.NewDevicesUseCase(repo, time.Minute, 24*time.Hour)
+		devicesUC.SetClock(clock)
+
+		_, err := devicesUC.IsDeviceRevoked(context.Background(), 7)
+		require.NoError(t, err)
+
+		now = now.Add(2 * time.Minute)
+		repo.block = make(chan struct{})
+		repo.started = make(chan struct{})
+
+		done := make(chan struct{})
+
+		go func() {
+			defer close(done)
+
+			revoked, err := devicesUC.IsDeviceRevoked(context.Background(), 7)
+			assert.NoError(t, err)
+			assert.True(t, revoked)
+		}()
+
+		<-repo.started
+
+		// пока идет запрос в базу, остальные проверки и отзыв не блокируются
+		revoked, err := devicesUC.IsDeviceRevoked(context.Background(), 7)
+		require.NoError(t, err)
+		assert.True(t, revoked)
+
+		require.NoError(t, devicesUC.RevokeDevice(context.Background(), 1, 9))
+
+		close(repo.block)
+		<-done
+
+		// отзыв, сделанный во время перечитывания, не теряется после подмены кеша
+		revoked, err = devicesUC.IsDeviceRevoked(context.Background(), 9)
+		require.NoError(t, err)
+		assert.True(t, revoked)
+	})
+}
+
+type refreshTokensRepoStub struct {
+	usecase.RefreshTokensRepository
+
+	tokens map[string]*entity.RefreshToken
+}
+
+func (r *refreshTokensRepoStub) FindRefreshToken(_ context.Context, hash string) (*entity.RefreshToken, error) {
+	token, ok := r.tokens[hash]
+	if !ok {
+		return nil, entity.ErrRefreshTokenNotFound
+	}
+
+	return token, nil
+}
+
+func TestAuthUseCaseLogoutRevokesCachedDevice(t *testing.T) {
+	now := time.Date(2025, 4, 23, 12, 0, 0, 0, time.UTC)
+
+	devicesRepo := &devicesRepoStub{}
+	devicesUC := usecase.NewDevicesUseCase(devicesRepo, time.Minute, 24*time.Hour)
+	devicesUC.SetClock(func() time.Time { return now })
+
+	refreshTokensRepo := &refreshTokensRepoStub{tokens: map[string]*entity.RefreshToken{
+		tokens.HashRefreshToken("refresh"): {UserID: 1, DeviceID: 5},
+	}}
+	authUC := usecase.NewAuthUseCase(nil, refreshTokensRepo, devicesRepo, devicesUC, nil, usecase.AuthSettings{})
+
+	// кеш загружен до выхода и еще свежий, перечитывать его не нужно
+	revoked, err := devicesUC.IsDeviceRevoked(context.Background(), 5)
+	require.NoError(t, err)
+	require.False(t, revoked)
+
+	require.NoError(t, authUC.Logout(context.Background(), "refresh"))
+
+	revoked, err = devicesUC.IsDeviceRevoked(context.Background(), 5)
+	require.NoError(t, err)
+	assert.True(t, revoked)
+	assert.Len(t, devicesRepo.since, 1)
+}
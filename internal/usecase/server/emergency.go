@@ -0,0 +1,215 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/llravell/go-pass/internal/entity"
+	"github.com/llravell/go-pass/pkg/tracing"
+)
+
+// EmergencyCheckInterval — как часто проверяются истекшие сроки ожидания.
+const EmergencyCheckInterval = time.Minute
+
+// EmergencyUseCase ведет экстренный доступ: владелец назначает доверенные
+// контакты, контакт запрашивает доступ, и если владелец не отклонит запрос
+// за срок ожидания, контакт получает зашифрованный для него ключ хранилища.
+type EmergencyUseCase struct {
+	repo      EmergencyRepository
+	usersRepo UserRepository
+	now       func() time.Time
+}
+
+func NewEmergencyUseCase(repo EmergencyRepository, usersRepo UserRepository) *EmergencyUseCase {
+	return &EmergencyUseCase{
+		repo:      repo,
+		usersRepo: usersRepo,
+		now:       time.Now,
+	}
+}
+
+// SetClock подменяет источник текущего времени.
+func (uc *EmergencyUseCase) SetClock(now func() time.Time) {
+	uc.now = now
+}
+
+func (uc *EmergencyUseCase) Grant(
+	ctx context.Context,
+	grantorID int,
+	granteeLogin string,
+	waitPeriod time.Duration,
+	wrappedKey string,
+) error {
+	ctx, span := tracing.Start(ctx, "EmergencyUseCase.Grant")
+	defer span.End()
+
+	if waitPeriod <= 0 {
+		return entity.ErrInvalidWaitPeriod
+	}
+
+	granteeID, err := uc.findUserID(ctx, granteeLogin, entity.ErrRecipientNotFound)
+	if err != nil {
+		return err
+	}
+
+	if granteeID == grantorID {
+		return entity.ErrEmergencyAccessWithSelf
+	}
+
+	return uc.repo.StoreEmergencyAccess(ctx, &entity.EmergencyAccess{
+		GrantorID:  grantorID,
+		GranteeID:  granteeID,
+		WaitPeriod: waitPeriod,
+		Key:        wrappedKey,
+	})
+}
+
+// Revoke удаляет доверенный контакт. Как и Reject, не отзывает ключ хранилища,
+// который контакт уже получил.
+func (uc *EmergencyUseCase) Revoke(ctx context.Context, grantorID int, granteeLogin string) error {
+	ctx, span := tracing.Start(ctx, "EmergencyUseCase.Revoke")
+	defer span.End()
+
+	granteeID, err := uc.findUserID(ctx, granteeLogin, entity.ErrEmergencyAccessNotFound)
+	if err != nil {
+		return err
+	}
+
+	return uc.repo.DeleteEmergencyAccess(ctx, grantorID, granteeID)
+}
+
+func (uc *EmergencyUseCase) GetList(
+	ctx context.Context,
+	userID int,
+	incoming bool,
+) ([]*entity.EmergencyAccess, error) {
+	ctx, span := tracing.Start(ctx, "EmergencyUseCase.GetList")
+	defer span.End()
+
+	return uc.repo.GetEmergencyAccesses(ctx, userID, incoming)
+}
+
+// Request начинает отсчет срока ожидания. Повторный запрос срок не сдвигает.
+func (uc *EmergencyUseCase) Request(
+	ctx context.Context,
+	granteeID int,
+	grantorLogin string,
+) (*entity.EmergencyAccess, error) {
+	ctx, span := tracing.Start(ctx, "EmergencyUseCase.Request")
+	defer span.End()
+
+	grantorID, err := uc.findUserID(ctx, grantorLogin, entity.ErrEmergencyAccessNotFound)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.repo.UpdateEmergencyAccess(ctx, grantorID, granteeID,
+		func(access *entity.EmergencyAccess) (*entity.EmergencyAccess, error) {
+			if access.Status == entity.EmergencyStatusIdle {
+				access.Status = entity.EmergencyStatusRequested
+				access.RequestedAt = uc.now()
+			}
+
+			return access, nil
+		},
+	)
+}
+
+// Approve позволяет владельцу одобрить запрос, не дожидаясь конца срока.
+func (uc *EmergencyUseCase) Approve(ctx context.Context, grantorID int, granteeLogin string) error {
+	ctx, span := tracing.Start(ctx, "EmergencyUseCase.Approve")
+	defer span.End()
+
+	return uc.updateByGrantor(ctx, grantorID, granteeLogin,
+		func(access *entity.EmergencyAccess) (*entity.EmergencyAccess, error) {
+			if access.Status == entity.EmergencyStatusIdle {
+				return nil, entity.ErrEmergencyAccessNotRequested
+			}
+
+			access.Status = entity.EmergencyStatusApproved
+
+			return access, nil
+		},
+	)
+}
+
+// Reject отклоняет запрос или закрывает одобренный доступ к записям,
+// контакт остается доверенным и может запросить доступ снова. Ключ хранилища,
+// который контакт уже получил после одобрения, этим не отзывается: владельцу
+// нужно сменить мастер-пароль и перешифровать записи.
+func (uc *EmergencyUseCase) Reject(ctx context.Context, grantorID int, granteeLogin string) error {
+	ctx, span := tracing.Start(ctx, "EmergencyUseCase.Reject")
+	defer span.End()
+
+	return uc.updateByGrantor(ctx, grantorID, granteeLogin,
+		func(access *entity.EmergencyAccess) (*entity.EmergencyAccess, error) {
+			access.Status = entity.EmergencyStatusIdle
+			access.RequestedAt = time.Time{}
+
+			return access, nil
+		},
+	)
+}
+
+// ApproveExpired одобряет запросы, которые владелец не отклонил вовремя.
+func (uc *EmergencyUseCase) ApproveExpired(ctx context.Context) (int64, error) {
+	ctx, span := tracing.Start(ctx, "EmergencyUseCase.ApproveExpired")
+	defer span.End()
+
+	return uc.repo.ApproveExpiredEmergencyAccess(ctx, uc.now())
+}
+
+// Run периодически проверяет сроки ожидания до отмены контекста.
+func (uc *EmergencyUseCase) Run(ctx context.Context, onApproved func(count int64), onError func(err error)) {
+	ticker := time.NewTicker(EmergencyCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			count, err := uc.ApproveExpired(ctx)
+			if err != nil {
+				onError(err)
+
+				continue
+			}
+
+			if count > 0 {
+				onApproved(count)
+			}
+		}
+	}
+}
+
+func (uc *EmergencyUseCase) updateByGrantor(
+	ctx context.Context,
+	grantorID int,
+	granteeLogin string,
+	updateFn func(access *entity.EmergencyAccess) (*entity.EmergencyAccess, error),
+) error {
+	granteeID, err := uc.findUserID(ctx, granteeLogin, entity.ErrEmergencyAccessNotFound)
+	if err != nil {
+		return err
+	}
+
+	_, err = uc.repo.UpdateEmergencyAccess(ctx, grantorID, granteeID, updateFn)
+
+	return err
+}
+
+func (uc *EmergencyUseCase) findUserID(ctx context.Context, login string, notFoundErr error) (int, error) {
+	user, err := uc.usersRepo.FindUserByLogin(ctx, login)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, notFoundErr
+		}
+
+		return 0, err
+	}
+
+	return user.ID, nil
+}
@@ -0,0 +1,227 @@
+package server_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/llravell/go-pass/internal/entity"
+	usecase "github.com/llravell/go-pass/internal/usecase/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type usersRepoStub struct {
+	usecase.UserRepository
+
+	users map[string]int
+}
+
+func (r *usersRepoStub) FindUserByLogin(_ context.Context, login string) (*entity.User, error) {
+	id, ok := r.users[login]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+
+	return &entity.User{ID: id, Login: login}, nil
+}
+
+// emergencyRepoStub хранит доступы в памяти и одобряет их по тем же правилам,
+// что и запрос в базу.
+type emergencyRepoStub struct {
+	usecase.EmergencyRepository
+
+	accesses map[[2]int]*entity.EmergencyAccess
+}
+
+func newEmergencyRepoStub() *emergencyRepoStub {
+	return &emergencyRepoStub{accesses: map[[2]int]*entity.EmergencyAccess{}}
+}
+
+func (r *emergencyRepoStub) StoreEmergencyAccess(_ context.Context, access *entity.EmergencyAccess) error {
+	stored := *access
+	r.accesses[[2]int{access.GrantorID, access.GranteeID}] = &stored
+
+	return nil
+}
+
+func (r *emergencyRepoStub) GetEmergencyAccessByGrantor(
+	_ context.Context,
+	granteeID int,
+	grantor string,
+) (*entity.EmergencyAccess, error) {
+	for key, access := range r.accesses {
+		if key[1] == granteeID && access.Grantor == grantor {
+			return access, nil
+		}
+	}
+
+	return nil, entity.ErrEmergencyAccessNotFound
+}
+
+func (r *emergencyRepoStub) UpdateEmergencyAccess(
+	_ context.Context,
+	grantorID int,
+	granteeID int,
+	updateFn func(access *entity.EmergencyAccess) (*entity.EmergencyAccess, error),
+) (*entity.EmergencyAccess, error) {
+	access, ok := r.accesses[[2]int{grantorID, granteeID}]
+	if !ok {
+		return nil, entity.ErrEmergencyAccessNotFound
+	}
+
+	current := *access
+
+	updated, err := updateFn(&current)
+	if err != nil {
+		return nil, err
+	}
+
+	*access = *updated
+
+	return access, nil
+}
+
+func (r *emergencyRepoStub) ApproveExpiredEmergencyAccess(_ context.Context, now time.Time) (int64, error) {
+	var count int64
+
+	for _, access := range r.accesses {
+		if access.Status == entity.EmergencyStatusRequested && !access.AvailableAt().After(now) {
+			access.Status = entity.EmergencyStatusApproved
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+func TestEmergencyUseCase(t *testing.T) {
+	const (
+		aliceID = 1
+		bobID   = 2
+		wait    = 72 * time.Hour
+	)
+
+	setup := func(t *testing.T) (*usecase.EmergencyUseCase, *emergencyRepoStub, func(d time.Duration)) {
+		t.Helper()
+
+		now := time.Date(2025, 4, 19, 12, 0, 0, 0, time.UTC)
+		repo := newEmergencyRepoStub()
+		users := &usersRepoStub{users: map[string]int{"alice": aliceID, "bob": bobID}}
+
+		emergencyUC := usecase.NewEmergencyUseCase(repo, users)
+		emergencyUC.SetClock(func() time.Time { return now })
+
+		require.NoError(t, emergencyUC.Grant(context.Background(), aliceID, "bob", wait, "wrapped-key"))
+		repo.accesses[[2]int{aliceID, bobID}].Grantor = "alice"
+
+		return emergencyUC, repo, func(d time.Duration) { now = now.Add(d) }
+	}
+
+	access := func(repo *emergencyRepoStub) *entity.EmergencyAccess {
+		return repo.accesses[[2]int{aliceID, bobID}]
+	}
+
+	t.Run("approves request only after waiting period", func(t *testing.T) {
+		emergencyUC, repo, advance := setup(t)
+
+		requested, err := emergencyUC.Request(context.Background(), bobID, "alice")
+		require.NoError(t, err)
+		assert.Equal(t, entity.EmergencyStatusRequested, requested.Status)
+
+		advance(wait - time.Second)
+
+		count, err := emergencyUC.ApproveExpired(context.Background())
+		require.NoError(t, err)
+		assert.Zero(t, count)
+		assert.Equal(t, entity.EmergencyStatusRequested, access(repo).Status)
+
+		advance(time.Second)
+
+		count, err = emergencyUC.ApproveExpired(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+		assert.True(t, access(repo).Approved())
+	})
+
+	t.Run("repeated request does not restart waiting period", func(t *testing.T) {
+		emergencyUC, repo, advance := setup(t)
+
+		first, err := emergencyUC.Request(context.Background(), bobID, "alice")
+		require.NoError(t, err)
+
+		availableAt := first.AvailableAt()
+
+		advance(time.Hour)
+
+		_, err = emergencyUC.Request(context.Background(), bobID, "alice")
+		require.NoError(t, err)
+		assert.Equal(t, availableAt, access(repo).AvailableAt())
+	})
+
+	t.Run("rejected request is not approved", func(t *testing.T) {
+		emergencyUC, repo, advance := setup(t)
+
+		_, err := emergencyUC.Request(context.Background(), bobID, "alice")
+		require.NoError(t, err)
+
+		advance(time.Hour)
+		require.NoError(t, emergencyUC.Reject(context.Background(), aliceID, "bob"))
+
+		advance(wait)
+
+		count, err := emergencyUC.ApproveExpired(context.Background())
+		require.NoError(t, err)
+		assert.Zero(t, count)
+		assert.Equal(t, entity.EmergencyStatusIdle, access(repo).Status)
+	})
+
+	t.Run("approve requires request", func(t *testing.T) {
+		emergencyUC, _, _ := setup(t)
+
+		err := emergencyUC.Approve(context.Background(), aliceID, "bob")
+		require.ErrorIs(t, err, entity.ErrEmergencyAccessNotRequested)
+	})
+}
+
+func TestPasswordsUseCaseGetEmergencyList(t *testing.T) {
+	const (
+		aliceID = 1
+		bobID   = 2
+	)
+
+	setup := func(status entity.EmergencyStatus) *usecase.PasswordsUseCase {
+		emergencyRepo := newEmergencyRepoStub()
+		emergencyRepo.accesses[[2]int{aliceID, bobID}] = &entity.EmergencyAccess{
+			GrantorID: aliceID,
+			Grantor:   "alice",
+			GranteeID: bobID,
+			Status:    status,
+		}
+
+		repo := newPasswordsRepoStub()
+		_ = repo.AddNewPassword(context.Background(), aliceID, &entity.Password{Name: "mail"}, nil)
+
+		return usecase.NewPasswordsUseCase(repo, newOrgsRepoStub(nil), emergencyRepo, usecase.Quotas{})
+	}
+
+	for _, status := range []entity.EmergencyStatus{entity.EmergencyStatusIdle, entity.EmergencyStatusRequested} {
+		t.Run("denied while "+status.String(), func(t *testing.T) {
+			_, err := setup(status).GetEmergencyList(context.Background(), bobID, "alice")
+			require.ErrorIs(t, err, entity.ErrEmergencyAccessNotApproved)
+		})
+	}
+
+	t.Run("returns grantor entries after approval", func(t *testing.T) {
+		passwords, err := setup(entity.EmergencyStatusApproved).GetEmergencyList(context.Background(), bobID, "alice")
+		require.NoError(t, err)
+		require.Len(t, passwords, 1)
+		assert.Equal(t, "mail", passwords[0].Name)
+	})
+
+	t.Run("unknown grantor", func(t *testing.T) {
+		_, err := setup(entity.EmergencyStatusApproved).GetEmergencyList(context.Background(), bobID, "carol")
+		require.ErrorIs(t, err, entity.ErrEmergencyAccessNotFound)
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/llravell/go-pass/internal/entity"
+	tokens "github.com/llravell/go-pass/pkg/auth"
 )
 
 //go:generate ../../../bin/mockgen -source=interfaces.go -destination=../../mocks/mock_usecase_server.go -package=mocks
@@ -15,19 +16,166 @@ type (
 		FindUserByLogin(ctx context.Context, login string) (*entity.User, error)
 	}
 
+	TwoFactorRepository interface {
+		FindUserByID(ctx context.Context, userID int) (*entity.User, error)
+		SetTOTPSecret(ctx context.Context, userID int, secret string, recoveryCodeHashes []string) error
+		EnableTOTP(ctx context.Context, userID int, step int64) error
+		DisableTOTP(ctx context.Context, userID int) error
+		UseTOTPStep(ctx context.Context, userID int, step int64) error
+		GetRecoveryCodes(ctx context.Context, userID int) ([]*entity.RecoveryCode, error)
+		UseRecoveryCode(ctx context.Context, codeID int) error
+	}
+
+	AuthAttemptsRepository interface {
+		GetAttempts(ctx context.Context, keys []string) ([]*entity.AuthAttempts, error)
+		UpdateAttempts(ctx context.Context, key string, updateFn func(attempts *entity.AuthAttempts)) error
+		ResetAttempts(ctx context.Context, key string) error
+	}
+
+	RefreshTokensRepository interface {
+		StoreRefreshToken(ctx context.Context, token *entity.RefreshToken) error
+		FindRefreshToken(ctx context.Context, hash string) (*entity.RefreshToken, error)
+		RotateRefreshToken(
+			ctx context.Context,
+			hash string,
+			rotateFn func(token *entity.RefreshToken) (*entity.RefreshToken, error),
+		) error
+		RevokeRefreshTokenFamily(ctx context.Context, familyID string) error
+		DeleteExpiredRefreshTokens(ctx context.Context, before time.Time) (int64, error)
+	}
+
 	PasswordsRepository interface {
 		UpdateByName(
 			ctx context.Context,
 			userID int,
+			collectionID int,
 			name string,
 			updateFn func(password *entity.Password) (*entity.Password, error),
+			checkFn func(count int) error,
+		) error
+		AddNewPassword(
+			ctx context.Context,
+			userID int,
+			password *entity.Password,
+			checkFn func(count int) error,
+		) error
+		DeletePasswordByName(ctx context.Context, userID int, collectionID int, name string) error
+		GetPasswords(ctx context.Context, userID int, collectionID int) ([]*entity.Password, error)
+	}
+
+	CollectionsRepository interface {
+		GetCollection(ctx context.Context, userID int, collectionID int) (*entity.Collection, error)
+	}
+
+	OrgsRepository interface {
+		CollectionsRepository
+		CreateOrganization(ctx context.Context, name string, ownerID int) (*entity.Organization, error)
+		GetOrganizations(ctx context.Context, userID int) ([]*entity.Organization, error)
+		GetMembership(ctx context.Context, orgName string, userID int) (*entity.Organization, error)
+		GetMembers(ctx context.Context, orgID int) ([]*entity.OrgMember, error)
+		SetMember(
+			ctx context.Context,
+			orgID int,
+			login string,
+			role entity.OrgRole,
+			checkFn func(members []*entity.OrgMember) error,
+		) error
+		RemoveMember(
+			ctx context.Context,
+			orgID int,
+			login string,
+			checkFn func(members []*entity.OrgMember) error,
+		) error
+		CreateCollection(ctx context.Context, collection *entity.Collection, creatorID int) error
+		GetCollections(ctx context.Context, userID int) ([]*entity.Collection, error)
+		GrantCollection(ctx context.Context, collectionID int, login string, wrappedKey string) error
+		RevokeCollection(ctx context.Context, collectionID int, login string) error
+	}
+
+	EmergencyAccessRepository interface {
+		GetEmergencyAccessByGrantor(ctx context.Context, granteeID int, grantor string) (*entity.EmergencyAccess, error)
+	}
+
+	EmergencyRepository interface {
+		EmergencyAccessRepository
+		StoreEmergencyAccess(ctx context.Context, access *entity.EmergencyAccess) error
+		GetEmergencyAccesses(ctx context.Context, userID int, incoming bool) ([]*entity.EmergencyAccess, error)
+		UpdateEmergencyAccess(
+			ctx context.Context,
+			grantorID int,
+			granteeID int,
+			updateFn func(access *entity.EmergencyAccess) (*entity.EmergencyAccess, error),
+		) (*entity.EmergencyAccess, error)
+		DeleteEmergencyAccess(ctx context.Context, grantorID int, granteeID int) error
+		ApproveExpiredEmergencyAccess(ctx context.Context, now time.Time) (int64, error)
+	}
+
+	SendsRepository interface {
+		CreateSend(ctx context.Context, send *entity.Send) error
+		ConsumeSend(ctx context.Context, id string, now time.Time) (*entity.Send, error)
+		GetSends(ctx context.Context, userID int, now time.Time) ([]*entity.Send, error)
+		DeleteSend(ctx context.Context, userID int, id string) error
+		DeleteExpiredSends(ctx context.Context, now time.Time) (int64, error)
+	}
+
+	MachineTokensRepository interface {
+		CreateMachineToken(ctx context.Context, token *entity.MachineToken) error
+		GetMachineToken(ctx context.Context, tokenID int) (*entity.MachineToken, error)
+		GetMachineTokens(ctx context.Context, userID int) ([]*entity.MachineToken, error)
+		RevokeMachineToken(ctx context.Context, userID int, tokenID int) error
+		TouchMachineToken(ctx context.Context, tokenID int, ip string, now time.Time) error
+	}
+
+	AuditRepository interface {
+		AppendAuditEvent(ctx context.Context, event *entity.AuditEvent) error
+		GetAuditEvents(ctx context.Context, userID int, beforeID int64, limit int) ([]*entity.AuditEvent, error)
+		ExportAuditEvents(
+			ctx context.Context,
+			filter entity.AuditFilter,
+			fn func(event *entity.AuditEvent) error,
+		) error
+	}
+
+	DevicesRepository interface {
+		CreateDevice(ctx context.Context, device *entity.Device) error
+		TouchDevice(ctx context.Context, device *entity.Device) error
+		GetDevices(ctx context.Context, userID int) ([]*entity.Device, error)
+		RevokeDevice(ctx context.Context, userID int, deviceID int) error
+		GetRevokedDeviceIDs(ctx context.Context, since time.Time) ([]int, error)
+	}
+
+	SharesRepository interface {
+		SetKeyPair(ctx context.Context, userID int, keyPair *entity.UserKeyPair) error
+		GetKeyPair(ctx context.Context, userID int) (*entity.UserKeyPair, error)
+		GetPublicKeyByLogin(ctx context.Context, login string) (int, string, error)
+		CreateShare(ctx context.Context, share *entity.Share) error
+		GetShares(ctx context.Context, userID int, outgoing bool) ([]*entity.Share, error)
+		UpdateShare(
+			ctx context.Context,
+			shareID int,
+			updateFn func(share *entity.Share) (*entity.Share, error),
 		) error
-		AddNewPassword(ctx context.Context, userID int, password *entity.Password) error
-		DeletePasswordByName(ctx context.Context, userID int, name string) error
-		GetPasswords(ctx context.Context, userID int) ([]*entity.Password, error)
+		DeleteShare(ctx context.Context, userID int, shareID int) error
+	}
+
+	SigningKeysRepository interface {
+		GetSigningKeys(ctx context.Context) ([]*entity.SigningKey, error)
+		StoreSigningKey(ctx context.Context, key *entity.SigningKey) error
+		DeleteSigningKeys(ctx context.Context, ids []string) error
+	}
+
+	KeyRing interface {
+		SetKeys(active *tokens.SigningKey, keys []*tokens.SigningKey)
+		PublicKeys() []*tokens.SigningKey
+	}
+
+	ChallengeIssuer interface {
+		IssueChallenge(userID int, ttl time.Duration) (string, error)
+		ParseChallenge(tokenString string) (int, error)
 	}
 
 	JWTIssuer interface {
 		Issue(userID int, ttl time.Duration) (string, error)
+		IssueForDevice(userID int, deviceID int, ttl time.Duration) (string, error)
 	}
 )
@@ -0,0 +1,117 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"time"
+
+	"github.com/llravell/go-pass/internal/entity"
+	tokens "github.com/llravell/go-pass/pkg/auth"
+	"github.com/llravell/go-pass/pkg/tracing"
+)
+
+// MachineTokensUseCase выдает токены сервисным аккаунтам. Токен действует от
+// имени создателя, но только в пределах одной коллекции и с заданными правами.
+type MachineTokensUseCase struct {
+	repo            MachineTokensRepository
+	collectionsRepo CollectionsRepository
+}
+
+func NewMachineTokensUseCase(
+	repo MachineTokensRepository,
+	collectionsRepo CollectionsRepository,
+) *MachineTokensUseCase {
+	return &MachineTokensUseCase{
+		repo:            repo,
+		collectionsRepo: collectionsRepo,
+	}
+}
+
+// Create сохраняет токен и возвращает его серверный секрет. Токен на запись
+// может выдать только тот, кто сам может изменять коллекцию.
+func (uc *MachineTokensUseCase) Create(
+	ctx context.Context,
+	userID int,
+	token *entity.MachineToken,
+	ttl time.Duration,
+) (string, error) {
+	ctx, span := tracing.Start(ctx, "MachineTokensUseCase.Create")
+	defer span.End()
+
+	if token.Name == "" || ttl <= 0 {
+		return "", entity.ErrInvalidMachineToken
+	}
+
+	collection, err := uc.collectionsRepo.GetCollection(ctx, userID, token.CollectionID)
+	if err != nil {
+		return "", err
+	}
+
+	if token.Writable && !collection.Role.CanWrite() {
+		return "", entity.ErrPermissionDenied
+	}
+
+	secret, secretHash, err := tokens.GenerateMachineSecret()
+	if err != nil {
+		return "", err
+	}
+
+	token.UserID = userID
+	token.SecretHash = secretHash
+	token.ExpiresAt = time.Now().Add(ttl)
+	token.Collection = collection.Path()
+
+	if err = uc.repo.CreateMachineToken(ctx, token); err != nil {
+		return "", err
+	}
+
+	return secret, nil
+}
+
+func (uc *MachineTokensUseCase) GetList(ctx context.Context, userID int) ([]*entity.MachineToken, error) {
+	ctx, span := tracing.Start(ctx, "MachineTokensUseCase.GetList")
+	defer span.End()
+
+	return uc.repo.GetMachineTokens(ctx, userID)
+}
+
+func (uc *MachineTokensUseCase) Revoke(ctx context.Context, userID int, tokenID int) error {
+	ctx, span := tracing.Start(ctx, "MachineTokensUseCase.Revoke")
+	defer span.End()
+
+	return uc.repo.RevokeMachineToken(ctx, userID, tokenID)
+}
+
+// Verify проверяет серверную часть токена и отмечает его использование.
+// Неизвестный, отозванный и истекший токены неотличимы для вызывающего.
+func (uc *MachineTokensUseCase) Verify(ctx context.Context, bearer string, ip string) (*entity.MachineToken, error) {
+	ctx, span := tracing.Start(ctx, "MachineTokensUseCase.Verify")
+	defer span.End()
+
+	parsed, err := tokens.ParseMachineToken(bearer)
+	if err != nil {
+		return nil, entity.ErrMachineTokenNotFound
+	}
+
+	token, err := uc.repo.GetMachineToken(ctx, parsed.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	secretHash := tokens.HashRefreshToken(parsed.AuthSecret)
+	if subtle.ConstantTimeCompare([]byte(secretHash), []byte(token.SecretHash)) != 1 {
+		return nil, entity.ErrMachineTokenNotFound
+	}
+
+	now := time.Now()
+
+	if token.Revoked() || token.Expired(now) {
+		return nil, entity.ErrMachineTokenNotFound
+	}
+
+	if err = uc.repo.TouchMachineToken(ctx, token.ID, ip, now); err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
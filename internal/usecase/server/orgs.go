@@ -0,0 +1,278 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	"github.com/llravell/go-pass/internal/entity"
+	"github.com/llravell/go-pass/pkg/tracing"
+)
+
+// OrgsUseCase управляет организациями, их участниками и коллекциями.
+// Ключи коллекций приходят уже зашифрованными для каждого участника,
+// сервер только хранит их и проверяет права.
+type OrgsUseCase struct {
+	repo OrgsRepository
+}
+
+func NewOrgsUseCase(repo OrgsRepository) *OrgsUseCase {
+	return &OrgsUseCase{
+		repo: repo,
+	}
+}
+
+func (uc *OrgsUseCase) CreateOrganization(
+	ctx context.Context,
+	userID int,
+	name string,
+) (*entity.Organization, error) {
+	ctx, span := tracing.Start(ctx, "OrgsUseCase.CreateOrganization")
+	defer span.End()
+
+	if !validOrgName(name) {
+		return nil, entity.ErrInvalidOrgName
+	}
+
+	return uc.repo.CreateOrganization(ctx, name, userID)
+}
+
+func (uc *OrgsUseCase) GetList(ctx context.Context, userID int) ([]*entity.Organization, error) {
+	ctx, span := tracing.Start(ctx, "OrgsUseCase.GetList")
+	defer span.End()
+
+	return uc.repo.GetOrganizations(ctx, userID)
+}
+
+func (uc *OrgsUseCase) GetMembers(
+	ctx context.Context,
+	userID int,
+	orgName string,
+) ([]*entity.OrgMember, error) {
+	ctx, span := tracing.Start(ctx, "OrgsUseCase.GetMembers")
+	defer span.End()
+
+	org, err := uc.repo.GetMembership(ctx, orgName, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.repo.GetMembers(ctx, org.ID)
+}
+
+// SetMember добавляет участника или меняет его роль. Назначать и менять
+// владельцев может только владелец, последнего владельца понизить нельзя.
+// Права проверяются по составу организации внутри транзакции изменения.
+func (uc *OrgsUseCase) SetMember(
+	ctx context.Context,
+	userID int,
+	orgName string,
+	login string,
+	role entity.OrgRole,
+) error {
+	ctx, span := tracing.Start(ctx, "OrgsUseCase.SetMember")
+	defer span.End()
+
+	if !role.Valid() {
+		return entity.ErrInvalidOrgRole
+	}
+
+	org, err := uc.repo.GetMembership(ctx, orgName, userID)
+	if err != nil {
+		return err
+	}
+
+	return uc.repo.SetMember(ctx, org.ID, login, role, func(members []*entity.OrgMember) error {
+		actor := findMemberByID(members, userID)
+		if actor == nil {
+			return entity.ErrOrgNotFound
+		}
+
+		if !actor.Role.CanManage() {
+			return entity.ErrPermissionDenied
+		}
+
+		target := findMember(members, login)
+		touchesOwner := role == entity.OrgRoleOwner || (target != nil && target.Role == entity.OrgRoleOwner)
+
+		if touchesOwner && actor.Role != entity.OrgRoleOwner {
+			return entity.ErrPermissionDenied
+		}
+
+		if target != nil && target.Role == entity.OrgRoleOwner && role != entity.OrgRoleOwner && countOwners(members) == 1 {
+			return entity.ErrLastOrgOwner
+		}
+
+		return nil
+	})
+}
+
+// RemoveMember исключает участника, выйти из организации может и сам участник.
+// Ключи коллекций, которые он успел получить, не меняются: записи стоит перевыпустить.
+func (uc *OrgsUseCase) RemoveMember(
+	ctx context.Context,
+	userID int,
+	orgName string,
+	login string,
+) error {
+	ctx, span := tracing.Start(ctx, "OrgsUseCase.RemoveMember")
+	defer span.End()
+
+	org, err := uc.repo.GetMembership(ctx, orgName, userID)
+	if err != nil {
+		return err
+	}
+
+	return uc.repo.RemoveMember(ctx, org.ID, login, func(members []*entity.OrgMember) error {
+		actor := findMemberByID(members, userID)
+		if actor == nil {
+			return entity.ErrOrgNotFound
+		}
+
+		target := findMember(members, login)
+		if target == nil {
+			return entity.ErrOrgMemberNotFound
+		}
+
+		if target.UserID != userID {
+			if !actor.Role.CanManage() || (target.Role == entity.OrgRoleOwner && actor.Role != entity.OrgRoleOwner) {
+				return entity.ErrPermissionDenied
+			}
+		}
+
+		if target.Role == entity.OrgRoleOwner && countOwners(members) == 1 {
+			return entity.ErrLastOrgOwner
+		}
+
+		return nil
+	})
+}
+
+// CreateCollection создает коллекцию, wrappedKey — ее ключ, зашифрованный для создателя.
+func (uc *OrgsUseCase) CreateCollection(
+	ctx context.Context,
+	userID int,
+	orgName string,
+	name string,
+	wrappedKey string,
+) (*entity.Collection, error) {
+	ctx, span := tracing.Start(ctx, "OrgsUseCase.CreateCollection")
+	defer span.End()
+
+	if !validOrgName(name) {
+		return nil, entity.ErrInvalidOrgName
+	}
+
+	org, err := uc.repo.GetMembership(ctx, orgName, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !org.Role.CanManage() {
+		return nil, entity.ErrPermissionDenied
+	}
+
+	collection := &entity.Collection{
+		OrgID:   org.ID,
+		OrgName: org.Name,
+		Name:    name,
+		Role:    org.Role,
+		Key:     wrappedKey,
+	}
+
+	if err = uc.repo.CreateCollection(ctx, collection, userID); err != nil {
+		return nil, err
+	}
+
+	return collection, nil
+}
+
+func (uc *OrgsUseCase) GetCollections(ctx context.Context, userID int) ([]*entity.Collection, error) {
+	ctx, span := tracing.Start(ctx, "OrgsUseCase.GetCollections")
+	defer span.End()
+
+	return uc.repo.GetCollections(ctx, userID)
+}
+
+// GrantCollection выдает участнику организации ключ коллекции,
+// зашифрованный администратором для этого участника.
+func (uc *OrgsUseCase) GrantCollection(
+	ctx context.Context,
+	userID int,
+	collectionID int,
+	login string,
+	wrappedKey string,
+) error {
+	ctx, span := tracing.Start(ctx, "OrgsUseCase.GrantCollection")
+	defer span.End()
+
+	if err := uc.authorizeCollectionManagement(ctx, userID, collectionID); err != nil {
+		return err
+	}
+
+	return uc.repo.GrantCollection(ctx, collectionID, login, wrappedKey)
+}
+
+func (uc *OrgsUseCase) RevokeCollection(
+	ctx context.Context,
+	userID int,
+	collectionID int,
+	login string,
+) error {
+	ctx, span := tracing.Start(ctx, "OrgsUseCase.RevokeCollection")
+	defer span.End()
+
+	if err := uc.authorizeCollectionManagement(ctx, userID, collectionID); err != nil {
+		return err
+	}
+
+	return uc.repo.RevokeCollection(ctx, collectionID, login)
+}
+
+func (uc *OrgsUseCase) authorizeCollectionManagement(ctx context.Context, userID int, collectionID int) error {
+	collection, err := uc.repo.GetCollection(ctx, userID, collectionID)
+	if err != nil {
+		return err
+	}
+
+	if !collection.Role.CanManage() {
+		return entity.ErrPermissionDenied
+	}
+
+	return nil
+}
+
+func validOrgName(name string) bool {
+	return strings.TrimSpace(name) != "" && !strings.Contains(name, "/")
+}
+
+func findMember(members []*entity.OrgMember, login string) *entity.OrgMember {
+	for _, member := range members {
+		if member.Login == login {
+			return member
+		}
+	}
+
+	return nil
+}
+
+func findMemberByID(members []*entity.OrgMember, userID int) *entity.OrgMember {
+	for _, member := range members {
+		if member.UserID == userID {
+			return member
+		}
+	}
+
+	return nil
+}
+
+func countOwners(members []*entity.OrgMember) int {
+	owners := 0
+
+	for _, member := range members {
+		if member.Role == entity.OrgRoleOwner {
+			owners++
+		}
+	}
+
+	return owners
+}
@@ -0,0 +1,214 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/llravell/go-pass/internal/entity"
+	usecase "github.com/llravell/go-pass/internal/usecase/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// orgsRepoStub хранит одну организацию и, как и репозиторий, вызывает
+// проверку с актуальным составом перед изменением.
+type orgsRepoStub struct {
+	usecase.OrgsRepository
+
+	members     []*entity.OrgMember
+	collections map[int]map[int]entity.OrgRole
+}
+
+func newOrgsRepoStub(roles map[string]entity.OrgRole) *orgsRepoStub {
+	repo := &orgsRepoStub{collections: map[int]map[int]entity.OrgRole{}}
+
+	for id, login := range []string{"alice", "bob", "carol", "dave", "eve"} {
+		if role, ok := roles[login]; ok {
+			repo.members = append(repo.members, &entity.OrgMember{UserID: id + 1, Login: login, Role: role})
+		}
+	}
+
+	return repo
+}
+
+func (r *orgsRepoStub) member(userID int) *entity.OrgMember {
+	for _, member := range r.members {
+		if member.UserID == userID {
+			return member
+		}
+	}
+
+	return nil
+}
+
+func (r *orgsRepoStub) GetMembership(_ context.Context, orgName string, userID int) (*entity.Organization, error) {
+	member := r.member(userID)
+	if orgName != "acme" || member == nil {
+		return nil, entity.ErrOrgNotFound
+	}
+
+	return &entity.Organization{ID: 1, Name: orgName, Role: member.Role}, nil
+}
+
+func (r *orgsRepoStub) SetMember(
+	_ context.Context,
+	_ int,
+	login string,
+	role entity.OrgRole,
+	checkFn func(members []*entity.OrgMember) error,
+) error {
+	if err := checkFn(r.members); err != nil {
+		return err
+	}
+
+	for _, member := range r.members {
+		if member.Login == login {
+			member.Role = role
+
+			return nil
+		}
+	}
+
+	r.members = append(r.members, &entity.OrgMember{UserID: len(r.members) + 100, Login: login, Role: role})
+
+	return nil
+}
+
+func (r *orgsRepoStub) RemoveMember(
+	_ context.Context,
+	_ int,
+	login string,
+	checkFn func(members []*entity.OrgMember) error,
+) error {
+	if err := checkFn(r.members); err != nil {
+		return err
+	}
+
+	for i, member := range r.members {
+		if member.Login == login {
+			r.members = append(r.members[:i], r.members[i+1:]...)
+
+			return nil
+		}
+	}
+
+	return entity.ErrOrgMemberNotFound
+}
+
+func (r *orgsRepoStub) GetCollection(_ context.Context, userID int, collectionID int) (*entity.Collection, error) {
+	role, ok := r.collections[collectionID][userID]
+	if !ok {
+		return nil, entity.ErrCollectionNotFound
+	}
+
+	return &entity.Collection{ID: collectionID, Role: role}, nil
+}
+
+func TestOrgsUseCaseSetMember(t *testing.T) {
+	roles := map[string]entity.OrgRole{
+		"alice": entity.OrgRoleOwner,
+		"bob":   entity.OrgRoleAdmin,
+		"carol": entity.OrgRoleEditor,
+		"dave":  entity.OrgRoleViewer,
+	}
+
+	tests := []struct {
+		name    string
+		actorID int
+		login   string
+		role    entity.OrgRole
+		wantErr error
+	}{
+		{name: "owner promotes to owner", actorID: 1, login: "bob", role: entity.OrgRoleOwner},
+		{name: "admin adds editor", actorID: 2, login: "eve", role: entity.OrgRoleEditor},
+		{name: "admin cannot grant owner", actorID: 2, login: "carol", role: entity.OrgRoleOwner, wantErr: entity.ErrPermissionDenied},
+		{name: "admin cannot demote owner", actorID: 2, login: "alice", role: entity.OrgRoleViewer, wantErr: entity.ErrPermissionDenied},
+		{name: "editor cannot manage", actorID: 3, login: "dave", role: entity.OrgRoleEditor, wantErr: entity.ErrPermissionDenied},
+		{name: "viewer cannot manage", actorID: 4, login: "eve", role: entity.OrgRoleViewer, wantErr: entity.ErrPermissionDenied},
+		{name: "non-member sees no org", actorID: 5, login: "eve", role: entity.OrgRoleViewer, wantErr: entity.ErrOrgNotFound},
+		{name: "last owner cannot step down", actorID: 1, login: "alice", role: entity.OrgRoleAdmin, wantErr: entity.ErrLastOrgOwner},
+		{name: "invalid role", actorID: 1, login: "bob", role: 0, wantErr: entity.ErrInvalidOrgRole},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orgsUC := usecase.NewOrgsUseCase(newOrgsRepoStub(roles))
+
+			err := orgsUC.SetMember(context.Background(), tt.actorID, "acme", tt.login, tt.role)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("checks owners of the current state", func(t *testing.T) {
+		repo := newOrgsRepoStub(map[string]entity.OrgRole{
+			"alice": entity.OrgRoleOwner,
+			"bob":   entity.OrgRoleOwner,
+		})
+		orgsUC := usecase.NewOrgsUseCase(repo)
+
+		require.NoError(t, orgsUC.SetMember(context.Background(), 1, "acme", "bob", entity.OrgRoleAdmin))
+
+		err := orgsUC.SetMember(context.Background(), 1, "acme", "alice", entity.OrgRoleAdmin)
+		require.ErrorIs(t, err, entity.ErrLastOrgOwner)
+	})
+}
+
+func TestOrgsUseCaseRemoveMember(t *testing.T) {
+	roles := map[string]entity.OrgRole{
+		"alice": entity.OrgRoleOwner,
+		"bob":   entity.OrgRoleAdmin,
+		"carol": entity.OrgRoleEditor,
+		"dave":  entity.OrgRoleViewer,
+	}
+
+	tests := []struct {
+		name    string
+		actorID int
+		login   string
+		wantErr error
+	}{
+		{name: "owner removes admin", actorID: 1, login: "bob"},
+		{name: "admin removes viewer", actorID: 2, login: "dave"},
+		{name: "member leaves", actorID: 4, login: "dave"},
+		{name: "admin cannot remove owner", actorID: 2, login: "alice", wantErr: entity.ErrPermissionDenied},
+		{name: "editor cannot remove others", actorID: 3, login: "dave", wantErr: entity.ErrPermissionDenied},
+		{name: "non-member sees no org", actorID: 5, login: "dave", wantErr: entity.ErrOrgNotFound},
+		{name: "unknown member", actorID: 1, login: "eve", wantErr: entity.ErrOrgMemberNotFound},
+		{name: "last owner cannot leave", actorID: 1, login: "alice", wantErr: entity.ErrLastOrgOwner},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orgsUC := usecase.NewOrgsUseCase(newOrgsRepoStub(roles))
+
+			err := orgsUC.RemoveMember(context.Background(), tt.actorID, "acme", tt.login)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("one of two owners can leave, the other cannot", func(t *testing.T) {
+		repo := newOrgsRepoStub(map[string]entity.OrgRole{
+			"alice": entity.OrgRoleOwner,
+			"bob":   entity.OrgRoleOwner,
+		})
+		orgsUC := usecase.NewOrgsUseCase(repo)
+
+		require.NoError(t, orgsUC.RemoveMember(context.Background(), 2, "acme", "bob"))
+
+		err := orgsUC.RemoveMember(context.Background(), 1, "acme", "alice")
+		require.ErrorIs(t, err, entity.ErrLastOrgOwner)
+		assert.Len(t, repo.members, 1)
+	})
+}
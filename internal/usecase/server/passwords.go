@@ -3,41 +3,125 @@ package server
 import (
 	"context"
 	"errors"
+	"sync"
 
 	"github.com/llravell/go-pass/internal/entity"
+	"github.com/llravell/go-pass/pkg/tracing"
 )
 
+// Quotas ограничивает размер записей и их число в личном хранилище
+// или коллекции, 0 снимает ограничение.
+type Quotas struct {
+	// MaxEntrySize - предел суммарного размера имени, шифротекста и метаданных записи в байтах
+	MaxEntrySize int
+	MaxEntries   int
+}
+
+// PasswordsUseCase работает с личными записями пользователя и с записями коллекций.
+// Доступ к коллекции определяется наличием у пользователя ее ключа и ролью
+// в организации: читать могут все участники коллекции, изменять — начиная с editor.
 type PasswordsUseCase struct {
-	repo PasswordsRepository
+	repo            PasswordsRepository
+	collectionsRepo CollectionsRepository
+	emergencyRepo   EmergencyAccessRepository
+
+	mu     sync.RWMutex
+	quotas Quotas
 }
 
-func NewPasswordsUseCase(repo PasswordsRepository) *PasswordsUseCase {
+func NewPasswordsUseCase(
+	repo PasswordsRepository,
+	collectionsRepo CollectionsRepository,
+	emergencyRepo EmergencyAccessRepository,
+	quotas Quotas,
+) *PasswordsUseCase {
 	return &PasswordsUseCase{
-		repo: repo,
+		repo:            repo,
+		collectionsRepo: collectionsRepo,
+		emergencyRepo:   emergencyRepo,
+		quotas:          quotas,
 	}
 }
 
+// SetQuotas применяет новые квоты к следующим изменениям, уже сохраненные записи не трогает.
+func (uc *PasswordsUseCase) SetQuotas(quotas Quotas) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	uc.quotas = quotas
+}
+
 func (uc *PasswordsUseCase) AddNewPassword(
 	ctx context.Context,
 	userID int,
 	password *entity.Password,
 ) error {
-	return uc.repo.AddNewPassword(ctx, userID, password)
+	ctx, span := tracing.Start(ctx, "PasswordsUseCase.AddNewPassword")
+	defer span.End()
+
+	if err := uc.authorize(ctx, userID, password.CollectionID, true); err != nil {
+		return err
+	}
+
+	if err := uc.checkEntrySize(password); err != nil {
+		return err
+	}
+
+	return uc.repo.AddNewPassword(ctx, userID, password, uc.entriesQuotaCheck(password))
 }
 
 func (uc *PasswordsUseCase) DeletePasswordByName(
 	ctx context.Context,
 	userID int,
+	collectionID int,
 	name string,
 ) error {
-	return uc.repo.DeletePasswordByName(ctx, userID, name)
+	ctx, span := tracing.Start(ctx, "PasswordsUseCase.DeletePasswordByName")
+	defer span.End()
+
+	if err := uc.authorize(ctx, userID, collectionID, true); err != nil {
+		return err
+	}
+
+	return uc.repo.DeletePasswordByName(ctx, userID, collectionID, name)
 }
 
 func (uc *PasswordsUseCase) GetList(
 	ctx context.Context,
 	userID int,
+	collectionID int,
 ) ([]*entity.Password, error) {
-	return uc.repo.GetPasswords(ctx, userID)
+	ctx, span := tracing.Start(ctx, "PasswordsUseCase.GetList")
+	defer span.End()
+
+	if err := uc.authorize(ctx, userID, collectionID, false); err != nil {
+		return nil, err
+	}
+
+	return uc.repo.GetPasswords(ctx, userID, collectionID)
+}
+
+// GetEmergencyList отдает личные записи владельца доверенному контакту
+// после одобрения экстренного доступа. Записи остаются зашифрованными
+// ключом хранилища владельца, копию которого контакт получает вместе с доступом.
+func (uc *PasswordsUseCase) GetEmergencyList(
+	ctx context.Context,
+	userID int,
+	grantor string,
+) ([]*entity.Password, error) {
+	ctx, span := tracing.Start(ctx, "PasswordsUseCase.GetEmergencyList")
+	defer span.End()
+
+	access, err := uc.emergencyRepo.GetEmergencyAccessByGrantor(ctx, userID, grantor)
+	if err != nil {
+		return nil, err
+	}
+
+	if !access.Approved() {
+		return nil, entity.ErrEmergencyAccessNotApproved
+	}
+
+	return uc.repo.GetPasswords(ctx, access.GrantorID, 0)
 }
 
 func (uc *PasswordsUseCase) SyncPassword(
@@ -45,13 +129,26 @@ func (uc *PasswordsUseCase) SyncPassword(
 	userID int,
 	password *entity.Password,
 ) error {
+	ctx, span := tracing.Start(ctx, "PasswordsUseCase.SyncPassword")
+	defer span.End()
+
+	if err := uc.authorize(ctx, userID, password.CollectionID, true); err != nil {
+		return err
+	}
+
+	if err := uc.checkEntrySize(password); err != nil {
+		return err
+	}
+
 	err := uc.repo.UpdateByName(
 		ctx,
 		userID,
+		password.CollectionID,
 		password.Name,
 		func(actualPassword *entity.Password) (*entity.Password, error) {
 			if actualPassword.Deleted {
 				if password.Version > actualPassword.Version {
+					// восстановленная запись снова занимает место в квоте, ее проверит репозиторий
 					return password, nil
 				}
 
@@ -64,13 +161,14 @@ func (uc *PasswordsUseCase) SyncPassword(
 
 			return nil, entity.NewPasswordDiffConflictError(actualPassword, password)
 		},
+		uc.entriesQuotaCheck(password),
 	)
 	if err != nil {
 		if !errors.Is(err, entity.ErrPasswordDoesNotExist) {
 			return err
 		}
 
-		err = uc.AddNewPassword(ctx, userID, password)
+		err = uc.repo.AddNewPassword(ctx, userID, password, uc.entriesQuotaCheck(password))
 		if err != nil {
 			return err
 		}
@@ -78,3 +176,62 @@ func (uc *PasswordsUseCase) SyncPassword(
 
 	return nil
 }
+
+func (uc *PasswordsUseCase) currentQuotas() Quotas {
+	uc.mu.RLock()
+	defer uc.mu.RUnlock()
+
+	return uc.quotas
+}
+
+func (uc *PasswordsUseCase) checkEntrySize(password *entity.Password) error {
+	maxSize := uc.currentQuotas().MaxEntrySize
+
+	if maxSize > 0 && len(password.Name)+len(password.Value)+len(password.Meta) > maxSize {
+		return entity.ErrEntryTooLarge
+	}
+
+	return nil
+}
+
+// entriesQuotaCheck возвращает проверку числа записей, которую репозиторий
+// выполняет в одной транзакции со вставкой. Удаленные записи в квоту не входят,
+// для них и при снятом ограничении проверка не нужна.
+func (uc *PasswordsUseCase) entriesQuotaCheck(password *entity.Password) func(count int) error {
+	maxEntries := uc.currentQuotas().MaxEntries
+	if maxEntries <= 0 || password.Deleted {
+		return nil
+	}
+
+	return func(count int) error {
+		if count >= maxEntries {
+			return entity.ErrEntriesQuotaExceeded
+		}
+
+		return nil
+	}
+}
+
+// authorize проверяет доступ к коллекции, личные записи (collectionID = 0)
+// и так выбираются только по user_id.
+func (uc *PasswordsUseCase) authorize(
+	ctx context.Context,
+	userID int,
+	collectionID int,
+	write bool,
+) error {
+	if collectionID == 0 {
+		return nil
+	}
+
+	collection, err := uc.collectionsRepo.GetCollection(ctx, userID, collectionID)
+	if err != nil {
+		return err
+	}
+
+	if write && !collection.Role.CanWrite() {
+		return entity.ErrPermissionDenied
+	}
+
+	return nil
+}
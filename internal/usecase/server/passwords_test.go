@@ -0,0 +1,263 @@
+package server_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/llravell/go-pass/internal/entity"
+	usecase "github.com/llravell/go-pass/internal/usecase/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// passwordsRepoStub повторяет выборку репозитория: записи коллекции общие,
+// личные записи (collectionID = 0) видны только владельцу.
+type passwordsRepoStub struct {
+	usecase.PasswordsRepository
+
+	passwords map[string]map[string]*entity.Password
+}
+
+func newPasswordsRepoStub() *passwordsRepoStub {
+	return &passwordsRepoStub{passwords: map[string]map[string]*entity.Password{}}
+}
+
+func passwordsScope(userID int, collectionID int) string {
+	if collectionID != 0 {
+		return fmt.Sprintf("collection:%d", collectionID)
+	}
+
+	return fmt.Sprintf("user:%d", userID)
+}
+
+func (r *passwordsRepoStub) countAlive(scope string) int {
+	count := 0
+
+	for _, password := range r.passwords[scope] {
+		if !password.Deleted {
+			count++
+		}
+	}
+
+	return count
+}
+
+func (r *passwordsRepoStub) AddNewPassword(
+	_ context.Context,
+	userID int,
+	password *entity.Password,
+	checkFn func(count int) error,
+) error {
+	scope := passwordsScope(userID, password.CollectionID)
+	if r.passwords[scope] == nil {
+		r.passwords[scope] = map[string]*entity.Password{}
+	}
+
+	if checkFn != nil {
+		if err := checkFn(r.countAlive(scope)); err != nil {
+			return err
+		}
+	}
+
+	stored := *password
+	r.passwords[scope][password.Name] = &stored
+
+	return nil
+}
+
+func (r *passwordsRepoStub) UpdateByName(
+	_ context.Context,
+	userID int,
+	collectionID int,
+	name string,
+	updateFn func(password *entity.Password) (*entity.Password, error),
+	checkFn func(count int) error,
+) error {
+	scope := passwordsScope(userID, collectionID)
+
+	actual, ok := r.passwords[scope][name]
+	if !ok {
+		return entity.ErrPasswordDoesNotExist
+	}
+
+	current := *actual
+
+	updated, err := updateFn(&current)
+	if err != nil || updated == nil {
+		return err
+	}
+
+	if actual.Deleted && !updated.Deleted && checkFn != nil {
+		if err = checkFn(r.countAlive(scope)); err != nil {
+			return err
+		}
+	}
+
+	stored := *updated
+	r.passwords[scope][name] = &stored
+
+	return nil
+}
+
+func (r *passwordsRepoStub) GetPasswords(_ context.Context, userID int, collectionID int) ([]*entity.Password, error) {
+	passwords := make([]*entity.Password, 0)
+
+	for _, password := range r.passwords[passwordsScope(userID, collectionID)] {
+		passwords = append(passwords, password)
+	}
+
+	return passwords, nil
+}
+
+func (r *passwordsRepoStub) DeletePasswordByName(_ context.Context, userID int, collectionID int, name string) error {
+	if password, ok := r.passwords[passwordsScope(userID, collectionID)][name]; ok {
+		password.Deleted = true
+	}
+
+	return nil
+}
+
+func TestPasswordsUseCaseAuthorize(t *testing.T) {
+	const collectionID = 7
+
+	newUseCase := func() (*usecase.PasswordsUseCase, *passwordsRepoStub) {
+		collections := newOrgsRepoStub(nil)
+		collections.collections[collectionID] = map[int]entity.OrgRole{
+			1: entity.OrgRoleEditor,
+			2: entity.OrgRoleViewer,
+		}
+
+		repo := newPasswordsRepoStub()
+
+		return usecase.NewPasswordsUseCase(repo, collections, nil, usecase.Quotas{}), repo
+	}
+
+	tests := []struct {
+		name    string
+		userID  int
+		write   bool
+		wantErr error
+	}{
+		{name: "editor writes", userID: 1, write: true},
+		{name: "viewer reads", userID: 2},
+		{name: "viewer write denied", userID: 2, write: true, wantErr: entity.ErrPermissionDenied},
+		{name: "non-member read denied", userID: 3, wantErr: entity.ErrCollectionNotFound},
+		{name: "non-member write denied", userID: 3, write: true, wantErr: entity.ErrCollectionNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			passwordsUC, _ := newUseCase()
+			ctx := context.Background()
+
+			var err error
+
+			if tt.write {
+				err = passwordsUC.AddNewPassword(ctx, tt.userID, &entity.Password{Name: "db", CollectionID: collectionID})
+				if err == nil {
+					err = passwordsUC.DeletePasswordByName(ctx, tt.userID, collectionID, "db")
+				}
+			} else {
+				_, err = passwordsUC.GetList(ctx, tt.userID, collectionID)
+			}
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("personal entries are scoped to user", func(t *testing.T) {
+		passwordsUC, _ := newUseCase()
+		ctx := context.Background()
+
+		// для личных записей коллекции не проверяются, доступ ограничен user_id
+		require.NoError(t, passwordsUC.AddNewPassword(ctx, 3, &entity.Password{Name: "mail"}))
+
+		own, err := passwordsUC.GetList(ctx, 3, 0)
+		require.NoError(t, err)
+		assert.Len(t, own, 1)
+
+		foreign, err := passwordsUC.GetList(ctx, 1, 0)
+		require.NoError(t, err)
+		assert.Empty(t, foreign)
+	})
+}
+
+func TestPasswordsUseCaseQuotas(t *testing.T) {
+	const userID = 1
+
+	ctx := context.Background()
+
+	newUseCase := func(quotas usecase.Quotas) (*usecase.PasswordsUseCase, *passwordsRepoStub) {
+		repo := newPasswordsRepoStub()
+
+		return usecase.NewPasswordsUseCase(repo, newOrgsRepoStub(nil), nil, quotas), repo
+	}
+
+	t.Run("entry size includes name", func(t *testing.T) {
+		passwordsUC, _ := newUseCase(usecase.Quotas{MaxEntrySize: 8})
+
+		require.NoError(t, passwordsUC.AddNewPassword(ctx, userID, &entity.Password{Name: "db", Value: "secret"}))
+
+		err := passwordsUC.AddNewPassword(ctx, userID, &entity.Password{Name: "mail", Value: "secret"})
+		require.ErrorIs(t, err, entity.ErrEntryTooLarge)
+
+		err = passwordsUC.SyncPassword(ctx, userID, &entity.Password{Name: "a-long-name", Version: 1})
+		require.ErrorIs(t, err, entity.ErrEntryTooLarge)
+	})
+
+	t.Run("entries quota counts only live entries", func(t *testing.T) {
+		passwordsUC, _ := newUseCase(usecase.Quotas{MaxEntries: 2})
+
+		require.NoError(t, passwordsUC.AddNewPassword(ctx, userID, &entity.Password{Name: "db"}))
+		require.NoError(t, passwordsUC.SyncPassword(ctx, userID, &entity.Password{Name: "mail", Version: 1}))
+
+		err := passwordsUC.AddNewPassword(ctx, userID, &entity.Password{Name: "vpn"})
+		require.ErrorIs(t, err, entity.ErrEntriesQuotaExceeded)
+
+		err = passwordsUC.SyncPassword(ctx, userID, &entity.Password{Name: "vpn", Version: 1})
+		require.ErrorIs(t, err, entity.ErrEntriesQuotaExceeded)
+
+		// удаленную запись синхронизировать можно, она не занимает места
+		require.NoError(t, passwordsUC.SyncPassword(ctx, userID, &entity.Password{Name: "old", Version: 1, Deleted: true}))
+
+		require.NoError(t, passwordsUC.DeletePasswordByName(ctx, userID, 0, "db"))
+		require.NoError(t, passwordsUC.AddNewPassword(ctx, userID, &entity.Password{Name: "vpn"}))
+	})
+
+	t.Run("restore from deleted is checked against quota", func(t *testing.T) {
+		passwordsUC, repo := newUseCase(usecase.Quotas{MaxEntries: 1})
+
+		require.NoError(t, passwordsUC.AddNewPassword(ctx, userID, &entity.Password{Name: "db", Version: 1}))
+		require.NoError(t, passwordsUC.DeletePasswordByName(ctx, userID, 0, "db"))
+		require.NoError(t, passwordsUC.AddNewPassword(ctx, userID, &entity.Password{Name: "mail"}))
+
+		err := passwordsUC.SyncPassword(ctx, userID, &entity.Password{Name: "db", Value: "new", Version: 2})
+		require.ErrorIs(t, err, entity.ErrEntriesQuotaExceeded)
+		assert.True(t, repo.passwords[passwordsScope(userID, 0)]["db"].Deleted)
+
+		require.NoError(t, passwordsUC.DeletePasswordByName(ctx, userID, 0, "mail"))
+		require.NoError(t, passwordsUC.SyncPassword(ctx, userID, &entity.Password{Name: "db", Value: "new", Version: 2}))
+
+		restored := repo.passwords[passwordsScope(userID, 0)]["db"]
+		assert.False(t, restored.Deleted)
+		assert.Equal(t, "new", restored.Value)
+	})
+
+	t.Run("stale restore is a conflict", func(t *testing.T) {
+		passwordsUC, _ := newUseCase(usecase.Quotas{})
+
+		require.NoError(t, passwordsUC.AddNewPassword(ctx, userID, &entity.Password{Name: "db", Version: 2}))
+		require.NoError(t, passwordsUC.DeletePasswordByName(ctx, userID, 0, "db"))
+
+		err := passwordsUC.SyncPassword(ctx, userID, &entity.Password{Name: "db", Version: 2})
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, entity.ErrEntriesQuotaExceeded)
+	})
+}
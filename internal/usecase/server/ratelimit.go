@@ -0,0 +1,142 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/llravell/go-pass/internal/entity"
+	"github.com/llravell/go-pass/pkg/tracing"
+)
+
+type RateLimits struct {
+	// MaxFailures - сколько неудачных попыток подряд приводит к блокировке
+	MaxFailures int
+	// BaseDelay - пауза после первой неудачи, дальше она удваивается до MaxDelay
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	// LockoutDuration - длительность блокировки после MaxFailures неудач
+	LockoutDuration time.Duration
+	// FailureWindow - через сколько после последней неудачи счетчик забывается
+	FailureWindow time.Duration
+}
+
+// RateLimitUseCase ограничивает попытки входа: после каждой неудачи следующая
+// попытка возможна только через растущую паузу, после серии неудач ключ блокируется.
+// Счетчики хранятся в базе, поэтому лимиты общие для всех инстансов.
+type RateLimitUseCase struct {
+	repo AuthAttemptsRepository
+	now  func() time.Time
+
+	mu     sync.RWMutex
+	limits RateLimits
+}
+
+func NewRateLimitUseCase(repo AuthAttemptsRepository, limits RateLimits) *RateLimitUseCase {
+	return &RateLimitUseCase{
+		repo:   repo,
+		limits: limits,
+		now:    time.Now,
+	}
+}
+
+// SetClock подменяет источник текущего времени.
+func (uc *RateLimitUseCase) SetClock(now func() time.Time) {
+	uc.now = now
+}
+
+// SetLimits применяет новые лимиты, накопленные счетчики неудач сохраняются.
+func (uc *RateLimitUseCase) SetLimits(limits RateLimits) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	uc.limits = limits
+}
+
+func (uc *RateLimitUseCase) currentLimits() RateLimits {
+	uc.mu.RLock()
+	defer uc.mu.RUnlock()
+
+	return uc.limits
+}
+
+// RetryAfter возвращает, сколько нужно подождать до следующей попытки по любому из ключей.
+func (uc *RateLimitUseCase) RetryAfter(ctx context.Context, keys []string) (time.Duration, error) {
+	ctx, span := tracing.Start(ctx, "RateLimitUseCase.RetryAfter")
+	defer span.End()
+
+	attempts, err := uc.repo.GetAttempts(ctx, keys)
+	if err != nil {
+		return 0, err
+	}
+
+	now := uc.now()
+
+	var wait time.Duration
+
+	for _, attempt := range attempts {
+		if retryAt := uc.retryAt(attempt, now); retryAt.Sub(now) > wait {
+			wait = retryAt.Sub(now)
+		}
+	}
+
+	return wait, nil
+}
+
+func (uc *RateLimitUseCase) RecordFailure(ctx context.Context, keys []string) error {
+	ctx, span := tracing.Start(ctx, "RateLimitUseCase.RecordFailure")
+	defer span.End()
+
+	now := uc.now()
+	limits := uc.currentLimits()
+
+	for _, key := range keys {
+		err := uc.repo.UpdateAttempts(ctx, key, func(attempts *entity.AuthAttempts) {
+			if now.Sub(attempts.LastFailureAt) > limits.FailureWindow {
+				attempts.Failures = 0
+			}
+
+			attempts.Failures++
+			attempts.LastFailureAt = now
+
+			if attempts.Failures >= limits.MaxFailures {
+				attempts.LockedUntil = now.Add(limits.LockoutDuration)
+				attempts.Failures = 0
+			}
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (uc *RateLimitUseCase) Reset(ctx context.Context, key string) error {
+	ctx, span := tracing.Start(ctx, "RateLimitUseCase.Reset")
+	defer span.End()
+
+	return uc.repo.ResetAttempts(ctx, key)
+}
+
+func (uc *RateLimitUseCase) retryAt(attempts *entity.AuthAttempts, now time.Time) time.Time {
+	if attempts.LockedUntil.After(now) {
+		return attempts.LockedUntil
+	}
+
+	limits := uc.currentLimits()
+
+	if attempts.Failures == 0 || now.Sub(attempts.LastFailureAt) > limits.FailureWindow {
+		return now
+	}
+
+	delay := limits.BaseDelay
+
+	for i := 1; i < attempts.Failures && delay < limits.MaxDelay; i++ {
+		delay *= 2
+	}
+
+	delay = min(delay, limits.MaxDelay)
+
+	return attempts.LastFailureAt.Add(delay)
+}
@@ -0,0 +1,134 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"github.com/llravell/go-pass/internal/entity"
+	"github.com/llravell/go-pass/pkg/tracing"
+)
+
+const (
+	// MaxSendSize ограничивает размер шифротекста одной передачи.
+	MaxSendSize = 64 * 1024
+	// SendsCleanupInterval — как часто удаляются истекшие передачи.
+	SendsCleanupInterval = time.Minute
+
+	sendIDLength = 16
+	sendPath     = "/s/"
+)
+
+// SendsUseCase хранит одноразовые передачи секретов для тех, у кого нет go-pass.
+type SendsUseCase struct {
+	repo      SendsRepository
+	maxTTL    time.Duration
+	publicURL string
+}
+
+func NewSendsUseCase(repo SendsRepository, maxTTL time.Duration, publicURL string) *SendsUseCase {
+	return &SendsUseCase{
+		repo:      repo,
+		maxTTL:    maxTTL,
+		publicURL: strings.TrimSuffix(publicURL, "/"),
+	}
+}
+
+func (uc *SendsUseCase) Create(
+	ctx context.Context,
+	userID int,
+	ciphertext string,
+	ttl time.Duration,
+	maxViews int,
+) (*entity.Send, error) {
+	ctx, span := tracing.Start(ctx, "SendsUseCase.Create")
+	defer span.End()
+
+	if len(ciphertext) == 0 || ttl <= 0 || ttl > uc.maxTTL || maxViews < 1 {
+		return nil, entity.ErrInvalidSend
+	}
+
+	if len(ciphertext) > MaxSendSize {
+		return nil, entity.ErrSendTooLarge
+	}
+
+	id, err := generateSendID()
+	if err != nil {
+		return nil, err
+	}
+
+	send := &entity.Send{
+		ID:         id,
+		UserID:     userID,
+		Ciphertext: ciphertext,
+		MaxViews:   maxViews,
+		ExpiresAt:  time.Now().Add(ttl),
+	}
+
+	if err = uc.repo.CreateSend(ctx, send); err != nil {
+		return nil, err
+	}
+
+	return send, nil
+}
+
+// URL возвращает ссылку на страницу передачи или пустую строку,
+// если публичный адрес не настроен.
+func (uc *SendsUseCase) URL(id string) string {
+	if uc.publicURL == "" {
+		return ""
+	}
+
+	return uc.publicURL + sendPath + id
+}
+
+func (uc *SendsUseCase) Receive(ctx context.Context, id string) (*entity.Send, error) {
+	ctx, span := tracing.Start(ctx, "SendsUseCase.Receive")
+	defer span.End()
+
+	return uc.repo.ConsumeSend(ctx, id, time.Now())
+}
+
+func (uc *SendsUseCase) GetList(ctx context.Context, userID int) ([]*entity.Send, error) {
+	ctx, span := tracing.Start(ctx, "SendsUseCase.GetList")
+	defer span.End()
+
+	return uc.repo.GetSends(ctx, userID, time.Now())
+}
+
+func (uc *SendsUseCase) Delete(ctx context.Context, userID int, id string) error {
+	ctx, span := tracing.Start(ctx, "SendsUseCase.Delete")
+	defer span.End()
+
+	return uc.repo.DeleteSend(ctx, userID, id)
+}
+
+// Run периодически удаляет истекшие передачи до отмены контекста.
+// Просроченные записи и так не отдаются, очистка убирает шифротексты из базы.
+func (uc *SendsUseCase) Run(ctx context.Context, onError func(err error)) {
+	ticker := time.NewTicker(SendsCleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := uc.repo.DeleteExpiredSends(ctx, time.Now()); err != nil {
+				onError(err)
+			}
+		}
+	}
+}
+
+func generateSendID() (string, error) {
+	buf := make([]byte, sendIDLength)
+
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
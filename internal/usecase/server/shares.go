@@ -0,0 +1,144 @@
+package server
+
+import (
+	"context"
+
+	"github.com/llravell/go-pass/internal/entity"
+	"github.com/llravell/go-pass/pkg/tracing"
+)
+
+// SharesUseCase хранит копии записей, которыми пользователи делятся друг с другом.
+// Сервер видит только шифротексты: ключ данных каждой копии зашифрован
+// открытыми ключами владельца и получателя на стороне клиента.
+type SharesUseCase struct {
+	repo SharesRepository
+}
+
+func NewSharesUseCase(repo SharesRepository) *SharesUseCase {
+	return &SharesUseCase{
+		repo: repo,
+	}
+}
+
+func (uc *SharesUseCase) SetKeyPair(
+	ctx context.Context,
+	userID int,
+	keyPair *entity.UserKeyPair,
+) error {
+	ctx, span := tracing.Start(ctx, "SharesUseCase.SetKeyPair")
+	defer span.End()
+
+	return uc.repo.SetKeyPair(ctx, userID, keyPair)
+}
+
+func (uc *SharesUseCase) GetKeyPair(
+	ctx context.Context,
+	userID int,
+) (*entity.UserKeyPair, error) {
+	ctx, span := tracing.Start(ctx, "SharesUseCase.GetKeyPair")
+	defer span.End()
+
+	return uc.repo.GetKeyPair(ctx, userID)
+}
+
+// GetPublicKey возвращает открытый ключ пользователя. Логины не скрываются:
+// для неизвестного логина возвращается ErrRecipientNotFound, как и при создании
+// передачи, экстренном доступе и добавлении в организацию, так что любой
+// вошедший пользователь может проверить, существует ли логин.
+func (uc *SharesUseCase) GetPublicKey(ctx context.Context, login string) (string, error) {
+	ctx, span := tracing.Start(ctx, "SharesUseCase.GetPublicKey")
+	defer span.End()
+
+	_, publicKey, err := uc.repo.GetPublicKeyByLogin(ctx, login)
+
+	return publicKey, err
+}
+
+func (uc *SharesUseCase) CreateShare(
+	ctx context.Context,
+	ownerID int,
+	recipientLogin string,
+	share *entity.Share,
+) error {
+	ctx, span := tracing.Start(ctx, "SharesUseCase.CreateShare")
+	defer span.End()
+
+	recipientID, _, err := uc.repo.GetPublicKeyByLogin(ctx, recipientLogin)
+	if err != nil {
+		return err
+	}
+
+	if recipientID == ownerID {
+		return entity.ErrShareWithSelf
+	}
+
+	share.OwnerID = ownerID
+	share.RecipientID = recipientID
+	share.Recipient = recipientLogin
+	share.Version = 1
+
+	return uc.repo.CreateShare(ctx, share)
+}
+
+func (uc *SharesUseCase) GetList(
+	ctx context.Context,
+	userID int,
+	outgoing bool,
+) ([]*entity.Share, error) {
+	ctx, span := tracing.Start(ctx, "SharesUseCase.GetList")
+	defer span.End()
+
+	return uc.repo.GetShares(ctx, userID, outgoing)
+}
+
+// UpdateShare записывает новое значение копии, если клиент видел ее последнюю версию.
+// Изменение владельца фиксирует версию его записи, изменение получателя
+// помечает копию, чтобы владелец забрал его при следующей синхронизации.
+func (uc *SharesUseCase) UpdateShare(
+	ctx context.Context,
+	userID int,
+	update *entity.Share,
+) (*entity.Share, error) {
+	ctx, span := tracing.Start(ctx, "SharesUseCase.UpdateShare")
+	defer span.End()
+
+	var updatedShare *entity.Share
+
+	err := uc.repo.UpdateShare(ctx, update.ID, func(share *entity.Share) (*entity.Share, error) {
+		isOwner := share.OwnerID == userID
+
+		switch {
+		case !isOwner && share.RecipientID != userID:
+			return nil, entity.ErrShareNotFound
+		case !isOwner && !share.Writable():
+			return nil, entity.ErrShareReadOnly
+		case share.Version != update.Version:
+			return nil, entity.ErrShareVersionConflict
+		}
+
+		share.Value = update.Value
+		share.Meta = update.Meta
+		share.Version++
+		share.RecipientModified = !isOwner
+
+		if isOwner {
+			share.SourceVersion = update.SourceVersion
+		}
+
+		updatedShare = share
+
+		return share, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedShare, nil
+}
+
+func (uc *SharesUseCase) RevokeShare(ctx context.Context, userID int, shareID int) error {
+	ctx, span := tracing.Start(ctx, "SharesUseCase.RevokeShare")
+	defer span.End()
+
+	return uc.repo.DeleteShare(ctx, userID, shareID)
+}
@@ -0,0 +1,46 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/llravell/go-pass/internal/entity"
+	usecase "github.com/llravell/go-pass/internal/usecase/server"
+	"github.com/llravell/go-pass/pkg/encryption"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type sharesRepoStub struct {
+	usecase.SharesRepository
+
+	publicKeys map[string]string
+}
+
+func (r *sharesRepoStub) GetPublicKeyByLogin(_ context.Context, login string) (int, string, error) {
+	publicKey, ok := r.publicKeys[login]
+	if !ok {
+		return 0, "", entity.ErrRecipientNotFound
+	}
+
+	return 1, publicKey, nil
+}
+
+func TestSharesUseCaseGetPublicKey(t *testing.T) {
+	keyPair, err := encryption.GenerateKeyPair()
+	require.NoError(t, err)
+
+	repo := &sharesRepoStub{publicKeys: map[string]string{"bob": keyPair.PublicKey()}}
+	sharesUC := usecase.NewSharesUseCase(repo)
+
+	t.Run("returns key of existing user", func(t *testing.T) {
+		publicKey, err := sharesUC.GetPublicKey(context.Background(), "bob")
+		require.NoError(t, err)
+		assert.Equal(t, keyPair.PublicKey(), publicKey)
+	})
+
+	t.Run("reports unknown login", func(t *testing.T) {
+		_, err := sharesUC.GetPublicKey(context.Background(), "mallory")
+		require.ErrorIs(t, err, entity.ErrRecipientNotFound)
+	})
+}
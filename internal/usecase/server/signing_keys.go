@@ -0,0 +1,178 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/llravell/go-pass/internal/entity"
+	tokens "github.com/llravell/go-pass/pkg/auth"
+	"github.com/llravell/go-pass/pkg/tracing"
+)
+
+// SigningKeysRefreshInterval — как часто инстанс перечитывает ключи из базы.
+const SigningKeysRefreshInterval = time.Minute
+
+// Новый ключ начинает подписывать токены не сразу: остальные инстансы
+// должны успеть его загрузить, иначе они отклонят выпущенные им токены.
+const signingKeyActivationDelay = 2 * SigningKeysRefreshInterval
+
+// SigningKeysUseCase ротирует ключи подписи JWT. Раз в rotationPeriod создается
+// новый ключ, предыдущий перестает подписывать, но еще gracePeriod принимается
+// при проверке, после чего удаляется. Если два инстанса одновременно создадут
+// по ключу, оба будут валидными, а лишний уйдет при следующей ротации.
+type SigningKeysUseCase struct {
+	repo           SigningKeysRepository
+	keyRing        KeyRing
+	algorithm      string
+	rotationPeriod time.Duration
+	gracePeriod    time.Duration
+}
+
+func NewSigningKeysUseCase(
+	repo SigningKeysRepository,
+	keyRing KeyRing,
+	algorithm string,
+	rotationPeriod time.Duration,
+	gracePeriod time.Duration,
+) *SigningKeysUseCase {
+	return &SigningKeysUseCase{
+		repo:           repo,
+		keyRing:        keyRing,
+		algorithm:      algorithm,
+		rotationPeriod: rotationPeriod,
+		gracePeriod:    gracePeriod,
+	}
+}
+
+// Rotate загружает ключи, при необходимости создает новый и удаляет
+// ключи с истекшим grace period, затем обновляет набор ключей менеджера.
+func (uc *SigningKeysUseCase) Rotate(ctx context.Context) error {
+	ctx, span := tracing.Start(ctx, "SigningKeysUseCase.Rotate")
+	defer span.End()
+
+	stored, err := uc.repo.GetSigningKeys(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	if uc.needsNewKey(stored, now) {
+		key, err := uc.createKey(ctx)
+		if err != nil {
+			return err
+		}
+
+		stored = append(stored, key)
+	}
+
+	keys := make([]*tokens.SigningKey, 0, len(stored))
+	expired := make([]string, 0)
+
+	for i, storedKey := range stored {
+		if i+1 < len(stored) && now.Sub(retiredAt(stored[i+1])) >= uc.gracePeriod {
+			expired = append(expired, storedKey.ID)
+
+			continue
+		}
+
+		key, err := tokens.ParseSigningKey(
+			storedKey.ID,
+			storedKey.Algorithm,
+			[]byte(storedKey.PrivateKey),
+			storedKey.CreatedAt,
+		)
+		if err != nil {
+			return err
+		}
+
+		keys = append(keys, key)
+	}
+
+	if len(expired) > 0 {
+		if err = uc.repo.DeleteSigningKeys(ctx, expired); err != nil {
+			return err
+		}
+	}
+
+	uc.keyRing.SetKeys(activeKey(keys, now), keys)
+
+	return nil
+}
+
+// Run перечитывает ключи раз в SigningKeysRefreshInterval до отмены контекста.
+func (uc *SigningKeysUseCase) Run(ctx context.Context, onError func(err error)) {
+	ticker := time.NewTicker(SigningKeysRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := uc.Rotate(ctx); err != nil {
+				onError(err)
+			}
+		}
+	}
+}
+
+func (uc *SigningKeysUseCase) PublicKeys() []*tokens.SigningKey {
+	return uc.keyRing.PublicKeys()
+}
+
+func (uc *SigningKeysUseCase) needsNewKey(stored []*entity.SigningKey, now time.Time) bool {
+	if len(stored) == 0 {
+		return true
+	}
+
+	newest := stored[len(stored)-1]
+
+	return newest.Algorithm != uc.algorithm || now.Sub(newest.CreatedAt) >= uc.rotationPeriod
+}
+
+func (uc *SigningKeysUseCase) createKey(ctx context.Context) (*entity.SigningKey, error) {
+	key, err := tokens.GenerateSigningKey(uc.algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKey, err := key.MarshalPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	storedKey := &entity.SigningKey{
+		ID:         key.ID,
+		Algorithm:  key.Algorithm,
+		PrivateKey: string(privateKey),
+		CreatedAt:  key.CreatedAt,
+	}
+
+	if err = uc.repo.StoreSigningKey(ctx, storedKey); err != nil {
+		return nil, err
+	}
+
+	return storedKey, nil
+}
+
+// retiredAt — момент, когда ключ-преемник начинает подписывать токены.
+func retiredAt(successor *entity.SigningKey) time.Time {
+	return successor.CreatedAt.Add(signingKeyActivationDelay)
+}
+
+// activeKey выбирает самый новый ключ, который уже успели загрузить все инстансы.
+// Единственный ключ используется сразу, подписывать все равно больше нечем.
+func activeKey(keys []*tokens.SigningKey, now time.Time) *tokens.SigningKey {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	for i := len(keys) - 1; i >= 0; i-- {
+		if !now.Before(keys[i].CreatedAt.Add(signingKeyActivationDelay)) {
+			return keys[i]
+		}
+	}
+
+	return keys[0]
+}
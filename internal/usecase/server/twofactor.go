@@ -0,0 +1,195 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/llravell/go-pass/internal/entity"
+	tokens "github.com/llravell/go-pass/pkg/auth"
+	"github.com/llravell/go-pass/pkg/tracing"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	totpIssuer         = "go-pass"
+	recoveryCodesCount = 10
+	challengeTTL       = 5 * time.Minute
+)
+
+type TwoFactorUseCase struct {
+	repo            TwoFactorRepository
+	challengeIssuer ChallengeIssuer
+	required        bool
+}
+
+func NewTwoFactorUseCase(
+	repo TwoFactorRepository,
+	challengeIssuer ChallengeIssuer,
+	required bool,
+) *TwoFactorUseCase {
+	return &TwoFactorUseCase{
+		repo:            repo,
+		challengeIssuer: challengeIssuer,
+		required:        required,
+	}
+}
+
+// SecondFactorState сообщает, нужен ли пользователю второй фактор при входе
+// и должен ли он сначала его подключить, потому что того требует сервер.
+func (uc *TwoFactorUseCase) SecondFactorState(user *entity.User) (bool, bool) {
+	if user.TOTPEnabled {
+		return true, false
+	}
+
+	return uc.required, uc.required
+}
+
+func (uc *TwoFactorUseCase) IssueChallenge(user *entity.User) (string, error) {
+	return uc.challengeIssuer.IssueChallenge(user.ID, challengeTTL)
+}
+
+func (uc *TwoFactorUseCase) UserFromChallenge(ctx context.Context, challenge string) (*entity.User, error) {
+	ctx, span := tracing.Start(ctx, "TwoFactorUseCase.UserFromChallenge")
+	defer span.End()
+
+	userID, err := uc.challengeIssuer.ParseChallenge(challenge)
+	if err != nil {
+		return nil, entity.ErrInvalidChallenge
+	}
+
+	return uc.repo.FindUserByID(ctx, userID)
+}
+
+func (uc *TwoFactorUseCase) UserByID(ctx context.Context, userID int) (*entity.User, error) {
+	ctx, span := tracing.Start(ctx, "TwoFactorUseCase.UserByID")
+	defer span.End()
+
+	return uc.repo.FindUserByID(ctx, userID)
+}
+
+// BeginEnrollment генерирует новый секрет и коды восстановления.
+// Второй фактор включается только после подтверждения кодом из приложения.
+func (uc *TwoFactorUseCase) BeginEnrollment(
+	ctx context.Context,
+	user *entity.User,
+) (*entity.TwoFactorEnrollment, error) {
+	ctx, span := tracing.Start(ctx, "TwoFactorUseCase.BeginEnrollment")
+	defer span.End()
+
+	if user.TOTPEnabled {
+		return nil, entity.ErrTwoFactorAlreadyEnabled
+	}
+
+	secret, uri, err := tokens.GenerateTOTP(totpIssuer, user.Login)
+	if err != nil {
+		return nil, err
+	}
+
+	recoveryCodes, err := tokens.GenerateRecoveryCodes(recoveryCodesCount)
+	if err != nil {
+		return nil, err
+	}
+
+	hashes := make([]string, 0, len(recoveryCodes))
+
+	for _, code := range recoveryCodes {
+		hash, err := bcrypt.GenerateFromPassword([]byte(tokens.NormalizeRecoveryCode(code)), bcrypt.DefaultCost)
+		if err != nil {
+			return nil, err
+		}
+
+		hashes = append(hashes, string(hash))
+	}
+
+	err = uc.repo.SetTOTPSecret(ctx, user.ID, secret, hashes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.TwoFactorEnrollment{
+		URI:           uri,
+		Secret:        secret,
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+func (uc *TwoFactorUseCase) ConfirmEnrollment(ctx context.Context, user *entity.User, code string) error {
+	ctx, span := tracing.Start(ctx, "TwoFactorUseCase.ConfirmEnrollment")
+	defer span.End()
+
+	if user.TOTPEnabled {
+		return entity.ErrTwoFactorAlreadyEnabled
+	}
+
+	if len(user.TOTPSecret) == 0 {
+		return entity.ErrTwoFactorNotEnrolled
+	}
+
+	step, ok := tokens.ValidateTOTP(user.TOTPSecret, code, time.Now())
+	if !ok {
+		return entity.ErrInvalidSecondFactorCode
+	}
+
+	return uc.repo.EnableTOTP(ctx, user.ID, step)
+}
+
+// Verify проверяет код из приложения или одноразовый код восстановления.
+// Если второй фактор еще не подтвержден, проверка кода его подтверждает.
+func (uc *TwoFactorUseCase) Verify(ctx context.Context, user *entity.User, code string) error {
+	ctx, span := tracing.Start(ctx, "TwoFactorUseCase.Verify")
+	defer span.End()
+
+	if !user.TOTPEnabled {
+		return uc.ConfirmEnrollment(ctx, user, code)
+	}
+
+	if step, ok := tokens.ValidateTOTP(user.TOTPSecret, code, time.Now()); ok {
+		return uc.repo.UseTOTPStep(ctx, user.ID, step)
+	}
+
+	return uc.useRecoveryCode(ctx, user, code)
+}
+
+func (uc *TwoFactorUseCase) Disable(ctx context.Context, user *entity.User, code string) error {
+	ctx, span := tracing.Start(ctx, "TwoFactorUseCase.Disable")
+	defer span.End()
+
+	if uc.required {
+		return entity.ErrTwoFactorRequired
+	}
+
+	if !user.TOTPEnabled {
+		return entity.ErrTwoFactorNotEnrolled
+	}
+
+	if err := uc.Verify(ctx, user, code); err != nil {
+		return err
+	}
+
+	return uc.repo.DisableTOTP(ctx, user.ID)
+}
+
+func (uc *TwoFactorUseCase) useRecoveryCode(ctx context.Context, user *entity.User, code string) error {
+	codes, err := uc.repo.GetRecoveryCodes(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+
+	normalized := []byte(tokens.NormalizeRecoveryCode(code))
+
+	for _, recoveryCode := range codes {
+		err = bcrypt.CompareHashAndPassword([]byte(recoveryCode.Hash), normalized)
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return uc.repo.UseRecoveryCode(ctx, recoveryCode.ID)
+	}
+
+	return entity.ErrInvalidSecondFactorCode
+}
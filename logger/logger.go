@@ -3,10 +3,10 @@ package logger
 import (
 	"os"
 	"runtime/debug"
-	"strconv"
 	"sync"
 	"time"
 
+	"github.com/llravell/go-pass/pkg/tracing"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/pkgerrors"
 )
@@ -20,11 +20,14 @@ func Get() zerolog.Logger {
 		zerolog.ErrorStackMarshaler = pkgerrors.MarshalStack
 		zerolog.TimeFieldFormat = time.RFC3339Nano
 
-		logLevel, err := strconv.Atoi(os.Getenv("LOG_LEVEL"))
-		if err != nil {
-			logLevel = int(zerolog.InfoLevel) // default to INFO
+		logLevel, err := zerolog.ParseLevel(os.Getenv("LOG_LEVEL"))
+		if err != nil || logLevel == zerolog.NoLevel {
+			logLevel = zerolog.InfoLevel // default to INFO
 		}
 
+		// уровень глобальный, чтобы его можно было сменить без пересоздания логгера
+		zerolog.SetGlobalLevel(logLevel)
+
 		output := zerolog.ConsoleWriter{
 			Out:        os.Stdout,
 			TimeFormat: time.RFC3339,
@@ -38,12 +41,23 @@ func Get() zerolog.Logger {
 		}
 
 		log = zerolog.New(output).
-			Level(zerolog.Level(logLevel)). //nolint:gosec // disable G115
 			With().
 			Timestamp().
 			Str("go_version", goVersion).
-			Logger()
+			Logger().
+			Hook(tracing.ZerologHook{})
 	})
 
 	return log
 }
+
+// SetLevel меняет уровень логирования на лету, например при перечитывании конфигурации.
+func SetLevel(level zerolog.Level) {
+	zerolog.SetGlobalLevel(level)
+}
+
+// Sync сбрасывает на диск буферы stdout, куда пишутся логи, перед выходом.
+// Для пайпов и терминалов Sync не поддерживается, эту ошибку игнорируем.
+func Sync() {
+	_ = os.Stdout.Sync()
+}
@@ -1,12 +1,23 @@
 package auth
 
 import (
+	"errors"
+	"sort"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/golang-jwt/jwt/v4"
 )
 
+const purposeLoginChallenge = "login_challenge"
+
+var (
+	ErrUnexpectedTokenPurpose = errors.New("unexpected token purpose")
+	ErrNoSigningKey           = errors.New("no active jwt signing key")
+	ErrUnknownKeyID           = errors.New("unknown jwt key id")
+)
+
 type UnexpectedSigningMethodError struct {
 	alg string
 }
@@ -15,41 +26,174 @@ func (e *UnexpectedSigningMethodError) Error() string {
 	return "unexpected signing method: " + e.alg
 }
 
+// Claims дополняет стандартные поля идентификатором устройства,
+// по нему сервер проверяет, не отозвана ли сессия.
+// Purpose отличает служебные токены (например, вызов второго фактора) от токенов доступа.
+type Claims struct {
+	jwt.RegisteredClaims
+
+	DeviceID int    `json:"device_id,omitempty"`
+	Purpose  string `json:"purpose,omitempty"`
+}
+
+// JWTManager подписывает токены активным ключом, а проверяет любым из известных
+// ключей, выбирая его по заголовку kid. Так токены, выпущенные до ротации,
+// остаются действительными, пока старый ключ не выведен из набора.
 type JWTManager struct {
-	secret []byte
+	mu     sync.RWMutex
+	active *SigningKey
+	keys   map[string]*SigningKey
 }
 
+// NewJWTManager создает менеджер с единственным ключом HS256 на общем секрете.
 func NewJWTManager(secret string) *JWTManager {
-	return &JWTManager{
-		secret: []byte(secret),
+	key := NewHMACKey(secret)
+
+	return NewRotatingJWTManager(key, key)
+}
+
+// NewRotatingJWTManager создает менеджер с заданным набором ключей,
+// дальше набор обновляется через SetKeys.
+func NewRotatingJWTManager(active *SigningKey, keys ...*SigningKey) *JWTManager {
+	m := &JWTManager{}
+	m.SetKeys(active, keys)
+
+	return m
+}
+
+// SetKeys заменяет набор ключей. Активный ключ всегда доступен и для проверки.
+func (m *JWTManager) SetKeys(active *SigningKey, keys []*SigningKey) {
+	byID := make(map[string]*SigningKey, len(keys)+1)
+
+	for _, key := range keys {
+		byID[key.ID] = key
+	}
+
+	if active != nil {
+		byID[active.ID] = active
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.active = active
+	m.keys = byID
+}
+
+// PublicKeys возвращает асимметричные ключи, которыми сейчас проверяются токены.
+func (m *JWTManager) PublicKeys() []*SigningKey {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	keys := make([]*SigningKey, 0, len(m.keys))
+
+	for _, key := range m.keys {
+		if IsAsymmetric(key.Algorithm) {
+			keys = append(keys, key)
+		}
 	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].CreatedAt.After(keys[j].CreatedAt)
+	})
+
+	return keys
 }
 
 func (m *JWTManager) Issue(userID int, ttl time.Duration) (string, error) {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
-		ExpiresAt: jwt.NewNumericDate(time.Now().Add(ttl)),
-		Subject:   strconv.Itoa(userID),
+	return m.IssueForDevice(userID, 0, ttl)
+}
+
+func (m *JWTManager) IssueForDevice(userID int, deviceID int, ttl time.Duration) (string, error) {
+	return m.sign(Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(ttl)),
+			Subject:   strconv.Itoa(userID),
+		},
+		DeviceID: deviceID,
 	})
+}
+
+// IssueChallenge выдает токен, подтверждающий, что пароль уже проверен
+// и осталось предъявить второй фактор. Как токен доступа он не принимается.
+func (m *JWTManager) IssueChallenge(userID int, ttl time.Duration) (string, error) {
+	return m.sign(Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(ttl)),
+			Subject:   strconv.Itoa(userID),
+		},
+		Purpose: purposeLoginChallenge,
+	})
+}
 
-	tokenString, err := token.SignedString(m.secret)
+func (m *JWTManager) ParseChallenge(tokenString string) (int, error) {
+	claims := &Claims{}
+
+	_, err := jwt.ParseWithClaims(tokenString, claims, m.keyFunc)
 	if err != nil {
-		return "", err
+		return 0, err
 	}
 
-	return tokenString, nil
+	if claims.Purpose != purposeLoginChallenge {
+		return 0, ErrUnexpectedTokenPurpose
+	}
+
+	return strconv.Atoi(claims.Subject)
 }
 
 func (m *JWTManager) Parse(tokenString string) (*jwt.Token, error) {
-	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		if alg := token.Method.Alg(); alg != jwt.SigningMethodHS256.Name {
-			return nil, &UnexpectedSigningMethodError{alg}
-		}
-
-		return m.secret, nil
-	})
+	token, err := jwt.Parse(tokenString, m.keyFunc)
 	if err != nil {
 		return nil, err
 	}
 
+	if claims, ok := token.Claims.(jwt.MapClaims); ok {
+		if purpose, ok := claims["purpose"].(string); ok && len(purpose) > 0 {
+			return nil, ErrUnexpectedTokenPurpose
+		}
+	}
+
 	return token, nil
 }
+
+func (m *JWTManager) sign(claims Claims) (string, error) {
+	m.mu.RLock()
+	key := m.active
+	m.mu.RUnlock()
+
+	if key == nil {
+		return "", ErrNoSigningKey
+	}
+
+	token := jwt.NewWithClaims(key.method(), claims)
+	if key.ID != "" {
+		token.Header["kid"] = key.ID
+	}
+
+	tokenString, err := token.SignedString(key.private)
+	if err != nil {
+		return "", err
+	}
+
+	return tokenString, nil
+}
+
+// keyFunc выбирает ключ по kid и требует, чтобы алгоритм токена совпадал
+// с алгоритмом ключа: иначе открытый ключ можно было бы выдать за секрет HS256.
+func (m *JWTManager) keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	m.mu.RLock()
+	key, ok := m.keys[kid]
+	m.mu.RUnlock()
+
+	if !ok {
+		return nil, ErrUnknownKeyID
+	}
+
+	if alg := token.Method.Alg(); alg != key.Algorithm {
+		return nil, &UnexpectedSigningMethodError{alg}
+	}
+
+	return key.verificationKey(), nil
+}
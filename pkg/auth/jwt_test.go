@@ -0,0 +1,95 @@
+package auth_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/llravell/go-pass/pkg/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotatingJWTManager(t *testing.T) {
+	for _, algorithm := range []string{auth.AlgorithmEdDSA, auth.AlgorithmES256} {
+		t.Run(algorithm, func(t *testing.T) {
+			oldKey, err := auth.GenerateSigningKey(algorithm)
+			require.NoError(t, err)
+
+			jwtManager := auth.NewRotatingJWTManager(oldKey)
+
+			oldToken, err := jwtManager.Issue(1, time.Hour)
+			require.NoError(t, err)
+
+			newKey, err := auth.GenerateSigningKey(algorithm)
+			require.NoError(t, err)
+
+			jwtManager.SetKeys(newKey, []*auth.SigningKey{oldKey})
+
+			newToken, err := jwtManager.Issue(1, time.Hour)
+			require.NoError(t, err)
+
+			token, err := jwtManager.Parse(newToken)
+			require.NoError(t, err)
+			assert.Equal(t, newKey.ID, token.Header["kid"])
+
+			_, err = jwtManager.Parse(oldToken)
+			require.NoError(t, err, "token signed before rotation is accepted during grace period")
+
+			jwtManager.SetKeys(newKey, nil)
+
+			_, err = jwtManager.Parse(oldToken)
+			require.ErrorIs(t, err, auth.ErrUnknownKeyID)
+		})
+	}
+
+	t.Run("restores key from pem", func(t *testing.T) {
+		key, err := auth.GenerateSigningKey(auth.AlgorithmEdDSA)
+		require.NoError(t, err)
+
+		privatePEM, err := key.MarshalPrivateKey()
+		require.NoError(t, err)
+
+		restored, err := auth.ParseSigningKey(key.ID, key.Algorithm, privatePEM, key.CreatedAt)
+		require.NoError(t, err)
+
+		token, err := auth.NewRotatingJWTManager(key).Issue(1, time.Hour)
+		require.NoError(t, err)
+
+		_, err = auth.NewRotatingJWTManager(restored).Parse(token)
+		require.NoError(t, err)
+
+		_, err = auth.ParseSigningKey(key.ID, auth.AlgorithmES256, privatePEM, key.CreatedAt)
+		require.ErrorIs(t, err, auth.ErrInvalidSigningKey)
+	})
+
+	t.Run("rejects hmac token signed with public key", func(t *testing.T) {
+		key, err := auth.GenerateSigningKey(auth.AlgorithmEdDSA)
+		require.NoError(t, err)
+
+		publicPEM, err := key.PublicKeyPEM()
+		require.NoError(t, err)
+
+		forged := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{Subject: "1"})
+		forged.Header["kid"] = key.ID
+
+		tokenString, err := forged.SignedString(publicPEM)
+		require.NoError(t, err)
+
+		_, err = auth.NewRotatingJWTManager(key).Parse(tokenString)
+
+		var methodErr *auth.UnexpectedSigningMethodError
+		require.ErrorAs(t, err, &methodErr)
+	})
+
+	t.Run("publishes only asymmetric keys", func(t *testing.T) {
+		assert.Empty(t, auth.NewJWTManager("secret").PublicKeys())
+
+		key, err := auth.GenerateSigningKey(auth.AlgorithmES256)
+		require.NoError(t, err)
+
+		keys := auth.NewRotatingJWTManager(key).PublicKeys()
+		require.Len(t, keys, 1)
+		assert.Equal(t, key.ID, keys[0].ID)
+	})
+}
@@ -0,0 +1,163 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	AlgorithmEdDSA = "EdDSA"
+	AlgorithmES256 = "ES256"
+	AlgorithmHS256 = "HS256"
+
+	keyIDLength = 12
+)
+
+var (
+	ErrUnsupportedAlgorithm = errors.New("unsupported jwt signing algorithm")
+	ErrInvalidSigningKey    = errors.New("invalid jwt signing key")
+	ErrSymmetricKey         = errors.New("symmetric jwt key has no public part")
+)
+
+// IsAsymmetric сообщает, можно ли проверять подпись алгоритма открытым ключом.
+func IsAsymmetric(algorithm string) bool {
+	return algorithm == AlgorithmEdDSA || algorithm == AlgorithmES256
+}
+
+// SigningKey — ключ подписи токенов, ID попадает в заголовок kid.
+// Для HS256 ключом служит общий секрет, у асимметричных ключей
+// открытую часть можно отдавать другим сервисам для проверки токенов.
+type SigningKey struct {
+	ID        string
+	Algorithm string
+	CreatedAt time.Time
+
+	private interface{}
+}
+
+func NewHMACKey(secret string) *SigningKey {
+	return &SigningKey{
+		Algorithm: AlgorithmHS256,
+		private:   []byte(secret),
+	}
+}
+
+func GenerateSigningKey(algorithm string) (*SigningKey, error) {
+	id, err := generateKeyID()
+	if err != nil {
+		return nil, err
+	}
+
+	key := &SigningKey{
+		ID:        id,
+		Algorithm: algorithm,
+		CreatedAt: time.Now(),
+	}
+
+	switch algorithm {
+	case AlgorithmEdDSA:
+		_, key.private, err = ed25519.GenerateKey(rand.Reader)
+	case AlgorithmES256:
+		key.private, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	default:
+		return nil, ErrUnsupportedAlgorithm
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+// ParseSigningKey восстанавливает асимметричный ключ из PEM (PKCS #8).
+func ParseSigningKey(id string, algorithm string, privatePEM []byte, createdAt time.Time) (*SigningKey, error) {
+	block, _ := pem.Decode(privatePEM)
+	if block == nil {
+		return nil, ErrInvalidSigningKey
+	}
+
+	private, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	switch private.(type) {
+	case ed25519.PrivateKey:
+		if algorithm != AlgorithmEdDSA {
+			return nil, ErrInvalidSigningKey
+		}
+	case *ecdsa.PrivateKey:
+		if algorithm != AlgorithmES256 {
+			return nil, ErrInvalidSigningKey
+		}
+	default:
+		return nil, ErrUnsupportedAlgorithm
+	}
+
+	return &SigningKey{
+		ID:        id,
+		Algorithm: algorithm,
+		CreatedAt: createdAt,
+		private:   private,
+	}, nil
+}
+
+func (k *SigningKey) MarshalPrivateKey() ([]byte, error) {
+	if !IsAsymmetric(k.Algorithm) {
+		return nil, ErrSymmetricKey
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(k.private)
+	if err != nil {
+		return nil, err
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
+
+// PublicKeyPEM возвращает открытый ключ в формате PKIX (SubjectPublicKeyInfo).
+func (k *SigningKey) PublicKeyPEM() ([]byte, error) {
+	if !IsAsymmetric(k.Algorithm) {
+		return nil, ErrSymmetricKey
+	}
+
+	der, err := x509.MarshalPKIXPublicKey(k.verificationKey())
+	if err != nil {
+		return nil, err
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), nil
+}
+
+func (k *SigningKey) method() jwt.SigningMethod {
+	return jwt.GetSigningMethod(k.Algorithm)
+}
+
+func (k *SigningKey) verificationKey() interface{} {
+	if signer, ok := k.private.(crypto.Signer); ok {
+		return signer.Public()
+	}
+
+	return k.private
+}
+
+func generateKeyID() (string, error) {
+	buf := make([]byte, keyIDLength)
+
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
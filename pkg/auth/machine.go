@@ -0,0 +1,85 @@
+package auth
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// MachineTokenPrefix отличает токены сервисных аккаунтов от JWT.
+const MachineTokenPrefix = "gpt_"
+
+const machineSecretSize = 32
+
+var ErrInvalidMachineToken = errors.New("invalid machine token")
+
+// Токен сервисного аккаунта имеет вид gpt_<id>.<auth secret>.<key secret>.
+// На сервер отправляется только gpt_<id>.<auth secret>, сервер хранит хеш
+// auth secret. Key secret остается у владельца токена: из него выводится
+// ключ, которым зашифрован ключ коллекции, поэтому сервер не может сам
+// расшифровать записи, даже видя все запросы.
+type MachineToken struct {
+	ID         int
+	AuthSecret string
+	KeySecret  string
+}
+
+// GenerateMachineSecret возвращает случайный секрет и его хеш для хранения.
+func GenerateMachineSecret() (string, string, error) {
+	secret, err := randomString(machineSecretSize)
+	if err != nil {
+		return "", "", err
+	}
+
+	return secret, HashRefreshToken(secret), nil
+}
+
+// GenerateMachineKeySecret создает секрет, из которого клиент выводит ключ
+// обертки. Он генерируется на клиенте и не покидает его.
+func GenerateMachineKeySecret() (string, error) {
+	return randomString(machineSecretSize)
+}
+
+func (t *MachineToken) String() string {
+	return t.Bearer() + "." + t.KeySecret
+}
+
+// Bearer — часть токена, которая передается серверу.
+func (t *MachineToken) Bearer() string {
+	return MachineTokenPrefix + strconv.Itoa(t.ID) + "." + t.AuthSecret
+}
+
+// IsMachineToken сообщает, что строка авторизации — токен сервисного аккаунта.
+func IsMachineToken(token string) bool {
+	return strings.HasPrefix(token, MachineTokenPrefix)
+}
+
+// ParseMachineToken разбирает полный токен или только его серверную часть,
+// в последнем случае KeySecret пустой.
+func ParseMachineToken(token string) (*MachineToken, error) {
+	rest, ok := strings.CutPrefix(token, MachineTokenPrefix)
+	if !ok {
+		return nil, ErrInvalidMachineToken
+	}
+
+	parts := strings.Split(rest, ".")
+	if len(parts) < 2 || len(parts) > 3 || parts[1] == "" {
+		return nil, ErrInvalidMachineToken
+	}
+
+	id, err := strconv.Atoi(parts[0])
+	if err != nil || id <= 0 {
+		return nil, ErrInvalidMachineToken
+	}
+
+	parsed := &MachineToken{
+		ID:         id,
+		AuthSecret: parts[1],
+	}
+
+	if len(parts) == 3 {
+		parsed.KeySecret = parts[2]
+	}
+
+	return parsed, nil
+}
@@ -0,0 +1,34 @@
+package auth_test
+
+import (
+	"testing"
+
+	"github.com/llravell/go-pass/pkg/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMachineToken(t *testing.T) {
+	t.Run("round trip keeps key secret out of bearer", func(t *testing.T) {
+		token := &auth.MachineToken{ID: 42, AuthSecret: "auth", KeySecret: "key"}
+
+		assert.Equal(t, "gpt_42.auth", token.Bearer())
+		assert.True(t, auth.IsMachineToken(token.String()))
+
+		parsed, err := auth.ParseMachineToken(token.String())
+		require.NoError(t, err)
+		assert.Equal(t, token, parsed)
+
+		bearer, err := auth.ParseMachineToken(token.Bearer())
+		require.NoError(t, err)
+		assert.Equal(t, 42, bearer.ID)
+		assert.Empty(t, bearer.KeySecret)
+	})
+
+	t.Run("rejects malformed tokens", func(t *testing.T) {
+		for _, token := range []string{"", "gpt_", "gpt_x.auth", "gpt_0.auth", "gpt_1.", "gpt_1.a.b.c", "jwt.token"} {
+			_, err := auth.ParseMachineToken(token)
+			assert.ErrorIs(t, err, auth.ErrInvalidMachineToken, token)
+		}
+	})
+}
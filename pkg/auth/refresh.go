@@ -0,0 +1,40 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+)
+
+const refreshTokenSize = 32
+
+// GenerateRefreshToken возвращает случайный непрозрачный токен и его хеш для хранения.
+func GenerateRefreshToken() (string, string, error) {
+	token, err := randomString(refreshTokenSize)
+	if err != nil {
+		return "", "", err
+	}
+
+	return token, HashRefreshToken(token), nil
+}
+
+func HashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func GenerateTokenFamilyID() (string, error) {
+	return randomString(refreshTokenSize / 2)
+}
+
+func randomString(size int) (string, error) {
+	buf := make([]byte, size)
+
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base32"
+	"strings"
+	"time"
+
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+)
+
+const (
+	totpPeriod = 30
+	totpSkew   = 1
+
+	recoveryCodeSize = 10
+)
+
+var totpValidateOpts = totp.ValidateOpts{
+	Period:    totpPeriod,
+	Digits:    otp.DigitsSix,
+	Algorithm: otp.AlgorithmSHA1,
+}
+
+// GenerateTOTP создает секрет и otpauth:// URI для приложения-аутентификатора.
+func GenerateTOTP(issuer, account string) (string, string, error) {
+	key, err := totp.Generate(totp.GenerateOpts{
+		Issuer:      issuer,
+		AccountName: account,
+		Period:      totpPeriod,
+		Digits:      otp.DigitsSix,
+		Algorithm:   otp.AlgorithmSHA1,
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	return key.Secret(), key.URL(), nil
+}
+
+// ValidateTOTP проверяет код с допуском в один шаг и возвращает номер шага,
+// которым код был выпущен: по нему сервер отклоняет повторное использование кода.
+func ValidateTOTP(secret, code string, now time.Time) (int64, bool) {
+	code = strings.TrimSpace(code)
+	step := now.Unix() / totpPeriod
+
+	for skew := int64(-totpSkew); skew <= totpSkew; skew++ {
+		candidateStep := step + skew
+
+		expected, err := totp.GenerateCodeCustom(secret, time.Unix(candidateStep*totpPeriod, 0), totpValidateOpts)
+		if err != nil {
+			return 0, false
+		}
+
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return candidateStep, true
+		}
+	}
+
+	return 0, false
+}
+
+// GenerateRecoveryCodes возвращает одноразовые коды вида xxxxx-xxxxx.
+func GenerateRecoveryCodes(count int) ([]string, error) {
+	codes := make([]string, 0, count)
+	encoding := base32.StdEncoding.WithPadding(base32.NoPadding)
+
+	for range count {
+		buf := make([]byte, recoveryCodeSize*5/8)
+
+		if _, err := rand.Read(buf); err != nil {
+			return nil, err
+		}
+
+		code := strings.ToLower(encoding.EncodeToString(buf))
+		codes = append(codes, code[:recoveryCodeSize/2]+"-"+code[recoveryCodeSize/2:])
+	}
+
+	return codes, nil
+}
+
+func NormalizeRecoveryCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+
+	return strings.ReplaceAll(code, " ", "")
+}
@@ -0,0 +1,73 @@
+package auth_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/llravell/go-pass/pkg/auth"
+	"github.com/pquerna/otp/totp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTOTP(t *testing.T) {
+	secret, uri, err := auth.GenerateTOTP("go-pass", "alice")
+	require.NoError(t, err)
+	assert.Contains(t, uri, "otpauth://totp/go-pass:alice")
+
+	now := time.Unix(1_700_000_000, 0)
+
+	t.Run("accepts current and adjacent codes", func(t *testing.T) {
+		for _, shift := range []time.Duration{-30 * time.Second, 0, 30 * time.Second} {
+			code, err := totp.GenerateCode(secret, now.Add(shift))
+			require.NoError(t, err)
+
+			step, ok := auth.ValidateTOTP(secret, code, now)
+			require.True(t, ok)
+			assert.Equal(t, now.Add(shift).Unix()/30, step)
+		}
+	})
+
+	t.Run("rejects stale code", func(t *testing.T) {
+		code, err := totp.GenerateCode(secret, now.Add(-2*time.Minute))
+		require.NoError(t, err)
+
+		_, ok := auth.ValidateTOTP(secret, code, now)
+		assert.False(t, ok)
+	})
+}
+
+func TestGenerateRecoveryCodes(t *testing.T) {
+	codes, err := auth.GenerateRecoveryCodes(10)
+	require.NoError(t, err)
+	require.Len(t, codes, 10)
+
+	seen := make(map[string]bool)
+
+	for _, code := range codes {
+		assert.Regexp(t, `^[a-z2-7]{5}-[a-z2-7]{5}$`, code)
+		assert.False(t, seen[code])
+
+		seen[code] = true
+	}
+}
+
+func TestChallengeToken(t *testing.T) {
+	jwtManager := auth.NewJWTManager("secret")
+
+	challenge, err := jwtManager.IssueChallenge(7, time.Minute)
+	require.NoError(t, err)
+
+	userID, err := jwtManager.ParseChallenge(challenge)
+	require.NoError(t, err)
+	assert.Equal(t, 7, userID)
+
+	_, err = jwtManager.Parse(challenge)
+	require.ErrorIs(t, err, auth.ErrUnexpectedTokenPurpose)
+
+	accessToken, err := jwtManager.Issue(7, time.Minute)
+	require.NoError(t, err)
+
+	_, err = jwtManager.ParseChallenge(accessToken)
+	require.ErrorIs(t, err, auth.ErrUnexpectedTokenPurpose)
+}
@@ -0,0 +1,109 @@
+package certs
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"os"
+	"sync"
+)
+
+var ErrInvalidCABundle = errors.New("no certificates found in CA bundle")
+
+// Reloader хранит серверный сертификат и CA клиентов, которые можно
+// перечитать с диска без перезапуска сервера.
+type Reloader struct {
+	certFile     string
+	keyFile      string
+	clientCAFile string
+
+	mu        sync.RWMutex
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+}
+
+func NewReloader(certFile, keyFile, clientCAFile string) (*Reloader, error) {
+	reloader := &Reloader{
+		certFile:     certFile,
+		keyFile:      keyFile,
+		clientCAFile: clientCAFile,
+	}
+
+	if err := reloader.Reload(); err != nil {
+		return nil, err
+	}
+
+	return reloader, nil
+}
+
+func (r *Reloader) Reload() error {
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return err
+	}
+
+	var clientCAs *x509.CertPool
+
+	if len(r.clientCAFile) > 0 {
+		clientCAs, err = LoadCertPool(r.clientCAFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cert = &cert
+	r.clientCAs = clientCAs
+
+	return nil
+}
+
+// TLSConfig возвращает конфиг, который на каждое подключение берет актуальные сертификаты.
+// Если задан CA клиентов, клиентский сертификат обязателен (mTLS).
+func (r *Reloader) TLSConfig() *tls.Config {
+	return r.tlsConfig(true)
+}
+
+// PublicTLSConfig — как TLSConfig, но без проверки клиентских сертификатов:
+// для страниц, которые открывают в браузере люди без сертификата.
+func (r *Reloader) PublicTLSConfig() *tls.Config {
+	return r.tlsConfig(false)
+}
+
+func (r *Reloader) tlsConfig(verifyClients bool) *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			r.mu.RLock()
+			defer r.mu.RUnlock()
+
+			config := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*r.cert},
+			}
+
+			if verifyClients && r.clientCAs != nil {
+				config.ClientCAs = r.clientCAs
+				config.ClientAuth = tls.RequireAndVerifyClientCert
+			}
+
+			return config, nil
+		},
+	}
+}
+
+func LoadCertPool(path string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, ErrInvalidCABundle
+	}
+
+	return pool, nil
+}
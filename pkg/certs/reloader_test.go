@@ -0,0 +1,149 @@
+package certs_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/llravell/go-pass/pkg/certs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeSelfSigned(t *testing.T, dir, commonName string) (string, string, *x509.Certificate) {
+	t.Helper()
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+		DNSNames:              []string{"localhost"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(privateKey)
+	require.NoError(t, err)
+
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return certFile, keyFile, cert
+}
+
+func handshake(t *testing.T, serverConfig *tls.Config, clientConfig *tls.Config) (*x509.Certificate, error) {
+	t.Helper()
+
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", serverConfig)
+	require.NoError(t, err)
+
+	defer listener.Close()
+
+	serverErr := make(chan error, 1)
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			serverErr <- err
+
+			return
+		}
+
+		defer conn.Close()
+
+		tlsConn, _ := conn.(*tls.Conn)
+		serverErr <- tlsConn.Handshake()
+	}()
+
+	client, err := tls.Dial("tcp", listener.Addr().String(), clientConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	defer client.Close()
+
+	if err = <-serverErr; err != nil {
+		return nil, err
+	}
+
+	return client.ConnectionState().PeerCertificates[0], nil
+}
+
+func TestReloader(t *testing.T) {
+	t.Run("serves reloaded certificate", func(t *testing.T) {
+		dir := t.TempDir()
+		certFile, keyFile, first := writeSelfSigned(t, dir, "first")
+
+		reloader, err := certs.NewReloader(certFile, keyFile, "")
+		require.NoError(t, err)
+
+		clientConfig := &tls.Config{InsecureSkipVerify: true} //nolint:gosec
+
+		served, err := handshake(t, reloader.TLSConfig(), clientConfig)
+		require.NoError(t, err)
+		assert.Equal(t, first.Raw, served.Raw)
+
+		_, _, second := writeSelfSigned(t, dir, "second")
+		require.NoError(t, reloader.Reload())
+
+		served, err = handshake(t, reloader.TLSConfig(), clientConfig)
+		require.NoError(t, err)
+		assert.Equal(t, second.Raw, served.Raw)
+	})
+
+	t.Run("requires client certificate with client CA", func(t *testing.T) {
+		serverDir, clientDir := t.TempDir(), t.TempDir()
+		certFile, keyFile, _ := writeSelfSigned(t, serverDir, "server")
+		clientCertFile, clientKeyFile, _ := writeSelfSigned(t, clientDir, "client")
+
+		reloader, err := certs.NewReloader(certFile, keyFile, clientCertFile)
+		require.NoError(t, err)
+
+		_, err = handshake(t, reloader.TLSConfig(), &tls.Config{InsecureSkipVerify: true}) //nolint:gosec
+		require.Error(t, err)
+
+		clientCert, err := tls.LoadX509KeyPair(clientCertFile, clientKeyFile)
+		require.NoError(t, err)
+
+		_, err = handshake(t, reloader.TLSConfig(), &tls.Config{
+			InsecureSkipVerify: true, //nolint:gosec
+			Certificates:       []tls.Certificate{clientCert},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("fails on invalid CA bundle", func(t *testing.T) {
+		dir := t.TempDir()
+		certFile, keyFile, _ := writeSelfSigned(t, dir, "server")
+
+		caFile := filepath.Join(dir, "ca.pem")
+		require.NoError(t, os.WriteFile(caFile, []byte("garbage"), 0o600))
+
+		_, err := certs.NewReloader(certFile, keyFile, caFile)
+		assert.ErrorIs(t, err, certs.ErrInvalidCABundle)
+	})
+}
@@ -0,0 +1,89 @@
+package credhelper
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/url"
+	"strings"
+)
+
+const (
+	DockerActionGet   = "get"
+	DockerActionStore = "store"
+	DockerActionErase = "erase"
+	DockerActionList  = "list"
+)
+
+// ErrDockerCredentialsNotFound текст ошибки, по которому docker отличает
+// отсутствие учетных данных от сбоя хелпера.
+var ErrDockerCredentialsNotFound = errors.New("credentials not found in native keychain")
+
+var ErrEmptyDockerServerURL = errors.New("no credentials server URL")
+
+// DockerCredential описание учетных данных в формате протокола docker credential helper.
+// https://github.com/docker/docker-credential-helpers
+type DockerCredential struct {
+	ServerURL string `json:"ServerURL"`
+	Username  string `json:"Username"`
+	Secret    string `json:"Secret"`
+}
+
+func ReadDockerServerURL(r io.Reader) (string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+
+	serverURL := strings.TrimSpace(string(data))
+	if len(serverURL) == 0 {
+		return "", ErrEmptyDockerServerURL
+	}
+
+	return serverURL, nil
+}
+
+func ReadDockerCredential(r io.Reader) (*DockerCredential, error) {
+	var cred DockerCredential
+
+	if err := json.NewDecoder(r).Decode(&cred); err != nil {
+		return nil, err
+	}
+
+	if len(strings.TrimSpace(cred.ServerURL)) == 0 {
+		return nil, ErrEmptyDockerServerURL
+	}
+
+	return &cred, nil
+}
+
+func WriteDockerCredential(w io.Writer, cred *DockerCredential) error {
+	return json.NewEncoder(w).Encode(cred)
+}
+
+// WriteDockerCredentialList пишет отображение адрес реестра -> имя пользователя.
+func WriteDockerCredentialList(w io.Writer, list map[string]string) error {
+	return json.NewEncoder(w).Encode(list)
+}
+
+// NormalizeDockerServerURL приводит адрес реестра к виду scheme://host/path,
+// docker передает адреса как со схемой, так и без нее.
+func NormalizeDockerServerURL(serverURL string) (*url.URL, error) {
+	serverURL = strings.TrimSpace(serverURL)
+	if len(serverURL) == 0 {
+		return nil, ErrEmptyDockerServerURL
+	}
+
+	if !strings.Contains(serverURL, "://") {
+		serverURL = "https://" + serverURL
+	}
+
+	parsed, err := url.Parse(serverURL)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed.Path = strings.TrimSuffix(parsed.Path, "/")
+
+	return parsed, nil
+}
@@ -0,0 +1,50 @@
+package credhelper_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/llravell/go-pass/pkg/credhelper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeDockerServerURL(t *testing.T) {
+	cases := map[string]string{
+		"registry.example.com":          "https://registry.example.com",
+		"https://index.docker.io/v1/":   "https://index.docker.io/v1",
+		"http://localhost:5000":         "http://localhost:5000",
+		" ghcr.io/org/ ":                "https://ghcr.io/org",
+		"https://registry.example.com/": "https://registry.example.com",
+	}
+
+	for input, expected := range cases {
+		parsed, err := credhelper.NormalizeDockerServerURL(input)
+		require.NoError(t, err)
+
+		assert.Equal(t, expected, parsed.String(), input)
+	}
+
+	_, err := credhelper.NormalizeDockerServerURL("  ")
+	assert.ErrorIs(t, err, credhelper.ErrEmptyDockerServerURL)
+}
+
+func TestDockerCredentialRoundTrip(t *testing.T) {
+	out := &bytes.Buffer{}
+	cred := &credhelper.DockerCredential{
+		ServerURL: "https://ghcr.io",
+		Username:  "bob",
+		Secret:    "token",
+	}
+
+	require.NoError(t, credhelper.WriteDockerCredential(out, cred))
+
+	decoded, err := credhelper.ReadDockerCredential(out)
+	require.NoError(t, err)
+
+	assert.Equal(t, cred, decoded)
+
+	_, err = credhelper.ReadDockerCredential(strings.NewReader(`{"Username":"bob"}`))
+	assert.ErrorIs(t, err, credhelper.ErrEmptyDockerServerURL)
+}
@@ -0,0 +1,140 @@
+package credhelper
+
+import (
+	"bufio"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+)
+
+const (
+	GitActionGet   = "get"
+	GitActionStore = "store"
+	GitActionErase = "erase"
+)
+
+var ErrMalformedGitCredential = errors.New("malformed git credential line")
+
+// GitCredential описание учетных данных в формате протокола git credential helper.
+// https://git-scm.com/docs/git-credential#IOFMT
+type GitCredential struct {
+	Protocol string
+	Host     string
+	Path     string
+	Username string
+	Password string
+}
+
+func ReadGitCredential(r io.Reader) (*GitCredential, error) {
+	var cred GitCredential
+
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if len(line) == 0 {
+			break
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrMalformedGitCredential, line)
+		}
+
+		switch key {
+		case "protocol":
+			cred.Protocol = value
+		case "host":
+			cred.Host = value
+		case "path":
+			cred.Path = value
+		case "username":
+			cred.Username = value
+		case "password":
+			cred.Password = value
+		case "url":
+			if err := cred.setURL(value); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return &cred, nil
+}
+
+func WriteGitCredential(w io.Writer, cred *GitCredential) error {
+	writer := bufio.NewWriter(w)
+
+	attrs := [][2]string{
+		{"protocol", cred.Protocol},
+		{"host", cred.Host},
+		{"path", cred.Path},
+		{"username", cred.Username},
+		{"password", cred.Password},
+	}
+
+	for _, attr := range attrs {
+		if len(attr[1]) == 0 {
+			continue
+		}
+
+		if _, err := writer.WriteString(attr[0] + "=" + attr[1] + "\n"); err != nil {
+			return err
+		}
+	}
+
+	return writer.Flush()
+}
+
+func (cred *GitCredential) setURL(rawURL string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+
+	cred.Protocol = parsed.Scheme
+	cred.Host = parsed.Host
+	cred.Path = strings.TrimPrefix(parsed.Path, "/")
+
+	if parsed.User != nil {
+		cred.Username = parsed.User.Username()
+
+		if password, ok := parsed.User.Password(); ok {
+			cred.Password = password
+		}
+	}
+
+	return nil
+}
+
+// URL возвращает адрес ресурса без учетных данных.
+func (cred *GitCredential) URL() string {
+	u := url.URL{
+		Scheme: cred.Protocol,
+		Host:   cred.Host,
+	}
+
+	if len(cred.Path) > 0 {
+		u.Path = "/" + cred.Path
+	}
+
+	return u.String()
+}
+
+// Rejects сообщает, относится ли erase к сохраненному паролю. Git передает
+// отвергнутый пароль, и отказ для старого или чужого пароля, например от другого
+// помощника в цепочке, не должен помечать текущий. Без пароля отказ относится к любому.
+func (cred *GitCredential) Rejects(stored string) bool {
+	if len(cred.Password) == 0 {
+		return true
+	}
+
+	return subtle.ConstantTimeCompare([]byte(cred.Password), []byte(stored)) == 1
+}
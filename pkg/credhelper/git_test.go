@@ -0,0 +1,75 @@
+package credhelper_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/llravell/go-pass/pkg/credhelper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadGitCredential(t *testing.T) {
+	t.Run("reads attributes until blank line", func(t *testing.T) {
+		input := "protocol=https\nhost=github.com\nusername=bob\n\nignored=1\n"
+
+		cred, err := credhelper.ReadGitCredential(strings.NewReader(input))
+		require.NoError(t, err)
+
+		assert.Equal(t, &credhelper.GitCredential{
+			Protocol: "https",
+			Host:     "github.com",
+			Username: "bob",
+		}, cred)
+		assert.Equal(t, "https://github.com", cred.URL())
+	})
+
+	t.Run("reads url attribute", func(t *testing.T) {
+		cred, err := credhelper.ReadGitCredential(strings.NewReader("url=https://bob@example.com/org/repo.git\n"))
+		require.NoError(t, err)
+
+		assert.Equal(t, "https://example.com/org/repo.git", cred.URL())
+		assert.Equal(t, "bob", cred.Username)
+	})
+
+	t.Run("rejects malformed lines", func(t *testing.T) {
+		_, err := credhelper.ReadGitCredential(strings.NewReader("protocol\n"))
+
+		assert.ErrorIs(t, err, credhelper.ErrMalformedGitCredential)
+	})
+}
+
+func TestWriteGitCredential(t *testing.T) {
+	out := &bytes.Buffer{}
+
+	err := credhelper.WriteGitCredential(out, &credhelper.GitCredential{
+		Protocol: "https",
+		Host:     "github.com",
+		Username: "bob",
+		Password: "secret",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "protocol=https\nhost=github.com\nusername=bob\npassword=secret\n", out.String())
+}
+
+func TestGitCredentialRejects(t *testing.T) {
+	t.Run("matching password", func(t *testing.T) {
+		cred := &credhelper.GitCredential{Host: "github.com", Password: "token"}
+
+		assert.True(t, cred.Rejects("token"))
+	})
+
+	t.Run("other password does not touch stored one", func(t *testing.T) {
+		cred := &credhelper.GitCredential{Host: "github.com", Password: "old-token"}
+
+		assert.False(t, cred.Rejects("token"))
+	})
+
+	t.Run("erase without password", func(t *testing.T) {
+		cred := &credhelper.GitCredential{Host: "github.com"}
+
+		assert.True(t, cred.Rejects("token"))
+	})
+}
@@ -0,0 +1,165 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"io"
+)
+
+const sealInfo = "go-pass seal v1"
+
+// KeyPair — ключевая пара X25519 пользователя. Открытая часть публикуется
+// на сервере, закрытой вскрываются ключи записей, которыми с ним поделились.
+type KeyPair struct {
+	private *ecdh.PrivateKey
+}
+
+func GenerateKeyPair() (*KeyPair, error) {
+	private, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KeyPair{
+		private: private,
+	}, nil
+}
+
+// ParseKeyPair восстанавливает пару из строкового представления, полученного через String.
+func ParseKeyPair(encoded string) (*KeyPair, error) {
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	private, err := ecdh.X25519().NewPrivateKey(raw)
+	if err != nil {
+		return nil, ErrInvalidKeyLength
+	}
+
+	return &KeyPair{
+		private: private,
+	}, nil
+}
+
+// String возвращает закрытый ключ, хранить его можно только зашифрованным.
+func (pair *KeyPair) String() string {
+	return base64.StdEncoding.EncodeToString(pair.private.Bytes())
+}
+
+func (pair *KeyPair) PublicKey() string {
+	return base64.StdEncoding.EncodeToString(pair.private.PublicKey().Bytes())
+}
+
+// PublicKeyFingerprint возвращает отпечаток открытого ключа для сверки вне go-pass.
+func PublicKeyFingerprint(publicKey string) (string, error) {
+	recipient, err := parsePublicKey(publicKey)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(recipient.Bytes())
+
+	return "SHA256:" + base64.RawStdEncoding.EncodeToString(sum[:]), nil
+}
+
+// Seal шифрует текст для владельца открытого ключа: на эфемерной паре
+// вычисляется общий секрет X25519, из него через HKDF выводится ключ AES-GCM.
+// Результат — эфемерный открытый ключ, nonce и шифротекст в base64.
+func Seal(publicKey string, text string) (string, error) {
+	recipient, err := parsePublicKey(publicKey)
+	if err != nil {
+		return "", err
+	}
+
+	ephemeral, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return "", err
+	}
+
+	gcm, err := sealCipher(ephemeral, recipient, ephemeral.PublicKey())
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+
+	sealed := append(ephemeral.PublicKey().Bytes(), nonce...)
+	sealed = gcm.Seal(sealed, nonce, []byte(text), nil)
+
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func (pair *KeyPair) Open(sealed string) (string, error) {
+	data, err := base64.StdEncoding.DecodeString(sealed)
+	if err != nil {
+		return "", err
+	}
+
+	publicKeySize := len(pair.private.PublicKey().Bytes())
+	if len(data) < publicKeySize {
+		return "", ErrShortCiphertext
+	}
+
+	ephemeral, err := ecdh.X25519().NewPublicKey(data[:publicKeySize])
+	if err != nil {
+		return "", err
+	}
+
+	gcm, err := sealCipher(pair.private, ephemeral, ephemeral)
+	if err != nil {
+		return "", err
+	}
+
+	data = data[publicKeySize:]
+	if len(data) < gcm.NonceSize() {
+		return "", ErrShortCiphertext
+	}
+
+	nonce, data := data[:gcm.NonceSize()], data[gcm.NonceSize():]
+
+	plaintext, err := gcm.Open(nil, nonce, data, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return string(plaintext), nil
+}
+
+func parsePublicKey(encoded string) (*ecdh.PublicKey, error) {
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	return ecdh.X25519().NewPublicKey(raw)
+}
+
+// sealCipher выводит ключ из общего секрета, эфемерный ключ служит солью,
+// чтобы каждое сообщение шифровалось своим ключом.
+func sealCipher(private *ecdh.PrivateKey, peer *ecdh.PublicKey, ephemeral *ecdh.PublicKey) (cipher.AEAD, error) {
+	secret, err := private.ECDH(peer)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := hkdf.Key(sha256.New, secret, ephemeral.Bytes(), sealInfo, sha256.Size)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
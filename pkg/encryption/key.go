@@ -3,6 +3,7 @@ package encryption
 import (
 	"crypto/aes"
 	"crypto/cipher"
+	"crypto/hkdf"
 	"crypto/rand"
 	"crypto/sha256"
 	"encoding/base64"
@@ -12,6 +13,8 @@ import (
 
 var ErrShortCiphertext = errors.New("ciphertext too short")
 
+var ErrInvalidKeyLength = errors.New("invalid key length")
+
 type Key struct {
 	hash []byte
 }
@@ -24,6 +27,32 @@ func GenerateKeyFromMasterPass(masterPassword string) *Key {
 	}
 }
 
+// GenerateKey создает случайный ключ данных, например для расшаренной записи.
+func GenerateKey() (*Key, error) {
+	hash := make([]byte, sha256.Size)
+
+	if _, err := io.ReadFull(rand.Reader, hash); err != nil {
+		return nil, err
+	}
+
+	return &Key{
+		hash: hash,
+	}, nil
+}
+
+// DeriveKey выводит ключ из случайного секрета, например из секрета
+// токена сервисного аккаунта. info разделяет ключи разного назначения.
+func DeriveKey(secret string, info string) (*Key, error) {
+	hash, err := hkdf.Key(sha256.New, []byte(secret), nil, info, sha256.Size)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Key{
+		hash: hash,
+	}, nil
+}
+
 func (key *Key) String() string {
 	return base64.StdEncoding.EncodeToString(key.hash)
 }
@@ -79,3 +108,19 @@ func (key *Key) Decrypt(ciphertext string) (string, error) {
 
 	return string(plaintext), nil
 }
+
+// ParseKey восстанавливает ключ из строкового представления, полученного через String.
+func ParseKey(encoded string) (*Key, error) {
+	hash, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(hash) != sha256.Size {
+		return nil, ErrInvalidKeyLength
+	}
+
+	return &Key{
+		hash: hash,
+	}, nil
+}
@@ -37,4 +37,73 @@ func TestKey(t *testing.T) {
 
 		assert.Equal(t, text, decrypted)
 	})
+
+	t.Run("parse key from string", func(t *testing.T) {
+		key := encryption.GenerateKeyFromMasterPass(masterPassword)
+
+		parsed, err := encryption.ParseKey(key.String())
+		require.NoError(t, err)
+
+		assert.Equal(t, key.String(), parsed.String())
+
+		_, err = encryption.ParseKey("c2hvcnQ=")
+		assert.ErrorIs(t, err, encryption.ErrInvalidKeyLength)
+	})
+
+	t.Run("derived keys depend on secret and info", func(t *testing.T) {
+		key1, err := encryption.DeriveKey("token secret", "purpose")
+		require.NoError(t, err)
+
+		key2, err := encryption.DeriveKey("token secret", "purpose")
+		require.NoError(t, err)
+
+		key3, err := encryption.DeriveKey("token secret", "other purpose")
+		require.NoError(t, err)
+
+		assert.Equal(t, key1.String(), key2.String())
+		assert.NotEqual(t, key1.String(), key3.String())
+	})
+}
+
+func TestKeyPair(t *testing.T) {
+	alice, err := encryption.GenerateKeyPair()
+	require.NoError(t, err)
+
+	bob, err := encryption.GenerateKeyPair()
+	require.NoError(t, err)
+
+	t.Run("seal and open", func(t *testing.T) {
+		sealed, err := encryption.Seal(bob.PublicKey(), "data key")
+		require.NoError(t, err)
+
+		opened, err := bob.Open(sealed)
+		require.NoError(t, err)
+		assert.Equal(t, "data key", opened)
+
+		_, err = alice.Open(sealed)
+		require.Error(t, err)
+	})
+
+	t.Run("restores from string", func(t *testing.T) {
+		restored, err := encryption.ParseKeyPair(bob.String())
+		require.NoError(t, err)
+		assert.Equal(t, bob.PublicKey(), restored.PublicKey())
+
+		sealed, err := encryption.Seal(bob.PublicKey(), "data key")
+		require.NoError(t, err)
+
+		opened, err := restored.Open(sealed)
+		require.NoError(t, err)
+		assert.Equal(t, "data key", opened)
+	})
+
+	t.Run("random keys differ", func(t *testing.T) {
+		key1, err := encryption.GenerateKey()
+		require.NoError(t, err)
+
+		key2, err := encryption.GenerateKey()
+		require.NoError(t, err)
+
+		assert.NotEqual(t, key1.String(), key2.String())
+	})
 }
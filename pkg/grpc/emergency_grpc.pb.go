@@ -0,0 +1,312 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: api/emergency.proto
+
+package grpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Emergency_Grant_FullMethodName   = "/emergency.Emergency/Grant"
+	Emergency_Revoke_FullMethodName  = "/emergency.Emergency/Revoke"
+	Emergency_List_FullMethodName    = "/emergency.Emergency/List"
+	Emergency_Request_FullMethodName = "/emergency.Emergency/Request"
+	Emergency_Approve_FullMethodName = "/emergency.Emergency/Approve"
+	Emergency_Reject_FullMethodName  = "/emergency.Emergency/Reject"
+)
+
+// EmergencyClient is the client API for Emergency service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EmergencyClient interface {
+	Grant(ctx context.Context, in *EmergencyGrantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Revoke(ctx context.Context, in *EmergencyContactRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	List(ctx context.Context, in *EmergencyListRequest, opts ...grpc.CallOption) (*EmergencyListResponse, error)
+	Request(ctx context.Context, in *EmergencyContactRequest, opts ...grpc.CallOption) (*EmergencyAccess, error)
+	Approve(ctx context.Context, in *EmergencyContactRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Reject(ctx context.Context, in *EmergencyContactRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type emergencyClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEmergencyClient(cc grpc.ClientConnInterface) EmergencyClient {
+	return &emergencyClient{cc}
+}
+
+func (c *emergencyClient) Grant(ctx context.Context, in *EmergencyGrantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Emergency_Grant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emergencyClient) Revoke(ctx context.Context, in *EmergencyContactRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Emergency_Revoke_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emergencyClient) List(ctx context.Context, in *EmergencyListRequest, opts ...grpc.CallOption) (*EmergencyListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmergencyListResponse)
+	err := c.cc.Invoke(ctx, Emergency_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emergencyClient) Request(ctx context.Context, in *EmergencyContactRequest, opts ...grpc.CallOption) (*EmergencyAccess, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmergencyAccess)
+	err := c.cc.Invoke(ctx, Emergency_Request_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emergencyClient) Approve(ctx context.Context, in *EmergencyContactRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Emergency_Approve_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emergencyClient) Reject(ctx context.Context, in *EmergencyContactRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Emergency_Reject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EmergencyServer is the server API for Emergency service.
+// All implementations must embed UnimplementedEmergencyServer
+// for forward compatibility.
+type EmergencyServer interface {
+	Grant(context.Context, *EmergencyGrantRequest) (*emptypb.Empty, error)
+	Revoke(context.Context, *EmergencyContactRequest) (*emptypb.Empty, error)
+	List(context.Context, *EmergencyListRequest) (*EmergencyListResponse, error)
+	Request(context.Context, *EmergencyContactRequest) (*EmergencyAccess, error)
+	Approve(context.Context, *EmergencyContactRequest) (*emptypb.Empty, error)
+	Reject(context.Context, *EmergencyContactRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedEmergencyServer()
+}
+
+// UnimplementedEmergencyServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedEmergencyServer struct{}
+
+func (UnimplementedEmergencyServer) Grant(context.Context, *EmergencyGrantRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Grant not implemented")
+}
+func (UnimplementedEmergencyServer) Revoke(context.Context, *EmergencyContactRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Revoke not implemented")
+}
+func (UnimplementedEmergencyServer) List(context.Context, *EmergencyListRequest) (*EmergencyListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedEmergencyServer) Request(context.Context, *EmergencyContactRequest) (*EmergencyAccess, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Request not implemented")
+}
+func (UnimplementedEmergencyServer) Approve(context.Context, *EmergencyContactRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Approve not implemented")
+}
+func (UnimplementedEmergencyServer) Reject(context.Context, *EmergencyContactRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reject not implemented")
+}
+func (UnimplementedEmergencyServer) mustEmbedUnimplementedEmergencyServer() {}
+func (UnimplementedEmergencyServer) testEmbeddedByValue()                   {}
+
+// UnsafeEmergencyServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EmergencyServer will
+// result in compilation errors.
+type UnsafeEmergencyServer interface {
+	mustEmbedUnimplementedEmergencyServer()
+}
+
+func RegisterEmergencyServer(s grpc.ServiceRegistrar, srv EmergencyServer) {
+	// If the following call pancis, it indicates UnimplementedEmergencyServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Emergency_ServiceDesc, srv)
+}
+
+func _Emergency_Grant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmergencyGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmergencyServer).Grant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Emergency_Grant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmergencyServer).Grant(ctx, req.(*EmergencyGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Emergency_Revoke_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmergencyContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmergencyServer).Revoke(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Emergency_Revoke_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmergencyServer).Revoke(ctx, req.(*EmergencyContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Emergency_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmergencyListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmergencyServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Emergency_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmergencyServer).List(ctx, req.(*EmergencyListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Emergency_Request_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmergencyContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmergencyServer).Request(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Emergency_Request_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmergencyServer).Request(ctx, req.(*EmergencyContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Emergency_Approve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmergencyContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmergencyServer).Approve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Emergency_Approve_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmergencyServer).Approve(ctx, req.(*EmergencyContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Emergency_Reject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmergencyContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmergencyServer).Reject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Emergency_Reject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmergencyServer).Reject(ctx, req.(*EmergencyContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Emergency_ServiceDesc is the grpc.ServiceDesc for Emergency service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Emergency_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "emergency.Emergency",
+	HandlerType: (*EmergencyServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Grant",
+			Handler:    _Emergency_Grant_Handler,
+		},
+		{
+			MethodName: "Revoke",
+			Handler:    _Emergency_Revoke_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _Emergency_List_Handler,
+		},
+		{
+			MethodName: "Request",
+			Handler:    _Emergency_Request_Handler,
+		},
+		{
+			MethodName: "Approve",
+			Handler:    _Emergency_Approve_Handler,
+		},
+		{
+			MethodName: "Reject",
+			Handler:    _Emergency_Reject_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/emergency.proto",
+}
@@ -0,0 +1,426 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: api/orgs.proto
+
+package grpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Orgs_Create_FullMethodName           = "/orgs.Orgs/Create"
+	Orgs_List_FullMethodName             = "/orgs.Orgs/List"
+	Orgs_ListMembers_FullMethodName      = "/orgs.Orgs/ListMembers"
+	Orgs_SetMember_FullMethodName        = "/orgs.Orgs/SetMember"
+	Orgs_RemoveMember_FullMethodName     = "/orgs.Orgs/RemoveMember"
+	Orgs_CreateCollection_FullMethodName = "/orgs.Orgs/CreateCollection"
+	Orgs_ListCollections_FullMethodName  = "/orgs.Orgs/ListCollections"
+	Orgs_GrantCollection_FullMethodName  = "/orgs.Orgs/GrantCollection"
+	Orgs_RevokeCollection_FullMethodName = "/orgs.Orgs/RevokeCollection"
+)
+
+// OrgsClient is the client API for Orgs service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OrgsClient interface {
+	Create(ctx context.Context, in *OrgCreateRequest, opts ...grpc.CallOption) (*Organization, error)
+	List(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*OrgListResponse, error)
+	ListMembers(ctx context.Context, in *OrgRequest, opts ...grpc.CallOption) (*OrgMemberListResponse, error)
+	SetMember(ctx context.Context, in *OrgMemberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RemoveMember(ctx context.Context, in *OrgMemberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreateCollection(ctx context.Context, in *CollectionCreateRequest, opts ...grpc.CallOption) (*Collection, error)
+	ListCollections(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CollectionListResponse, error)
+	GrantCollection(ctx context.Context, in *CollectionGrantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RevokeCollection(ctx context.Context, in *CollectionGrantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type orgsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOrgsClient(cc grpc.ClientConnInterface) OrgsClient {
+	return &orgsClient{cc}
+}
+
+func (c *orgsClient) Create(ctx context.Context, in *OrgCreateRequest, opts ...grpc.CallOption) (*Organization, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Organization)
+	err := c.cc.Invoke(ctx, Orgs_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orgsClient) List(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*OrgListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrgListResponse)
+	err := c.cc.Invoke(ctx, Orgs_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orgsClient) ListMembers(ctx context.Context, in *OrgRequest, opts ...grpc.CallOption) (*OrgMemberListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrgMemberListResponse)
+	err := c.cc.Invoke(ctx, Orgs_ListMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orgsClient) SetMember(ctx context.Context, in *OrgMemberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Orgs_SetMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orgsClient) RemoveMember(ctx context.Context, in *OrgMemberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Orgs_RemoveMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orgsClient) CreateCollection(ctx context.Context, in *CollectionCreateRequest, opts ...grpc.CallOption) (*Collection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Collection)
+	err := c.cc.Invoke(ctx, Orgs_CreateCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orgsClient) ListCollections(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CollectionListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollectionListResponse)
+	err := c.cc.Invoke(ctx, Orgs_ListCollections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orgsClient) GrantCollection(ctx context.Context, in *CollectionGrantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Orgs_GrantCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orgsClient) RevokeCollection(ctx context.Context, in *CollectionGrantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Orgs_RevokeCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OrgsServer is the server API for Orgs service.
+// All implementations must embed UnimplementedOrgsServer
+// for forward compatibility.
+type OrgsServer interface {
+	Create(context.Context, *OrgCreateRequest) (*Organization, error)
+	List(context.Context, *emptypb.Empty) (*OrgListResponse, error)
+	ListMembers(context.Context, *OrgRequest) (*OrgMemberListResponse, error)
+	SetMember(context.Context, *OrgMemberRequest) (*emptypb.Empty, error)
+	RemoveMember(context.Context, *OrgMemberRequest) (*emptypb.Empty, error)
+	CreateCollection(context.Context, *CollectionCreateRequest) (*Collection, error)
+	ListCollections(context.Context, *emptypb.Empty) (*CollectionListResponse, error)
+	GrantCollection(context.Context, *CollectionGrantRequest) (*emptypb.Empty, error)
+	RevokeCollection(context.Context, *CollectionGrantRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedOrgsServer()
+}
+
+// UnimplementedOrgsServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedOrgsServer struct{}
+
+func (UnimplementedOrgsServer) Create(context.Context, *OrgCreateRequest) (*Organization, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedOrgsServer) List(context.Context, *emptypb.Empty) (*OrgListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedOrgsServer) ListMembers(context.Context, *OrgRequest) (*OrgMemberListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMembers not implemented")
+}
+func (UnimplementedOrgsServer) SetMember(context.Context, *OrgMemberRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMember not implemented")
+}
+func (UnimplementedOrgsServer) RemoveMember(context.Context, *OrgMemberRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMember not implemented")
+}
+func (UnimplementedOrgsServer) CreateCollection(context.Context, *CollectionCreateRequest) (*Collection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCollection not implemented")
+}
+func (UnimplementedOrgsServer) ListCollections(context.Context, *emptypb.Empty) (*CollectionListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollections not implemented")
+}
+func (UnimplementedOrgsServer) GrantCollection(context.Context, *CollectionGrantRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantCollection not implemented")
+}
+func (UnimplementedOrgsServer) RevokeCollection(context.Context, *CollectionGrantRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeCollection not implemented")
+}
+func (UnimplementedOrgsServer) mustEmbedUnimplementedOrgsServer() {}
+func (UnimplementedOrgsServer) testEmbeddedByValue()              {}
+
+// UnsafeOrgsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OrgsServer will
+// result in compilation errors.
+type UnsafeOrgsServer interface {
+	mustEmbedUnimplementedOrgsServer()
+}
+
+func RegisterOrgsServer(s grpc.ServiceRegistrar, srv OrgsServer) {
+	// If the following call pancis, it indicates UnimplementedOrgsServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Orgs_ServiceDesc, srv)
+}
+
+func _Orgs_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrgCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrgsServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orgs_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrgsServer).Create(ctx, req.(*OrgCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orgs_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrgsServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orgs_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrgsServer).List(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orgs_ListMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrgRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrgsServer).ListMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orgs_ListMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrgsServer).ListMembers(ctx, req.(*OrgRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orgs_SetMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrgMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrgsServer).SetMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orgs_SetMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrgsServer).SetMember(ctx, req.(*OrgMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orgs_RemoveMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrgMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrgsServer).RemoveMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orgs_RemoveMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrgsServer).RemoveMember(ctx, req.(*OrgMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orgs_CreateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectionCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrgsServer).CreateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orgs_CreateCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrgsServer).CreateCollection(ctx, req.(*CollectionCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orgs_ListCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrgsServer).ListCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orgs_ListCollections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrgsServer).ListCollections(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orgs_GrantCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectionGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrgsServer).GrantCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orgs_GrantCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrgsServer).GrantCollection(ctx, req.(*CollectionGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Orgs_RevokeCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectionGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrgsServer).RevokeCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Orgs_RevokeCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrgsServer).RevokeCollection(ctx, req.(*CollectionGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Orgs_ServiceDesc is the grpc.ServiceDesc for Orgs service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Orgs_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "orgs.Orgs",
+	HandlerType: (*OrgsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _Orgs_Create_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _Orgs_List_Handler,
+		},
+		{
+			MethodName: "ListMembers",
+			Handler:    _Orgs_ListMembers_Handler,
+		},
+		{
+			MethodName: "SetMember",
+			Handler:    _Orgs_SetMember_Handler,
+		},
+		{
+			MethodName: "RemoveMember",
+			Handler:    _Orgs_RemoveMember_Handler,
+		},
+		{
+			MethodName: "CreateCollection",
+			Handler:    _Orgs_CreateCollection_Handler,
+		},
+		{
+			MethodName: "ListCollections",
+			Handler:    _Orgs_ListCollections_Handler,
+		},
+		{
+			MethodName: "GrantCollection",
+			Handler:    _Orgs_GrantCollection_Handler,
+		},
+		{
+			MethodName: "RevokeCollection",
+			Handler:    _Orgs_RevokeCollection_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/orgs.proto",
+}
@@ -20,9 +20,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Passwords_Sync_FullMethodName    = "/passwords.Passwords/Sync"
-	Passwords_Delete_FullMethodName  = "/passwords.Passwords/Delete"
-	Passwords_GetList_FullMethodName = "/passwords.Passwords/GetList"
+	Passwords_Sync_FullMethodName              = "/passwords.Passwords/Sync"
+	Passwords_Delete_FullMethodName            = "/passwords.Passwords/Delete"
+	Passwords_GetList_FullMethodName           = "/passwords.Passwords/GetList"
+	Passwords_GetCollectionList_FullMethodName = "/passwords.Passwords/GetCollectionList"
+	Passwords_GetEmergencyList_FullMethodName  = "/passwords.Passwords/GetEmergencyList"
 )
 
 // PasswordsClient is the client API for Passwords service.
@@ -32,6 +34,8 @@ type PasswordsClient interface {
 	Sync(ctx context.Context, in *Password, opts ...grpc.CallOption) (*PasswordSyncResponse, error)
 	Delete(ctx context.Context, in *PasswordDeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PasswordGetListResponse, error)
+	GetCollectionList(ctx context.Context, in *PasswordCollectionRequest, opts ...grpc.CallOption) (*PasswordGetListResponse, error)
+	GetEmergencyList(ctx context.Context, in *PasswordEmergencyRequest, opts ...grpc.CallOption) (*PasswordGetListResponse, error)
 }
 
 type passwordsClient struct {
@@ -72,6 +76,26 @@ func (c *passwordsClient) GetList(ctx context.Context, in *emptypb.Empty, opts .
 	return out, nil
 }
 
+func (c *passwordsClient) GetCollectionList(ctx context.Context, in *PasswordCollectionRequest, opts ...grpc.CallOption) (*PasswordGetListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PasswordGetListResponse)
+	err := c.cc.Invoke(ctx, Passwords_GetCollectionList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *passwordsClient) GetEmergencyList(ctx context.Context, in *PasswordEmergencyRequest, opts ...grpc.CallOption) (*PasswordGetListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PasswordGetListResponse)
+	err := c.cc.Invoke(ctx, Passwords_GetEmergencyList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PasswordsServer is the server API for Passwords service.
 // All implementations must embed UnimplementedPasswordsServer
 // for forward compatibility.
@@ -79,6 +103,8 @@ type PasswordsServer interface {
 	Sync(context.Context, *Password) (*PasswordSyncResponse, error)
 	Delete(context.Context, *PasswordDeleteRequest) (*emptypb.Empty, error)
 	GetList(context.Context, *emptypb.Empty) (*PasswordGetListResponse, error)
+	GetCollectionList(context.Context, *PasswordCollectionRequest) (*PasswordGetListResponse, error)
+	GetEmergencyList(context.Context, *PasswordEmergencyRequest) (*PasswordGetListResponse, error)
 	mustEmbedUnimplementedPasswordsServer()
 }
 
@@ -98,6 +124,12 @@ func (UnimplementedPasswordsServer) Delete(context.Context, *PasswordDeleteReque
 func (UnimplementedPasswordsServer) GetList(context.Context, *emptypb.Empty) (*PasswordGetListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetList not implemented")
 }
+func (UnimplementedPasswordsServer) GetCollectionList(context.Context, *PasswordCollectionRequest) (*PasswordGetListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionList not implemented")
+}
+func (UnimplementedPasswordsServer) GetEmergencyList(context.Context, *PasswordEmergencyRequest) (*PasswordGetListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEmergencyList not implemented")
+}
 func (UnimplementedPasswordsServer) mustEmbedUnimplementedPasswordsServer() {}
 func (UnimplementedPasswordsServer) testEmbeddedByValue()                   {}
 
@@ -173,6 +205,42 @@ func _Passwords_GetList_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Passwords_GetCollectionList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PasswordCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PasswordsServer).GetCollectionList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Passwords_GetCollectionList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PasswordsServer).GetCollectionList(ctx, req.(*PasswordCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Passwords_GetEmergencyList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PasswordEmergencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PasswordsServer).GetEmergencyList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Passwords_GetEmergencyList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PasswordsServer).GetEmergencyList(ctx, req.(*PasswordEmergencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Passwords_ServiceDesc is the grpc.ServiceDesc for Passwords service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -192,6 +260,14 @@ var Passwords_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetList",
 			Handler:    _Passwords_GetList_Handler,
 		},
+		{
+			MethodName: "GetCollectionList",
+			Handler:    _Passwords_GetCollectionList_Handler,
+		},
+		{
+			MethodName: "GetEmergencyList",
+			Handler:    _Passwords_GetEmergencyList_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/passwords.proto",
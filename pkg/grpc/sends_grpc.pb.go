@@ -0,0 +1,300 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: api/sends.proto
+
+package grpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Sends_Create_FullMethodName = "/sends.Sends/Create"
+	Sends_List_FullMethodName   = "/sends.Sends/List"
+	Sends_Delete_FullMethodName = "/sends.Sends/Delete"
+)
+
+// SendsClient is the client API for Sends service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SendsClient interface {
+	Create(ctx context.Context, in *SendCreateRequest, opts ...grpc.CallOption) (*SendCreateResponse, error)
+	List(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SendListResponse, error)
+	Delete(ctx context.Context, in *SendRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type sendsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSendsClient(cc grpc.ClientConnInterface) SendsClient {
+	return &sendsClient{cc}
+}
+
+func (c *sendsClient) Create(ctx context.Context, in *SendCreateRequest, opts ...grpc.CallOption) (*SendCreateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendCreateResponse)
+	err := c.cc.Invoke(ctx, Sends_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sendsClient) List(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SendListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendListResponse)
+	err := c.cc.Invoke(ctx, Sends_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sendsClient) Delete(ctx context.Context, in *SendRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Sends_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SendsServer is the server API for Sends service.
+// All implementations must embed UnimplementedSendsServer
+// for forward compatibility.
+type SendsServer interface {
+	Create(context.Context, *SendCreateRequest) (*SendCreateResponse, error)
+	List(context.Context, *emptypb.Empty) (*SendListResponse, error)
+	Delete(context.Context, *SendRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedSendsServer()
+}
+
+// UnimplementedSendsServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSendsServer struct{}
+
+func (UnimplementedSendsServer) Create(context.Context, *SendCreateRequest) (*SendCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedSendsServer) List(context.Context, *emptypb.Empty) (*SendListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedSendsServer) Delete(context.Context, *SendRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedSendsServer) mustEmbedUnimplementedSendsServer() {}
+func (UnimplementedSendsServer) testEmbeddedByValue()               {}
+
+// UnsafeSendsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SendsServer will
+// result in compilation errors.
+type UnsafeSendsServer interface {
+	mustEmbedUnimplementedSendsServer()
+}
+
+func RegisterSendsServer(s grpc.ServiceRegistrar, srv SendsServer) {
+	// If the following call pancis, it indicates UnimplementedSendsServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Sends_ServiceDesc, srv)
+}
+
+func _Sends_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SendsServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sends_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SendsServer).Create(ctx, req.(*SendCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sends_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SendsServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sends_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SendsServer).List(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sends_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SendsServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sends_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SendsServer).Delete(ctx, req.(*SendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Sends_ServiceDesc is the grpc.ServiceDesc for Sends service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Sends_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "sends.Sends",
+	HandlerType: (*SendsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _Sends_Create_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _Sends_List_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _Sends_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/sends.proto",
+}
+
+const (
+	SendsAccess_Receive_FullMethodName = "/sends.SendsAccess/Receive"
+)
+
+// SendsAccessClient is the client API for SendsAccess service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SendsAccessClient interface {
+	Receive(ctx context.Context, in *SendRequest, opts ...grpc.CallOption) (*SendPayload, error)
+}
+
+type sendsAccessClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSendsAccessClient(cc grpc.ClientConnInterface) SendsAccessClient {
+	return &sendsAccessClient{cc}
+}
+
+func (c *sendsAccessClient) Receive(ctx context.Context, in *SendRequest, opts ...grpc.CallOption) (*SendPayload, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendPayload)
+	err := c.cc.Invoke(ctx, SendsAccess_Receive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SendsAccessServer is the server API for SendsAccess service.
+// All implementations must embed UnimplementedSendsAccessServer
+// for forward compatibility.
+type SendsAccessServer interface {
+	Receive(context.Context, *SendRequest) (*SendPayload, error)
+	mustEmbedUnimplementedSendsAccessServer()
+}
+
+// UnimplementedSendsAccessServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSendsAccessServer struct{}
+
+func (UnimplementedSendsAccessServer) Receive(context.Context, *SendRequest) (*SendPayload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Receive not implemented")
+}
+func (UnimplementedSendsAccessServer) mustEmbedUnimplementedSendsAccessServer() {}
+func (UnimplementedSendsAccessServer) testEmbeddedByValue()                     {}
+
+// UnsafeSendsAccessServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SendsAccessServer will
+// result in compilation errors.
+type UnsafeSendsAccessServer interface {
+	mustEmbedUnimplementedSendsAccessServer()
+}
+
+func RegisterSendsAccessServer(s grpc.ServiceRegistrar, srv SendsAccessServer) {
+	// If the following call pancis, it indicates UnimplementedSendsAccessServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SendsAccess_ServiceDesc, srv)
+}
+
+func _SendsAccess_Receive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SendsAccessServer).Receive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SendsAccess_Receive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SendsAccessServer).Receive(ctx, req.(*SendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SendsAccess_ServiceDesc is the grpc.ServiceDesc for SendsAccess service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SendsAccess_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "sends.SendsAccess",
+	HandlerType: (*SendsAccessServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Receive",
+			Handler:    _SendsAccess_Receive_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/sends.proto",
+}
@@ -0,0 +1,350 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: api/shares.proto
+
+package grpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Shares_SetKeyPair_FullMethodName   = "/shares.Shares/SetKeyPair"
+	Shares_GetKeyPair_FullMethodName   = "/shares.Shares/GetKeyPair"
+	Shares_GetPublicKey_FullMethodName = "/shares.Shares/GetPublicKey"
+	Shares_Create_FullMethodName       = "/shares.Shares/Create"
+	Shares_List_FullMethodName         = "/shares.Shares/List"
+	Shares_Update_FullMethodName       = "/shares.Shares/Update"
+	Shares_Revoke_FullMethodName       = "/shares.Shares/Revoke"
+)
+
+// SharesClient is the client API for Shares service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SharesClient interface {
+	SetKeyPair(ctx context.Context, in *UserKeyPair, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetKeyPair(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*UserKeyPair, error)
+	GetPublicKey(ctx context.Context, in *PublicKeyRequest, opts ...grpc.CallOption) (*UserPublicKey, error)
+	Create(ctx context.Context, in *ShareCreateRequest, opts ...grpc.CallOption) (*Share, error)
+	List(ctx context.Context, in *ShareListRequest, opts ...grpc.CallOption) (*ShareListResponse, error)
+	Update(ctx context.Context, in *ShareUpdateRequest, opts ...grpc.CallOption) (*Share, error)
+	Revoke(ctx context.Context, in *ShareRevokeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type sharesClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSharesClient(cc grpc.ClientConnInterface) SharesClient {
+	return &sharesClient{cc}
+}
+
+func (c *sharesClient) SetKeyPair(ctx context.Context, in *UserKeyPair, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Shares_SetKeyPair_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sharesClient) GetKeyPair(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*UserKeyPair, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserKeyPair)
+	err := c.cc.Invoke(ctx, Shares_GetKeyPair_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sharesClient) GetPublicKey(ctx context.Context, in *PublicKeyRequest, opts ...grpc.CallOption) (*UserPublicKey, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserPublicKey)
+	err := c.cc.Invoke(ctx, Shares_GetPublicKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sharesClient) Create(ctx context.Context, in *ShareCreateRequest, opts ...grpc.CallOption) (*Share, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Share)
+	err := c.cc.Invoke(ctx, Shares_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sharesClient) List(ctx context.Context, in *ShareListRequest, opts ...grpc.CallOption) (*ShareListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShareListResponse)
+	err := c.cc.Invoke(ctx, Shares_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sharesClient) Update(ctx context.Context, in *ShareUpdateRequest, opts ...grpc.CallOption) (*Share, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Share)
+	err := c.cc.Invoke(ctx, Shares_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sharesClient) Revoke(ctx context.Context, in *ShareRevokeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Shares_Revoke_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SharesServer is the server API for Shares service.
+// All implementations must embed UnimplementedSharesServer
+// for forward compatibility.
+type SharesServer interface {
+	SetKeyPair(context.Context, *UserKeyPair) (*emptypb.Empty, error)
+	GetKeyPair(context.Context, *emptypb.Empty) (*UserKeyPair, error)
+	GetPublicKey(context.Context, *PublicKeyRequest) (*UserPublicKey, error)
+	Create(context.Context, *ShareCreateRequest) (*Share, error)
+	List(context.Context, *ShareListRequest) (*ShareListResponse, error)
+	Update(context.Context, *ShareUpdateRequest) (*Share, error)
+	Revoke(context.Context, *ShareRevokeRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedSharesServer()
+}
+
+// UnimplementedSharesServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSharesServer struct{}
+
+func (UnimplementedSharesServer) SetKeyPair(context.Context, *UserKeyPair) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetKeyPair not implemented")
+}
+func (UnimplementedSharesServer) GetKeyPair(context.Context, *emptypb.Empty) (*UserKeyPair, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKeyPair not implemented")
+}
+func (UnimplementedSharesServer) GetPublicKey(context.Context, *PublicKeyRequest) (*UserPublicKey, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublicKey not implemented")
+}
+func (UnimplementedSharesServer) Create(context.Context, *ShareCreateRequest) (*Share, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedSharesServer) List(context.Context, *ShareListRequest) (*ShareListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedSharesServer) Update(context.Context, *ShareUpdateRequest) (*Share, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedSharesServer) Revoke(context.Context, *ShareRevokeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Revoke not implemented")
+}
+func (UnimplementedSharesServer) mustEmbedUnimplementedSharesServer() {}
+func (UnimplementedSharesServer) testEmbeddedByValue()                {}
+
+// UnsafeSharesServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SharesServer will
+// result in compilation errors.
+type UnsafeSharesServer interface {
+	mustEmbedUnimplementedSharesServer()
+}
+
+func RegisterSharesServer(s grpc.ServiceRegistrar, srv SharesServer) {
+	// If the following call pancis, it indicates UnimplementedSharesServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Shares_ServiceDesc, srv)
+}
+
+func _Shares_SetKeyPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserKeyPair)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SharesServer).SetKeyPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Shares_SetKeyPair_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SharesServer).SetKeyPair(ctx, req.(*UserKeyPair))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Shares_GetKeyPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SharesServer).GetKeyPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Shares_GetKeyPair_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SharesServer).GetKeyPair(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Shares_GetPublicKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublicKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SharesServer).GetPublicKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Shares_GetPublicKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SharesServer).GetPublicKey(ctx, req.(*PublicKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Shares_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SharesServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Shares_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SharesServer).Create(ctx, req.(*ShareCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Shares_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SharesServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Shares_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SharesServer).List(ctx, req.(*ShareListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Shares_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SharesServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Shares_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SharesServer).Update(ctx, req.(*ShareUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Shares_Revoke_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareRevokeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SharesServer).Revoke(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Shares_Revoke_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SharesServer).Revoke(ctx, req.(*ShareRevokeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Shares_ServiceDesc is the grpc.ServiceDesc for Shares service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Shares_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "shares.Shares",
+	HandlerType: (*SharesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetKeyPair",
+			Handler:    _Shares_SetKeyPair_Handler,
+		},
+		{
+			MethodName: "GetKeyPair",
+			Handler:    _Shares_GetKeyPair_Handler,
+		},
+		{
+			MethodName: "GetPublicKey",
+			Handler:    _Shares_GetPublicKey_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _Shares_Create_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _Shares_List_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _Shares_Update_Handler,
+		},
+		{
+			MethodName: "Revoke",
+			Handler:    _Shares_Revoke_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/shares.proto",
+}
@@ -0,0 +1,138 @@
+package inject
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	FieldPassword = "password"
+	FieldMeta     = "meta"
+
+	uriScheme = "gopass://"
+	funcName  = "gopass"
+)
+
+var ErrUnknownField = errors.New("unknown reference field")
+
+// referenceRegexp находит ссылки двух видов: {{ gopass "name" "field" }}
+// и gopass://name/field. Остальной текст, в том числе чужие {{ ... }}
+// из шаблонов Helm или Jinja, не разбирается и остается как есть.
+var referenceRegexp = regexp.MustCompile(
+	`\{\{\s*` + funcName + `\s+("(?:[^"\\]|\\.)*")(?:\s+("(?:[^"\\]|\\.)*"))?\s*\}\}` +
+		`|gopass://[^\s"'<>{}\x60]+`,
+)
+
+type Reference struct {
+	Name  string
+	Field string
+}
+
+func (r Reference) String() string {
+	return uriScheme + r.Name + "/" + r.Field
+}
+
+type Resolver interface {
+	Resolve(ctx context.Context, ref Reference) (string, error)
+}
+
+type ResolverFunc func(ctx context.Context, ref Reference) (string, error)
+
+func (fn ResolverFunc) Resolve(ctx context.Context, ref Reference) (string, error) {
+	return fn(ctx, ref)
+}
+
+func isKnownField(field string) bool {
+	return field == FieldPassword || field == FieldMeta
+}
+
+func parseURI(uri string) Reference {
+	path := strings.TrimSuffix(strings.TrimPrefix(uri, uriScheme), "/")
+
+	idx := strings.LastIndex(path, "/")
+	if idx > 0 && isKnownField(path[idx+1:]) {
+		return Reference{Name: path[:idx], Field: path[idx+1:]}
+	}
+
+	return Reference{Name: path, Field: FieldPassword}
+}
+
+// parseMatch разбирает найденную ссылку, loc — индексы из FindAllStringSubmatchIndex.
+func parseMatch(text string, loc []int) (Reference, error) {
+	if loc[2] < 0 {
+		return parseURI(text[loc[0]:loc[1]]), nil
+	}
+
+	name, err := strconv.Unquote(text[loc[2]:loc[3]])
+	if err != nil {
+		return Reference{}, fmt.Errorf("%s: %w", text[loc[0]:loc[1]], err)
+	}
+
+	ref := Reference{Name: name, Field: FieldPassword}
+
+	if loc[4] >= 0 {
+		ref.Field, err = strconv.Unquote(text[loc[4]:loc[5]])
+		if err != nil {
+			return Reference{}, fmt.Errorf("%s: %w", text[loc[0]:loc[1]], err)
+		}
+	}
+
+	if !isKnownField(ref.Field) {
+		return Reference{}, fmt.Errorf("%w: %s", ErrUnknownField, ref.Field)
+	}
+
+	return ref, nil
+}
+
+// Render заменяет ссылки значениями из resolver, весь остальной текст
+// переносится без изменений.
+func Render(ctx context.Context, text string, resolver Resolver) (string, error) {
+	var out strings.Builder
+
+	last := 0
+
+	for _, loc := range referenceRegexp.FindAllStringSubmatchIndex(text, -1) {
+		ref, err := parseMatch(text, loc)
+		if err != nil {
+			return "", err
+		}
+
+		value, err := resolver.Resolve(ctx, ref)
+		if err != nil {
+			return "", err
+		}
+
+		out.WriteString(text[last:loc[0]])
+		out.WriteString(value)
+
+		last = loc[1]
+	}
+
+	out.WriteString(text[last:])
+
+	return out.String(), nil
+}
+
+// References возвращает все ссылки из текста без повторов, не обращаясь к хранилищу.
+func References(text string) ([]Reference, error) {
+	refs := make([]Reference, 0)
+	seen := make(map[Reference]struct{})
+
+	for _, loc := range referenceRegexp.FindAllStringSubmatchIndex(text, -1) {
+		ref, err := parseMatch(text, loc)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := seen[ref]; !ok {
+			seen[ref] = struct{}{}
+			refs = append(refs, ref)
+		}
+	}
+
+	return refs, nil
+}
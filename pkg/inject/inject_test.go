@@ -0,0 +1,98 @@
+package inject_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/llravell/go-pass/pkg/inject"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errNotFound = errors.New("not found")
+
+var secrets = map[inject.Reference]string{
+	{Name: "work/db", Field: inject.FieldPassword}: "qwerty",
+	{Name: "work/db", Field: inject.FieldMeta}:     "postgres",
+}
+
+func resolver() inject.Resolver {
+	return inject.ResolverFunc(func(_ context.Context, ref inject.Reference) (string, error) {
+		value, ok := secrets[ref]
+		if !ok {
+			return "", errNotFound
+		}
+
+		return value, nil
+	})
+}
+
+func TestRender(t *testing.T) {
+	t.Run("renders template function references", func(t *testing.T) {
+		out, err := inject.Render(t.Context(), `pass: {{ gopass "work/db" "password" }}`, resolver())
+		require.NoError(t, err)
+
+		assert.Equal(t, "pass: qwerty", out)
+	})
+
+	t.Run("renders uri references", func(t *testing.T) {
+		out, err := inject.Render(t.Context(), "user=gopass://work/db/meta\npass=gopass://work/db", resolver())
+		require.NoError(t, err)
+
+		assert.Equal(t, "user=postgres\npass=qwerty", out)
+	})
+
+	t.Run("fails on missing entry", func(t *testing.T) {
+		_, err := inject.Render(t.Context(), "pass=gopass://work/unknown", resolver())
+
+		assert.ErrorIs(t, err, errNotFound)
+	})
+
+	t.Run("fails on unknown field", func(t *testing.T) {
+		_, err := inject.Render(t.Context(), `{{ gopass "work/db" "login" }}`, resolver())
+
+		assert.ErrorIs(t, err, inject.ErrUnknownField)
+	})
+}
+
+func TestReferences(t *testing.T) {
+	t.Run("collects unique references", func(t *testing.T) {
+		refs, err := inject.References(`{{ gopass "a" }} gopass://b/meta gopass://a/password`)
+		require.NoError(t, err)
+
+		assert.Equal(t, []inject.Reference{
+			{Name: "a", Field: inject.FieldPassword},
+			{Name: "b", Field: inject.FieldMeta},
+		}, refs)
+	})
+
+	t.Run("collects references from every branch", func(t *testing.T) {
+		refs, err := inject.References(`{{ if .Prod }}{{ gopass "prod" }}{{ else }}gopass://dev{{ end }}`)
+		require.NoError(t, err)
+
+		assert.Equal(t, []inject.Reference{
+			{Name: "prod", Field: inject.FieldPassword},
+			{Name: "dev", Field: inject.FieldPassword},
+		}, refs)
+	})
+
+	t.Run("fails on unknown field", func(t *testing.T) {
+		_, err := inject.References(`{{ gopass "a" "login" }}`)
+
+		assert.ErrorIs(t, err, inject.ErrUnknownField)
+	})
+}
+
+func TestRenderForeignTemplates(t *testing.T) {
+	text := "image: {{ .Values.image }}\n" +
+		"{% if prod %}pass: {{ gopass \"work/db\" }}{% endif %}\n" +
+		"user: gopass://work/db/meta"
+
+	out, err := inject.Render(t.Context(), text, resolver())
+	require.NoError(t, err)
+
+	assert.Equal(t, "image: {{ .Values.image }}\n"+
+		"{% if prod %}pass: qwerty{% endif %}\n"+
+		"user: postgres", out)
+}
@@ -0,0 +1,91 @@
+package keyagent
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+
+	"github.com/llravell/go-pass/pkg/encryption"
+)
+
+type Client struct {
+	socketPath string
+}
+
+func NewClient(socketPath string) *Client {
+	return &Client{
+		socketPath: socketPath,
+	}
+}
+
+func (c *Client) Get(ctx context.Context) (*encryption.Key, error) {
+	resp, err := c.call(ctx, &request{Op: opGet})
+	if err != nil {
+		return nil, err
+	}
+
+	return encryption.ParseKey(resp.Key)
+}
+
+func (c *Client) Set(ctx context.Context, key *encryption.Key) error {
+	_, err := c.call(ctx, &request{Op: opSet, Key: key.String()})
+
+	return err
+}
+
+func (c *Client) Lock(ctx context.Context) error {
+	_, err := c.call(ctx, &request{Op: opLock})
+
+	return err
+}
+
+func (c *Client) Status(ctx context.Context) (*Status, error) {
+	resp, err := c.call(ctx, &request{Op: opStatus})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Status, nil
+}
+
+func (c *Client) call(ctx context.Context, req *request) (*response, error) {
+	var dialer net.Dialer
+
+	conn, err := dialer.DialContext(ctx, "unix", c.socketPath)
+	if err != nil {
+		return nil, err
+	}
+
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	if err = json.NewEncoder(conn).Encode(req); err != nil {
+		return nil, err
+	}
+
+	var resp response
+
+	if err = json.NewDecoder(conn).Decode(&resp); err != nil {
+		return nil, err
+	}
+
+	if len(resp.Error) > 0 {
+		return nil, errorFromResponse(resp.Error)
+	}
+
+	return &resp, nil
+}
+
+func errorFromResponse(msg string) error {
+	for _, known := range []error{ErrLocked, ErrPeerNotAllowed, ErrUnknownOperation} {
+		if msg == known.Error() {
+			return known
+		}
+	}
+
+	return errors.New(msg)
+}
@@ -0,0 +1,47 @@
+package keyagent
+
+import (
+	"errors"
+	"net"
+	"os"
+)
+
+const (
+	opGet    = "get"
+	opSet    = "set"
+	opLock   = "lock"
+	opStatus = "status"
+)
+
+var (
+	ErrLocked           = errors.New("agent is locked")
+	ErrUnknownOperation = errors.New("unknown agent operation")
+	ErrPeerNotAllowed   = errors.New("peer is not allowed to use agent")
+)
+
+// CheckPeer пропускает к сокету агента только процессы того же пользователя.
+func CheckPeer(conn net.Conn) error {
+	uid, err := peerUID(conn)
+	if err != nil || uid != os.Getuid() {
+		return ErrPeerNotAllowed
+	}
+
+	return nil
+}
+
+type request struct {
+	Op  string `json:"op"`
+	Key string `json:"key,omitempty"`
+}
+
+type response struct {
+	Key    string  `json:"key,omitempty"`
+	Status *Status `json:"status,omitempty"`
+	Error  string  `json:"error,omitempty"`
+}
+
+// Status состояние агента, возвращаемое командой status.
+type Status struct {
+	Locked    bool   `json:"locked"`
+	ExpiresIn string `json:"expires_in,omitempty"`
+}
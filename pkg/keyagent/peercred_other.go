@@ -0,0 +1,12 @@
+//go:build !linux && !darwin
+
+package keyagent
+
+import (
+	"net"
+)
+
+// peerUID не поддерживается на этой платформе, поэтому агент отклоняет все подключения.
+func peerUID(net.Conn) (int, error) {
+	return -1, ErrPeerNotAllowed
+}
@@ -0,0 +1,185 @@
+package keyagent
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/llravell/go-pass/pkg/encryption"
+)
+
+const connDeadline = 5 * time.Second
+
+// Server хранит ключ шифрования в памяти и отдает его процессам того же пользователя.
+// Ключ сбрасывается после idleTimeout без обращений и не позже absoluteTimeout после разблокировки.
+type Server struct {
+	idleTimeout     time.Duration
+	absoluteTimeout time.Duration
+
+	mu         sync.Mutex
+	key        *encryption.Key
+	unlockedAt time.Time
+	lastUsedAt time.Time
+}
+
+func NewServer(idleTimeout, absoluteTimeout time.Duration) *Server {
+	return &Server{
+		idleTimeout:     idleTimeout,
+		absoluteTimeout: absoluteTimeout,
+	}
+}
+
+func (s *Server) Serve(ctx context.Context, listener net.Listener) error {
+	go func() {
+		<-ctx.Done()
+		listener.Close()
+	}()
+
+	go s.expireLoop(ctx)
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				s.Lock()
+
+				return nil
+			}
+
+			return err
+		}
+
+		go s.handle(conn)
+	}
+}
+
+func (s *Server) Get() (*encryption.Key, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.expireLocked()
+
+	if s.key == nil {
+		return nil, ErrLocked
+	}
+
+	s.lastUsedAt = time.Now()
+
+	return s.key, nil
+}
+
+func (s *Server) Set(key *encryption.Key) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.key = key
+	s.unlockedAt = now
+	s.lastUsedAt = now
+}
+
+func (s *Server) Lock() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.key = nil
+}
+
+func (s *Server) Status() *Status {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.expireLocked()
+
+	if s.key == nil {
+		return &Status{Locked: true}
+	}
+
+	return &Status{ExpiresIn: s.expiresAtLocked().Sub(time.Now()).Round(time.Second).String()}
+}
+
+func (s *Server) expiresAtLocked() time.Time {
+	expiresAt := s.lastUsedAt.Add(s.idleTimeout)
+
+	if absolute := s.unlockedAt.Add(s.absoluteTimeout); absolute.Before(expiresAt) {
+		expiresAt = absolute
+	}
+
+	return expiresAt
+}
+
+func (s *Server) expireLocked() {
+	if s.key != nil && !time.Now().Before(s.expiresAtLocked()) {
+		s.key = nil
+	}
+}
+
+func (s *Server) expireLoop(ctx context.Context) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.mu.Lock()
+			s.expireLocked()
+			s.mu.Unlock()
+		}
+	}
+}
+
+func (s *Server) handle(conn net.Conn) {
+	defer conn.Close()
+
+	_ = conn.SetDeadline(time.Now().Add(connDeadline))
+
+	encoder := json.NewEncoder(conn)
+
+	if err := CheckPeer(conn); err != nil {
+		_ = encoder.Encode(&response{Error: err.Error()})
+
+		return
+	}
+
+	var req request
+
+	if err := json.NewDecoder(bufio.NewReader(conn)).Decode(&req); err != nil {
+		return
+	}
+
+	_ = encoder.Encode(s.process(&req))
+}
+
+func (s *Server) process(req *request) *response {
+	switch req.Op {
+	case opGet:
+		key, err := s.Get()
+		if err != nil {
+			return &response{Error: err.Error()}
+		}
+
+		return &response{Key: key.String()}
+	case opSet:
+		key, err := encryption.ParseKey(req.Key)
+		if err != nil {
+			return &response{Error: err.Error()}
+		}
+
+		s.Set(key)
+
+		return &response{}
+	case opLock:
+		s.Lock()
+
+		return &response{}
+	case opStatus:
+		return &response{Status: s.Status()}
+	default:
+		return &response{Error: ErrUnknownOperation.Error()}
+	}
+}
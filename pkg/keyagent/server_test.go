@@ -0,0 +1,90 @@
+package keyagent_test
+
+import (
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/llravell/go-pass/pkg/encryption"
+	"github.com/llravell/go-pass/pkg/keyagent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func startAgent(t *testing.T, idleTimeout, absoluteTimeout time.Duration) *keyagent.Client {
+	t.Helper()
+
+	socketPath := filepath.Join(t.TempDir(), "agent.sock")
+
+	listener, err := net.Listen("unix", socketPath)
+	require.NoError(t, err)
+
+	server := keyagent.NewServer(idleTimeout, absoluteTimeout)
+
+	go func() {
+		_ = server.Serve(t.Context(), listener)
+	}()
+
+	return keyagent.NewClient(socketPath)
+}
+
+func TestAgent(t *testing.T) {
+	key := encryption.GenerateKeyFromMasterPass("secret pass")
+
+	t.Run("agent is locked until key is set", func(t *testing.T) {
+		client := startAgent(t, time.Minute, time.Hour)
+
+		_, err := client.Get(t.Context())
+		require.ErrorIs(t, err, keyagent.ErrLocked)
+
+		require.NoError(t, client.Set(t.Context(), key))
+
+		cached, err := client.Get(t.Context())
+		require.NoError(t, err)
+		assert.Equal(t, key.String(), cached.String())
+
+		status, err := client.Status(t.Context())
+		require.NoError(t, err)
+		assert.False(t, status.Locked)
+	})
+
+	t.Run("lock drops key", func(t *testing.T) {
+		client := startAgent(t, time.Minute, time.Hour)
+
+		require.NoError(t, client.Set(t.Context(), key))
+		require.NoError(t, client.Lock(t.Context()))
+
+		_, err := client.Get(t.Context())
+		require.ErrorIs(t, err, keyagent.ErrLocked)
+	})
+
+	t.Run("key expires after idle timeout", func(t *testing.T) {
+		client := startAgent(t, 50*time.Millisecond, time.Hour)
+
+		require.NoError(t, client.Set(t.Context(), key))
+
+		time.Sleep(100 * time.Millisecond)
+
+		_, err := client.Get(t.Context())
+		require.ErrorIs(t, err, keyagent.ErrLocked)
+	})
+
+	t.Run("key expires after absolute timeout despite usage", func(t *testing.T) {
+		client := startAgent(t, time.Minute, 150*time.Millisecond)
+
+		require.NoError(t, client.Set(t.Context(), key))
+
+		for range 3 {
+			time.Sleep(40 * time.Millisecond)
+
+			_, err := client.Get(t.Context())
+			require.NoError(t, err)
+		}
+
+		time.Sleep(60 * time.Millisecond)
+
+		_, err := client.Get(t.Context())
+		require.ErrorIs(t, err, keyagent.ErrLocked)
+	})
+}
@@ -0,0 +1,184 @@
+package sshagent
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/llravell/go-pass/pkg/keyagent"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+)
+
+var (
+	ErrOperationRefused = errors.New("operation refused by go-pass agent")
+	ErrSignDenied       = errors.New("signature request denied")
+	ErrInvalidLifetime  = errors.New("key lifetime must be at least 1s")
+)
+
+// maxLifetime — наибольший срок, который помещается в поле протокола агента.
+const maxLifetime = time.Duration(math.MaxUint32) * time.Second
+
+// Confirmer спрашивает у пользователя разрешение на подпись ключом.
+type Confirmer func(key ssh.PublicKey, comment string) (bool, error)
+
+// Agent ssh-агент, который хранит ключи только в памяти процесса.
+// Ключи добавляются из хранилища go-pass, клиенты агента могут только
+// перечислять ключи и подписывать ими данные.
+type Agent struct {
+	keyring agent.ExtendedAgent
+	confirm Confirmer
+
+	confirmMu sync.Mutex
+}
+
+func New(confirm Confirmer) *Agent {
+	keyring, _ := agent.NewKeyring().(agent.ExtendedAgent)
+
+	return &Agent{
+		keyring: keyring,
+		confirm: confirm,
+	}
+}
+
+// AddKey добавляет ключ из хранилища. Нулевой lifetime оставляет ключ до остановки
+// агента, ненулевой округляется вверх до секунд: протокол считает 0 бессрочным.
+func (a *Agent) AddKey(privateKeyPEM []byte, comment string, lifetime time.Duration) (ssh.PublicKey, error) {
+	if lifetime != 0 && (lifetime < time.Second || lifetime > maxLifetime) {
+		return nil, ErrInvalidLifetime
+	}
+
+	privateKey, err := ssh.ParseRawPrivateKey(privateKeyPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := ssh.NewSignerFromKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.keyring.Add(agent.AddedKey{
+		PrivateKey:   privateKey,
+		Comment:      comment,
+		LifetimeSecs: uint32(math.Ceil(lifetime.Seconds())),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return signer.PublicKey(), nil
+}
+
+func (a *Agent) List() ([]*agent.Key, error) {
+	return a.keyring.List()
+}
+
+func (a *Agent) Sign(key ssh.PublicKey, data []byte) (*ssh.Signature, error) {
+	return a.SignWithFlags(key, data, 0)
+}
+
+func (a *Agent) SignWithFlags(key ssh.PublicKey, data []byte, flags agent.SignatureFlags) (*ssh.Signature, error) {
+	if err := a.confirmUsage(key); err != nil {
+		return nil, err
+	}
+
+	return a.keyring.SignWithFlags(key, data, flags)
+}
+
+// Add запрещен: агент обслуживает только ключи из хранилища.
+func (a *Agent) Add(agent.AddedKey) error {
+	return ErrOperationRefused
+}
+
+func (a *Agent) Remove(key ssh.PublicKey) error {
+	return a.keyring.Remove(key)
+}
+
+func (a *Agent) RemoveAll() error {
+	return a.keyring.RemoveAll()
+}
+
+func (a *Agent) Lock(passphrase []byte) error {
+	return a.keyring.Lock(passphrase)
+}
+
+func (a *Agent) Unlock(passphrase []byte) error {
+	return a.keyring.Unlock(passphrase)
+}
+
+// Signers запрещен, чтобы приватные ключи нельзя было получить из агента.
+func (a *Agent) Signers() ([]ssh.Signer, error) {
+	return nil, ErrOperationRefused
+}
+
+func (a *Agent) Extension(string, []byte) ([]byte, error) {
+	return nil, agent.ErrExtensionUnsupported
+}
+
+// Serve обслуживает подключения только от процессов того же пользователя.
+func (a *Agent) Serve(ctx context.Context, listener net.Listener) error {
+	go func() {
+		<-ctx.Done()
+		listener.Close()
+	}()
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return err
+		}
+
+		go func() {
+			defer conn.Close()
+
+			if keyagent.CheckPeer(conn) != nil {
+				return
+			}
+
+			_ = agent.ServeAgent(a, conn)
+		}()
+	}
+}
+
+func (a *Agent) confirmUsage(key ssh.PublicKey) error {
+	if a.confirm == nil {
+		return nil
+	}
+
+	keys, err := a.keyring.List()
+	if err != nil {
+		return err
+	}
+
+	comment := ""
+	wire := key.Marshal()
+
+	for _, k := range keys {
+		if string(k.Marshal()) == string(wire) {
+			comment = k.Comment
+		}
+	}
+
+	a.confirmMu.Lock()
+	defer a.confirmMu.Unlock()
+
+	allowed, err := a.confirm(key, comment)
+	if err != nil {
+		return err
+	}
+
+	if !allowed {
+		return ErrSignDenied
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package sshagent_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/llravell/go-pass/pkg/sshagent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+)
+
+func generateKeyPEM(t *testing.T) []byte {
+	t.Helper()
+
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	block, err := ssh.MarshalPrivateKey(privateKey, "")
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(block)
+}
+
+func connect(t *testing.T, sshAgent *sshagent.Agent) agent.ExtendedAgent {
+	t.Helper()
+
+	serverConn, clientConn := net.Pipe()
+
+	go func() {
+		_ = agent.ServeAgent(sshAgent, serverConn)
+	}()
+
+	t.Cleanup(func() {
+		clientConn.Close()
+		serverConn.Close()
+	})
+
+	return agent.NewClient(clientConn)
+}
+
+func TestAgent(t *testing.T) {
+	t.Run("lists and signs with vault keys", func(t *testing.T) {
+		sshAgent := sshagent.New(nil)
+
+		publicKey, err := sshAgent.AddKey(generateKeyPEM(t), "ssh/deploy", 0)
+		require.NoError(t, err)
+
+		client := connect(t, sshAgent)
+
+		keys, err := client.List()
+		require.NoError(t, err)
+		require.Len(t, keys, 1)
+		assert.Equal(t, "ssh/deploy", keys[0].Comment)
+
+		signature, err := client.Sign(publicKey, []byte("data"))
+		require.NoError(t, err)
+		require.NoError(t, publicKey.Verify([]byte("data"), signature))
+	})
+
+	t.Run("asks confirmation before signing", func(t *testing.T) {
+		var asked string
+
+		sshAgent := sshagent.New(func(_ ssh.PublicKey, comment string) (bool, error) {
+			asked = comment
+
+			return false, nil
+		})
+
+		publicKey, err := sshAgent.AddKey(generateKeyPEM(t), "ssh/deploy", 0)
+		require.NoError(t, err)
+
+		_, err = connect(t, sshAgent).Sign(publicKey, []byte("data"))
+		require.Error(t, err)
+		assert.Equal(t, "ssh/deploy", asked)
+	})
+
+	t.Run("drops keys after lifetime", func(t *testing.T) {
+		sshAgent := sshagent.New(nil)
+
+		_, err := sshAgent.AddKey(generateKeyPEM(t), "ssh/deploy", time.Second)
+		require.NoError(t, err)
+
+		keys, err := sshAgent.List()
+		require.NoError(t, err)
+		assert.Len(t, keys, 1)
+
+		time.Sleep(1100 * time.Millisecond)
+
+		keys, err = sshAgent.List()
+		require.NoError(t, err)
+		assert.Empty(t, keys)
+	})
+
+	t.Run("rejects lifetime below one second", func(t *testing.T) {
+		sshAgent := sshagent.New(nil)
+
+		_, err := sshAgent.AddKey(generateKeyPEM(t), "ssh/deploy", 500*time.Millisecond)
+		require.ErrorIs(t, err, sshagent.ErrInvalidLifetime)
+
+		_, err = sshAgent.AddKey(generateKeyPEM(t), "ssh/deploy", -time.Second)
+		require.ErrorIs(t, err, sshagent.ErrInvalidLifetime)
+	})
+
+	t.Run("refuses to add and export keys", func(t *testing.T) {
+		sshAgent := sshagent.New(nil)
+
+		_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+
+		err = connect(t, sshAgent).Add(agent.AddedKey{PrivateKey: privateKey})
+		require.Error(t, err)
+
+		_, err = sshAgent.Signers()
+		assert.ErrorIs(t, err, sshagent.ErrOperationRefused)
+	})
+}
+
+func TestAgentServe(t *testing.T) {
+	socketPath := filepath.Join(t.TempDir(), "ssh.sock")
+
+	listener, err := sshagent.Listen(socketPath)
+	require.NoError(t, err)
+
+	info, err := os.Stat(socketPath)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	sshAgent := sshagent.New(nil)
+
+	_, err = sshAgent.AddKey(generateKeyPEM(t), "ssh/deploy", 0)
+	require.NoError(t, err)
+
+	go func() {
+		_ = sshAgent.Serve(t.Context(), listener)
+	}()
+
+	conn, err := net.Dial("unix", socketPath)
+	require.NoError(t, err)
+
+	defer conn.Close()
+
+	keys, err := agent.NewClient(conn).List()
+	require.NoError(t, err)
+	assert.Len(t, keys, 1)
+}
@@ -0,0 +1,25 @@
+//go:build !unix
+
+package sshagent
+
+import (
+	"net"
+	"os"
+)
+
+// Listen создает сокет агента. Прав доступа к файлу здесь недостаточно,
+// подключения все равно проверяет Serve.
+func Listen(socketPath string) (net.Listener, error) {
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = os.Chmod(socketPath, 0o600); err != nil {
+		listener.Close()
+
+		return nil, err
+	}
+
+	return listener, nil
+}
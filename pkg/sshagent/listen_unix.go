@@ -0,0 +1,35 @@
+//go:build unix
+
+package sshagent
+
+import (
+	"net"
+	"os"
+	"sync"
+
+	"golang.org/x/sys/unix"
+)
+
+var umaskMu sync.Mutex
+
+// Listen создает сокет агента сразу с правами 0600: umask снимается только
+// на время bind, чтобы между созданием и chmod к сокету нельзя было подключиться.
+func Listen(socketPath string) (net.Listener, error) {
+	umaskMu.Lock()
+	oldMask := unix.Umask(0o077)
+	listener, err := net.Listen("unix", socketPath)
+	unix.Umask(oldMask)
+	umaskMu.Unlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = os.Chmod(socketPath, 0o600); err != nil {
+		listener.Close()
+
+		return nil, err
+	}
+
+	return listener, nil
+}
@@ -0,0 +1,120 @@
+// Package tracing настраивает OpenTelemetry для клиента и сервера.
+// Без экспортера остается провайдер по умолчанию, и спаны ничего не стоят.
+package tracing
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	ExporterNone   = ""
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+
+	tracerName = "github.com/llravell/go-pass"
+)
+
+var ErrUnknownExporter = errors.New("tracing exporter must be otlp or stdout")
+
+// ShutdownFunc досылает накопленные спаны и останавливает экспортер.
+type ShutdownFunc func(ctx context.Context) error
+
+// ValidateExporter проверяет название экспортера из конфигурации.
+func ValidateExporter(exporter string) error {
+	switch exporter {
+	case ExporterNone, ExporterOTLP, ExporterStdout:
+		return nil
+	}
+
+	return ErrUnknownExporter
+}
+
+// Setup устанавливает глобальный провайдер трассировки и распространение
+// контекста по W3C Trace Context. Адрес OTLP-коллектора и семплирование
+// берутся из стандартных переменных OTEL_EXPORTER_OTLP_* и OTEL_TRACES_SAMPLER.
+// Экспортер stdout пишет спаны в out, это удобно для локальной отладки.
+func Setup(
+	ctx context.Context,
+	serviceName string,
+	version string,
+	exporter string,
+	out io.Writer,
+) (ShutdownFunc, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	var (
+		spanExporter sdktrace.SpanExporter
+		err          error
+	)
+
+	switch exporter {
+	case ExporterNone:
+		return func(context.Context) error { return nil }, nil
+	case ExporterOTLP:
+		spanExporter, err = otlptracegrpc.New(ctx)
+	case ExporterStdout:
+		spanExporter, err = stdouttrace.New(stdouttrace.WithWriter(out), stdouttrace.WithPrettyPrint())
+	default:
+		return nil, ErrUnknownExporter
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(
+		attribute.String("service.name", serviceName),
+		attribute.String("service.version", version),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(spanExporter),
+		sdktrace.WithResource(res),
+	)
+
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+// Start открывает дочерний спан, имя принято давать вида Type.Method.
+func Start(ctx context.Context, name string) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name)
+}
+
+// TraceID возвращает идентификатор трассы из контекста или пустую строку.
+func TraceID(ctx context.Context) string {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.HasTraceID() {
+		return ""
+	}
+
+	return spanContext.TraceID().String()
+}
+
+// ZerologHook дописывает trace_id в события, созданные с контекстом через Ctx(ctx).
+type ZerologHook struct{}
+
+func (ZerologHook) Run(e *zerolog.Event, _ zerolog.Level, _ string) {
+	if traceID := TraceID(e.GetCtx()); traceID != "" {
+		e.Str("trace_id", traceID)
+	}
+}
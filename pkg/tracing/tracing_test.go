@@ -0,0 +1,61 @@
+package tracing_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/llravell/go-pass/pkg/tracing"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTracing(t *testing.T) {
+	t.Run("rejects unknown exporter", func(t *testing.T) {
+		assert.NoError(t, tracing.ValidateExporter(tracing.ExporterNone))
+		assert.NoError(t, tracing.ValidateExporter(tracing.ExporterOTLP))
+		assert.ErrorIs(t, tracing.ValidateExporter("jaeger"), tracing.ErrUnknownExporter)
+
+		_, err := tracing.Setup(t.Context(), "test", "dev", "jaeger", nil)
+		assert.ErrorIs(t, err, tracing.ErrUnknownExporter)
+	})
+
+	t.Run("stdout exporter writes spans on shutdown", func(t *testing.T) {
+		var out bytes.Buffer
+
+		shutdown, err := tracing.Setup(t.Context(), "test", "dev", tracing.ExporterStdout, &out)
+		require.NoError(t, err)
+
+		ctx, span := tracing.Start(t.Context(), "Test.Span")
+		traceID := tracing.TraceID(ctx)
+		span.End()
+
+		require.NotEmpty(t, traceID)
+		require.NoError(t, shutdown(t.Context()))
+
+		assert.Contains(t, out.String(), "Test.Span")
+		assert.Contains(t, out.String(), traceID)
+	})
+
+	t.Run("zerolog hook adds trace id from event context", func(t *testing.T) {
+		var out bytes.Buffer
+
+		shutdown, err := tracing.Setup(t.Context(), "test", "dev", tracing.ExporterStdout, &bytes.Buffer{})
+		require.NoError(t, err)
+
+		defer shutdown(context.Background()) //nolint:errcheck
+
+		log := zerolog.New(&out).Hook(tracing.ZerologHook{})
+
+		ctx, span := tracing.Start(t.Context(), "Test.Log")
+		defer span.End()
+
+		log.Info().Ctx(ctx).Msg("with span")
+		assert.Contains(t, out.String(), `"trace_id":"`+tracing.TraceID(ctx)+`"`)
+
+		out.Reset()
+		log.Info().Msg("without span")
+		assert.NotContains(t, out.String(), "trace_id")
+	})
+}